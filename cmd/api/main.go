@@ -3,6 +3,7 @@ package main
 import (
     "fmt"
     "log"
+    "os"
 
     "ishari-backend/internal/bootstrap"
     "ishari-backend/pkg/config"
@@ -14,6 +15,19 @@ func main() {
         log.Fatalf("failed to load config: %v", err)
     }
 
+    // api migrate up | down N | status | force V
+    if len(os.Args) > 1 && os.Args[1] == "migrate" {
+        if err := runMigrate(cfg.Database, os.Args[2:]); err != nil {
+            log.Fatalf("migrate: %v", err)
+        }
+        return
+    }
+    if cfg.Database.MigrateOnStart {
+        if err := runMigrate(cfg.Database, []string{"up"}); err != nil {
+            log.Fatalf("migrate on start: %v", err)
+        }
+    }
+
     app, err := bootstrap.Build(cfg)
     if err != nil {
         log.Fatalf("bootstrap error: %v", err)
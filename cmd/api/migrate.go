@@ -0,0 +1,98 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+
+	"ishari-backend/migrations"
+	"ishari-backend/pkg/config"
+	"ishari-backend/pkg/database"
+	"ishari-backend/pkg/logger"
+	"ishari-backend/pkg/migrate"
+)
+
+var errMigrateUsage = errors.New("usage: api migrate up | down N | status | force V")
+
+// runMigrate runs the migrate subcommand with the embedded migrations
+func runMigrate(cfg config.DatabaseConfig, args []string) error {
+	if len(args) == 0 {
+		return errMigrateUsage
+	}
+	command := args[0]
+	var arg int64
+	switch command {
+	case "up", "status":
+		if len(args) != 1 {
+			return errMigrateUsage
+		}
+	case "down", "force":
+		if len(args) != 2 {
+			return errMigrateUsage
+		}
+		n, err := strconv.ParseInt(args[1], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid %s argument %q: %w", command, args[1], errMigrateUsage)
+		}
+		arg = n
+	default:
+		return errMigrateUsage
+	}
+
+	db, err := database.Connect(cfg)
+	if err != nil {
+		return err
+	}
+	defer database.Close(db)
+	sqlDB, err := db.DB()
+	if err != nil {
+		return err
+	}
+	m, err := migrate.New(sqlDB, migrations.FS, logger.New())
+	if err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	switch command {
+	case "up":
+		return m.Up(ctx)
+	case "down":
+		return m.Down(ctx, int(arg))
+	case "force":
+		return m.Force(ctx, arg)
+	default:
+		status, err := m.Status(ctx)
+		if err != nil {
+			return err
+		}
+		printStatus(os.Stdout, status)
+		return nil
+	}
+}
+
+// printStatus lists the migrations with the version of the database
+func printStatus(w io.Writer, status *migrate.Status) {
+	switch {
+	case status.Version == migrate.NilVersion && !status.Dirty:
+		fmt.Fprintln(w, "version: none")
+	case status.Dirty:
+		fmt.Fprintf(w, "version: %d (dirty)\n", status.Version)
+	default:
+		fmt.Fprintf(w, "version: %d\n", status.Version)
+	}
+	for _, migration := range status.Migrations {
+		state := "pending"
+		if migration.Applied {
+			state = "applied"
+		}
+		fmt.Fprintf(w, "  %06d  %-7s  %s\n", migration.Version, state, migration.Name)
+	}
+}
@@ -0,0 +1,170 @@
+// Command import-legacy imports the verses of the legacy MySQL application, read from its
+// database or from a JSON or CSV export of one table. A mapping file places the legacy
+// rows in chapters; see mapping.example.json. Verses are upserted on their chapter and
+// verse number, so the import can be rerun, and their legacy IDs are kept in
+// legacy_id_map.
+//
+// Usage:
+//
+//	import-legacy -mapping mapping.json [-dump rows.json | -mysql-dsn DSN] [-dry-run]
+//
+// The DSN defaults to $LEGACY_MYSQL_DSN; the target database is configured like the API.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/signal"
+	"strings"
+	"text/tabwriter"
+
+	"ishari-backend/internal/adapter/legacy"
+	"ishari-backend/internal/adapter/repository/postgres"
+	"ishari-backend/internal/core/entity"
+	portuc "ishari-backend/internal/core/port/usecase"
+	"ishari-backend/internal/core/usecase/legacyimport"
+	verseusecase "ishari-backend/internal/core/usecase/verse"
+	"ishari-backend/pkg/config"
+	"ishari-backend/pkg/database"
+	"ishari-backend/pkg/logger"
+)
+
+// errChaptersFailed makes the command exit with a failure when chapters were not imported
+var errChaptersFailed = errors.New("some chapters were not imported")
+
+func main() {
+	mappingPath := flag.String("mapping", "", "JSON mapping file (required)")
+	dumpPath := flag.String("dump", "", "JSON or CSV export of the legacy table, read instead of MySQL")
+	mysqlDSN := flag.String("mysql-dsn", os.Getenv("LEGACY_MYSQL_DSN"), "DSN of the legacy MySQL database (default $LEGACY_MYSQL_DSN)")
+	source := flag.String("source", "", "name of the legacy database in legacy_id_map (default the source of the mapping)")
+	dryRun := flag.Bool("dry-run", false, "report what would be imported without writing anything")
+	flag.Parse()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	if err := run(ctx, *mappingPath, *dumpPath, *mysqlDSN, *source, *dryRun); err != nil {
+		stop()
+		log.Fatalf("import-legacy: %v", err)
+	}
+}
+
+func run(ctx context.Context, mappingPath, dumpPath, mysqlDSN, source string, dryRun bool) error {
+	if mappingPath == "" {
+		return errors.New("-mapping is required")
+	}
+	mapping, err := legacy.LoadMapping(mappingPath)
+	if err != nil {
+		return err
+	}
+	if source == "" {
+		source = mapping.Source
+	}
+
+	records, err := readRecords(ctx, mapping, dumpPath, mysqlDSN)
+	if err != nil {
+		return err
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	db, err := database.Connect(cfg.Database)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := database.Close(db); err != nil {
+			log.Printf("close database: %v", err)
+		}
+	}()
+
+	l := logger.New()
+	verseRepo := postgres.NewVerseRepository(db)
+	verseUC := verseusecase.NewVerseUsecase(verseRepo, postgres.NewChapterRepository(db), l)
+	importUC := legacyimport.NewLegacyImportUsecase(postgres.NewLegacyRepository(db), verseRepo, verseUC, l)
+
+	report, err := importUC.Import(ctx, portuc.LegacyImportInput{
+		Source:  source,
+		Table:   mapping.Table,
+		Records: records,
+		Mapping: mapping.ImportMapping(),
+		DryRun:  dryRun,
+	})
+	if err != nil {
+		return err
+	}
+	printReport(os.Stdout, source, mapping.Table, report)
+	if report.Failed > 0 {
+		return errChaptersFailed
+	}
+	return nil
+}
+
+// readRecords reads the legacy table from the dump when one is given, else from MySQL
+func readRecords(ctx context.Context, mapping *legacy.Mapping, dumpPath, mysqlDSN string) ([]entity.LegacyRecord, error) {
+	if dumpPath != "" {
+		return legacy.ReadDump(dumpPath, mapping.Table, mapping.Columns)
+	}
+	if mysqlDSN == "" {
+		return nil, errors.New("either -dump or -mysql-dsn ($LEGACY_MYSQL_DSN) is required")
+	}
+	db, err := legacy.OpenMySQL(mysqlDSN)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := database.Close(db); err != nil {
+			log.Printf("close legacy database: %v", err)
+		}
+	}()
+	return legacy.ReadMySQL(ctx, db, mapping.Table, mapping.Columns)
+}
+
+// printReport writes the summary of an import, then every skipped record and error
+func printReport(w io.Writer, source, table string, report *portuc.LegacyImportReport) {
+	mode := ""
+	if report.DryRun {
+		mode = " (dry run, nothing written)"
+	}
+	fmt.Fprintf(w, "Legacy import of %s.%s%s\n", source, table, mode)
+	fmt.Fprintf(w, "records: %d  created: %d  updated: %d  skipped: %d  failed chapters: %d\n\n",
+		report.Records, report.Created, report.Updated, len(report.Skipped), report.Failed)
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "CATEGORY\tCHAPTER\tCHAPTER ID\tRECORDS\tCREATED\tUPDATED\tSTATUS")
+	for _, chapter := range report.Chapters {
+		id, status := "-", "ok"
+		if chapter.ChapterID != 0 {
+			id = fmt.Sprint(chapter.ChapterID)
+		}
+		if len(chapter.Errors) > 0 {
+			status = "failed"
+		}
+		fmt.Fprintf(tw, "%s\t%d\t%s\t%d\t%d\t%d\t%s\n", chapter.Category, chapter.ChapterNumber, id,
+			chapter.Records, chapter.Created, chapter.Updated, status)
+	}
+	tw.Flush()
+
+	if len(report.Skipped) > 0 {
+		fmt.Fprintln(w, "\nSkipped records:")
+		for _, skipped := range report.Skipped {
+			fmt.Fprintf(w, "  legacy id %d: %s\n", skipped.LegacyID, skipped.Reason)
+		}
+	}
+	var errs []string
+	for _, chapter := range report.Chapters {
+		for _, err := range chapter.Errors {
+			errs = append(errs, fmt.Sprintf("  %s chapter %d: %s", chapter.Category, chapter.ChapterNumber, err))
+		}
+	}
+	if len(errs) > 0 {
+		fmt.Fprintf(w, "\nErrors:\n%s\n", strings.Join(errs, "\n"))
+	}
+}
@@ -8,9 +8,11 @@ require (
 	github.com/go-playground/validator/v10 v10.28.0
 	github.com/gofiber/fiber/v2 v2.52.9
 	github.com/golang-jwt/jwt/v5 v5.3.0
+	github.com/hajimehoshi/go-mp3 v0.3.4
 	github.com/spf13/viper v1.21.0
 	github.com/subosito/gotenv v1.6.0
 	golang.org/x/crypto v0.43.0
+	gorm.io/driver/mysql v1.6.0
 	gorm.io/driver/postgres v1.6.0
 	gorm.io/gorm v1.31.0
 )
@@ -50,5 +52,4 @@ require (
 	golang.org/x/sync v0.17.0 // indirect
 	golang.org/x/sys v0.37.0 // indirect
 	golang.org/x/text v0.30.0 // indirect
-	gorm.io/driver/mysql v1.6.0 // indirect
 )
@@ -0,0 +1,16 @@
+package http
+
+import (
+	"ishari-backend/internal/adapter/handler/http/controller"
+	"ishari-backend/internal/adapter/handler/http/middleware"
+	portuc "ishari-backend/internal/core/port/usecase"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// RegisterAuditLogRoutes registers the admin-only audit log routes
+func RegisterAuditLogRoutes(router fiber.Router, ctrl *controller.AuditLogController, authUC portuc.AuthUseCase) {
+	auditLogs := router.Group("/audit-logs", middleware.AuthMiddleware(authUC), middleware.RequireRoles("super_admin", "admin_content"))
+	auditLogs.Get("/", ctrl.List)
+	auditLogs.Get("/:id", ctrl.GetByID)
+}
@@ -8,17 +8,20 @@ import (
 	"github.com/gofiber/fiber/v2"
 )
 
-// RegisterBookRoutes registers all book-related HTTP routes
-func RegisterBookRoutes(router fiber.Router, ctrl *controller.BookController, authUC portuc.AuthUseCase) {
+// RegisterBookRoutes registers all book-related HTTP routes. It returns the protected group,
+// which routes nested under /books/:id that need a signed-in user are registered on.
+func RegisterBookRoutes(router fiber.Router, ctrl *controller.BookController, authUC portuc.AuthUseCase) fiber.Router {
 	books := router.Group("/books")
 
 	// Public routes (read-only)
 	books.Get("/", ctrl.ListBooks)
 	books.Get("/:id", ctrl.GetBookById)
+	books.Get("/:id/toc", ctrl.GetTOC)
 
 	// Protected routes (require JWT token for mutations)
 	protected := books.Group("", middleware.AuthMiddleware(authUC))
 	protected.Post("/", ctrl.CreateBook)
 	protected.Put("/:id", ctrl.EditBook)
 	protected.Delete("/:id", ctrl.DeleteBook)
+	return protected
 }
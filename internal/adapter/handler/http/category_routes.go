@@ -0,0 +1,25 @@
+package http
+
+import (
+	"ishari-backend/internal/adapter/handler/http/controller"
+	"ishari-backend/internal/adapter/handler/http/middleware"
+	portuc "ishari-backend/internal/core/port/usecase"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// RegisterCategoryRoutes registers the chapter category routes
+func RegisterCategoryRoutes(router fiber.Router, ctrl *controller.CategoryController, authUC portuc.AuthUseCase) {
+	categories := router.Group("/categories")
+
+	// Public routes
+	categories.Get("/", ctrl.List)
+	categories.Get("/:id", ctrl.GetByID)
+	categories.Get("/:id/chapters", ctrl.ListChapters)
+
+	// Admin routes
+	admin := categories.Group("", middleware.AuthMiddleware(authUC), middleware.RequireRoles("super_admin", "admin_content"))
+	admin.Post("/", ctrl.Create)
+	admin.Put("/:id", ctrl.Update)
+	admin.Delete("/:id", ctrl.Delete)
+}
@@ -8,12 +8,15 @@ import (
 	"github.com/gofiber/fiber/v2"
 )
 
-func RegisterChapterRoutes(router fiber.Router, ctrl *controller.ChapterController, authUC portuc.AuthUseCase) {
+// RegisterChapterRoutes registers all chapter-related HTTP routes. It returns the protected
+// group, which routes nested under /chapters/:id that need a signed-in user are registered on.
+func RegisterChapterRoutes(router fiber.Router, ctrl *controller.ChapterController, authUC portuc.AuthUseCase) fiber.Router {
 	chapter := router.Group("/chapters")
 
 	// Public routes (no auth required)
 	chapter.Get("/", ctrl.List)
 	chapter.Get("/:id", ctrl.GetByID)
+	chapter.Get("/:id/reader", ctrl.GetReader)
 	chapter.Get("/book/:bookId", ctrl.GetByBookID)
 
 	// Protected routes (require JWT token)
@@ -22,4 +25,13 @@ func RegisterChapterRoutes(router fiber.Router, ctrl *controller.ChapterControll
 	protected.Post("/", ctrl.Create)
 	protected.Put("/:id", ctrl.Update)
 	protected.Delete("/:id", ctrl.Delete)
+	protected.Post("/:id/move", ctrl.Move)
+	protected.Post("/reconcile-verse-counts", middleware.RequireRoles("super_admin", "admin_content"), ctrl.ReconcileVerseCounts)
+	return protected
+}
+
+// RegisterBookChapterRoutes registers the chapter routes addressed by book on the protected
+// group returned by RegisterBookRoutes. Reordering is addressed by book but renumbers chapters.
+func RegisterBookChapterRoutes(books fiber.Router, ctrl *controller.ChapterController) {
+	books.Post("/:id/chapters/reorder", ctrl.Reorder)
 }
@@ -0,0 +1,163 @@
+package controller
+
+import (
+	"strconv"
+	"time"
+
+	"ishari-backend/internal/adapter/handler/http/dto"
+	"ishari-backend/internal/adapter/handler/http/response"
+	"ishari-backend/internal/core/entity"
+	portuc "ishari-backend/internal/core/port/usecase"
+	"ishari-backend/pkg/logger"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// dateLayout is accepted by the from and to filters next to RFC 3339 timestamps
+const dateLayout = "2006-01-02"
+
+// AuditLogController handles audit log HTTP requests
+type AuditLogController struct {
+	auditUsecase portuc.AuditLogUseCase
+	log          logger.Logger
+}
+
+// NewAuditLogController creates a new audit log controller
+func NewAuditLogController(auditUsecase portuc.AuditLogUseCase, l logger.Logger) *AuditLogController {
+	return &AuditLogController{
+		auditUsecase: auditUsecase,
+		log:          l,
+	}
+}
+
+// List handles listing audit log entries, newest first
+// GET /api/audit-logs?table_name=&record_id=&operation=&changed_by_user_id=&from=&to=&cursor=&limit=
+func (c *AuditLogController) List(ctx *fiber.Ctx) error {
+	input := portuc.ListAuditLogInput{
+		Table:     ctx.Query("table_name"),
+		Operation: ctx.Query("operation"),
+		Limit:     ctx.QueryInt("limit", 50),
+	}
+
+	var err error
+	if input.RecordID, err = uintQuery(ctx, "record_id"); err != nil {
+		return response.SendBadRequest(ctx, "invalid record_id", err, nil, "")
+	}
+	if input.ChangedByUserID, err = uintQuery(ctx, "changed_by_user_id"); err != nil {
+		return response.SendBadRequest(ctx, "invalid changed_by_user_id", err, nil, "")
+	}
+	cursor, err := uintQuery(ctx, "cursor")
+	if err != nil {
+		return response.SendBadRequest(ctx, "invalid cursor", err, nil, "")
+	}
+	if cursor != nil {
+		input.Cursor = *cursor
+	}
+	if input.From, err = timeQuery(ctx, "from", false); err != nil {
+		return response.SendBadRequest(ctx, "invalid from, expected YYYY-MM-DD or RFC 3339", err, nil, "")
+	}
+	if input.To, err = timeQuery(ctx, "to", true); err != nil {
+		return response.SendBadRequest(ctx, "invalid to, expected YYYY-MM-DD or RFC 3339", err, nil, "")
+	}
+
+	result, err := c.auditUsecase.List(ctx.UserContext(), input)
+	if err != nil {
+		return response.SendDomainError(ctx, err, c.log)
+	}
+
+	out := make([]dto.AuditLogResponse, 0, len(result.Data))
+	for i := range result.Data {
+		out = append(out, toAuditLogResponse(&result.Data[i]))
+	}
+	return response.SendCursorPaginated(ctx, out, result.Limit, len(out), result.NextCursor)
+}
+
+// GetByID handles getting an audit log entry with its field-level diff
+// GET /api/audit-logs/:id
+func (c *AuditLogController) GetByID(ctx *fiber.Ctx) error {
+	id, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil || id <= 0 {
+		return response.SendBadRequest(ctx, "invalid audit log ID", err, nil, "")
+	}
+
+	detail, err := c.auditUsecase.GetByID(ctx.UserContext(), uint(id))
+	if err != nil {
+		return response.SendDomainError(ctx, err, c.log)
+	}
+
+	changes := make([]dto.FieldChangeResponse, 0, len(detail.Changes))
+	for _, change := range detail.Changes {
+		changes = append(changes, dto.FieldChangeResponse{
+			Field: change.Field,
+			Old:   change.Old,
+			New:   change.New,
+		})
+	}
+	return response.SendOK(ctx, dto.AuditLogDetailResponse{
+		AuditLogResponse: toAuditLogResponse(&detail.AuditLog),
+		Changes:          changes,
+	})
+}
+
+func toAuditLogResponse(log *entity.AuditLog) dto.AuditLogResponse {
+	return dto.AuditLogResponse{
+		ID:                log.ID,
+		TableName:         log.Table,
+		RecordID:          log.RecordID,
+		Operation:         log.Operation,
+		OldData:           log.OldData,
+		NewData:           log.NewData,
+		ChangedByUserID:   log.ChangedByUserID,
+		ChangedByUsername: log.ChangedByUsername,
+		ChangedAt:         log.ChangedAt,
+	}
+}
+
+// uintQuery parses an optional positive integer query parameter
+func uintQuery(ctx *fiber.Ctx, name string) (*uint, error) {
+	raw := ctx.Query(name)
+	if raw == "" {
+		return nil, nil
+	}
+	v, err := strconv.ParseUint(raw, 10, 32)
+	if err != nil {
+		return nil, err
+	}
+	out := uint(v)
+	return &out, nil
+}
+
+// timeQuery parses an optional RFC 3339 timestamp or date. A date that ends a range is taken
+// as the start of the following day, so the range includes the whole day.
+func timeQuery(ctx *fiber.Ctx, name string, end bool) (*time.Time, error) {
+	raw := ctx.Query(name)
+	if raw == "" {
+		return nil, nil
+	}
+	if t, err := time.Parse(time.RFC3339, raw); err == nil {
+		return &t, nil
+	}
+	t, err := time.Parse(dateLayout, raw)
+	if err != nil {
+		return nil, err
+	}
+	if end {
+		t = t.AddDate(0, 0, 1)
+	}
+	return &t, nil
+}
+
+func toRevisionResponses(revisions []entity.Revision) []dto.RevisionResponse {
+	out := make([]dto.RevisionResponse, 0, len(revisions))
+	for _, r := range revisions {
+		out = append(out, dto.RevisionResponse{
+			ID:                r.ID,
+			Operation:         r.Operation,
+			Data:              r.Data,
+			ChangedByUserID:   r.ChangedByUserID,
+			ChangedByUsername: r.ChangedByUsername,
+			ChangedAt:         r.ChangedAt,
+		})
+	}
+	return out
+}
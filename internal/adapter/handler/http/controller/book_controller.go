@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"ishari-backend/internal/adapter/handler/http/dto"
+	"ishari-backend/internal/adapter/handler/http/response"
 	"ishari-backend/pkg/logger"
 	"ishari-backend/pkg/validation"
 
@@ -15,13 +16,14 @@ import (
 )
 
 type BookController struct {
-	bookUseCase portuc.BookUseCase
-	validate    validation.Validator
-	log         logger.Logger
+	bookUseCase   portuc.BookUseCase
+	readerUseCase portuc.ReaderUseCase
+	validate      validation.Validator
+	log           logger.Logger
 }
 
-func NewBookController(bookUseCase portuc.BookUseCase, v validation.Validator, l logger.Logger) *BookController {
-	return &BookController{bookUseCase: bookUseCase, validate: v, log: l}
+func NewBookController(bookUseCase portuc.BookUseCase, readerUseCase portuc.ReaderUseCase, v validation.Validator, l logger.Logger) *BookController {
+	return &BookController{bookUseCase: bookUseCase, readerUseCase: readerUseCase, validate: v, log: l}
 }
 
 func (h *BookController) CreateBook(c *fiber.Ctx) error {
@@ -68,6 +70,7 @@ func (h *BookController) CreateBook(c *fiber.Ctx) error {
 		Description:   book.Description,
 		PublishedYear: book.PublishedYear,
 		CoverImageURL: book.CoverImageURL,
+		CoverImages:   response.MapImageVariantsResponse(book.CoverImages),
 		CreatedAt:     book.CreatedAt.UTC().Format(time.RFC3339),
 		UpdatedAt:     book.UpdatedAt.UTC().Format(time.RFC3339),
 	}
@@ -104,6 +107,7 @@ func (h *BookController) ListBooks(c *fiber.Ctx) error {
 			Description:   b.Description,
 			PublishedYear: b.PublishedYear,
 			CoverImageURL: b.CoverImageURL,
+			CoverImages:   response.MapImageVariantsResponse(b.CoverImages),
 			CreatedAt:     b.CreatedAt.UTC().Format(time.RFC3339),
 			UpdatedAt:     b.UpdatedAt.UTC().Format(time.RFC3339),
 		})
@@ -167,6 +171,7 @@ func (h *BookController) EditBook(c *fiber.Ctx) error {
 		Description:   book.Description,
 		PublishedYear: book.PublishedYear,
 		CoverImageURL: book.CoverImageURL,
+		CoverImages:   response.MapImageVariantsResponse(book.CoverImages),
 		CreatedAt:     book.CreatedAt.UTC().Format(time.RFC3339),
 		UpdatedAt:     book.UpdatedAt.UTC().Format(time.RFC3339),
 	}
@@ -210,9 +215,67 @@ func (h *BookController) GetBookById(c *fiber.Ctx) error {
 		Description:   book.Description,
 		PublishedYear: book.PublishedYear,
 		CoverImageURL: book.CoverImageURL,
+		CoverImages:   response.MapImageVariantsResponse(book.CoverImages),
 		CreatedAt:     book.CreatedAt.UTC().Format(time.RFC3339),
 		UpdatedAt:     book.UpdatedAt.UTC().Format(time.RFC3339),
 	}
 
 	return c.Status(fiber.StatusOK).JSON(resp)
 }
+
+// GetTOC returns the book with its chapters grouped by category
+func (h *BookController) GetTOC(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil || id <= 0 {
+		return response.SendBadRequest(c, "invalid book ID", err, nil, "")
+	}
+
+	toc, err := h.readerUseCase.GetBookTOC(c.UserContext(), id)
+	if err != nil {
+		return response.SendDomainError(c, err, h.log)
+	}
+
+	resp := dto.BookTOCResponse{
+		Book: dto.BookResponse{
+			ID:            toc.Book.ID,
+			Title:         toc.Book.Title,
+			Author:        toc.Book.Author,
+			Description:   toc.Book.Description,
+			PublishedYear: toc.Book.PublishedYear,
+			CoverImageURL: toc.Book.CoverImageURL,
+			CoverImages:   response.MapImageVariantsResponse(toc.Book.CoverImages),
+			CreatedAt:     toc.Book.CreatedAt.UTC().Format(time.RFC3339),
+			UpdatedAt:     toc.Book.UpdatedAt.UTC().Format(time.RFC3339),
+		},
+		Categories: make([]dto.TOCCategoryResponse, 0, len(toc.Categories)),
+	}
+	for _, category := range toc.Categories {
+		out := dto.TOCCategoryResponse{
+			ID:       category.ID,
+			Slug:     category.Slug,
+			Name:     category.Name,
+			Chapters: make([]dto.TOCChapterResponse, 0, len(category.Chapters)),
+		}
+		for _, ch := range category.Chapters {
+			chapter := dto.TOCChapterResponse{
+				ID:            ch.ID,
+				ChapterNumber: ch.ChapterNumber,
+				Title:         ch.Title,
+				VerseCount:    ch.VerseCount,
+				HasAudio:      ch.HasAudio,
+				Translations:  make([]dto.TranslationCoverageResponse, 0, len(ch.Translations)),
+			}
+			for _, t := range ch.Translations {
+				chapter.Translations = append(chapter.Translations, dto.TranslationCoverageResponse{
+					LanguageCode:     t.LanguageCode,
+					TranslatedVerses: t.TranslatedVerses,
+					Coverage:         t.Coverage,
+				})
+			}
+			out.Chapters = append(out.Chapters, chapter)
+		}
+		resp.Categories = append(resp.Categories, out)
+	}
+
+	return response.SendOK(c, resp)
+}
@@ -0,0 +1,174 @@
+package controller
+
+import (
+	"math"
+	"strconv"
+	"time"
+
+	"ishari-backend/internal/adapter/handler/http/dto"
+	"ishari-backend/internal/adapter/handler/http/response"
+	"ishari-backend/internal/core/entity"
+	portuc "ishari-backend/internal/core/port/usecase"
+	"ishari-backend/pkg/logger"
+	"ishari-backend/pkg/validation"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// CategoryController handles chapter category HTTP requests
+type CategoryController struct {
+	categoryUsecase portuc.CategoryUseCase
+	validate        validation.Validator
+	log             logger.Logger
+}
+
+// NewCategoryController creates a new category controller
+func NewCategoryController(categoryUsecase portuc.CategoryUseCase, v validation.Validator, l logger.Logger) *CategoryController {
+	return &CategoryController{
+		categoryUsecase: categoryUsecase,
+		validate:        v,
+		log:             l,
+	}
+}
+
+// toCategoryResponse converts a Category to a CategoryResponse
+func toCategoryResponse(category *entity.Category) dto.CategoryResponse {
+	return dto.CategoryResponse{
+		ID:          category.ID,
+		Slug:        category.Slug,
+		Name:        category.Name,
+		SortOrder:   category.SortOrder,
+		Description: category.Description,
+		CreatedAt:   category.CreatedAt.UTC().Format(time.RFC3339),
+		UpdatedAt:   category.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+// List handles listing every category in reading order
+// GET /api/categories
+func (c *CategoryController) List(ctx *fiber.Ctx) error {
+	categories, err := c.categoryUsecase.List(ctx.UserContext())
+	if err != nil {
+		return response.SendDomainError(ctx, err, c.log)
+	}
+
+	out := make([]dto.CategoryResponse, 0, len(categories))
+	for i := range categories {
+		out = append(out, toCategoryResponse(&categories[i]))
+	}
+	return response.SendOK(ctx, out)
+}
+
+// GetByID handles getting a category by ID
+// GET /api/categories/:id
+func (c *CategoryController) GetByID(ctx *fiber.Ctx) error {
+	id, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil || id <= 0 {
+		return response.SendBadRequest(ctx, "invalid category ID", err, nil, "")
+	}
+
+	category, err := c.categoryUsecase.GetByID(ctx.UserContext(), uint(id))
+	if err != nil {
+		return response.SendDomainError(ctx, err, c.log)
+	}
+
+	return response.SendOK(ctx, toCategoryResponse(category))
+}
+
+// ListChapters handles listing the chapters of a category across all books
+// GET /api/categories/:id/chapters?page=&limit=
+func (c *CategoryController) ListChapters(ctx *fiber.Ctx) error {
+	id, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil || id <= 0 {
+		return response.SendBadRequest(ctx, "invalid category ID", err, nil, "")
+	}
+	page, _ := strconv.Atoi(ctx.Query("page", "1"))
+	limit, _ := strconv.Atoi(ctx.Query("limit", "20"))
+
+	result, err := c.categoryUsecase.ListChapters(ctx.UserContext(), uint(id), page, limit)
+	if err != nil {
+		return response.SendDomainError(ctx, err, c.log)
+	}
+
+	var totalPages int
+	if result.Limit > 0 {
+		totalPages = int(math.Ceil(float64(result.Total) / float64(result.Limit)))
+	}
+
+	out := make([]dto.ListChapterResponse, 0, len(result.Data))
+	for i := range result.Data {
+		out = append(out, toListChapterResponse(&result.Data[i]))
+	}
+
+	return response.SendPaginated(ctx, out, result.Page, result.Limit, result.Total, totalPages, len(result.Data))
+}
+
+// Create handles creating a new category
+// POST /api/categories
+func (c *CategoryController) Create(ctx *fiber.Ctx) error {
+	var req dto.CreateCategoryRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return response.SendParseError(ctx, err, c.log, "Create category body parse error")
+	}
+
+	if err := c.validate.Struct(req); err != nil {
+		return response.SendValidationError(ctx, err, c.log, "Create category validation failed")
+	}
+
+	category, err := c.categoryUsecase.Create(ctx.UserContext(), portuc.CreateCategoryInput{
+		Slug:        req.Slug,
+		Name:        req.Name,
+		SortOrder:   req.SortOrder,
+		Description: req.Description,
+	})
+	if err != nil {
+		return response.SendDomainError(ctx, err, c.log)
+	}
+
+	return response.SendCreated(ctx, "category created successfully", toCategoryResponse(category))
+}
+
+// Update handles updating a category
+// PUT /api/categories/:id
+func (c *CategoryController) Update(ctx *fiber.Ctx) error {
+	id, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil || id <= 0 {
+		return response.SendBadRequest(ctx, "invalid category ID", err, nil, "")
+	}
+
+	var req dto.UpdateCategoryRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return response.SendParseError(ctx, err, c.log, "Update category body parse error")
+	}
+
+	if err := c.validate.Struct(req); err != nil {
+		return response.SendValidationError(ctx, err, c.log, "Update category validation failed")
+	}
+
+	category, err := c.categoryUsecase.Update(ctx.UserContext(), uint(id), portuc.UpdateCategoryInput{
+		Slug:        req.Slug,
+		Name:        req.Name,
+		SortOrder:   req.SortOrder,
+		Description: req.Description,
+	})
+	if err != nil {
+		return response.SendDomainError(ctx, err, c.log)
+	}
+
+	return response.SendOK(ctx, toCategoryResponse(category))
+}
+
+// Delete handles deleting a category without chapters
+// DELETE /api/categories/:id
+func (c *CategoryController) Delete(ctx *fiber.Ctx) error {
+	id, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil || id <= 0 {
+		return response.SendBadRequest(ctx, "invalid category ID", err, nil, "")
+	}
+
+	if err := c.categoryUsecase.Delete(ctx.UserContext(), uint(id)); err != nil {
+		return response.SendDomainError(ctx, err, c.log)
+	}
+
+	return response.SendOK(ctx, fiber.Map{"message": "category deleted successfully"})
+}
@@ -10,6 +10,7 @@ import (
 	"ishari-backend/pkg/validation"
 	"math"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gofiber/fiber/v2"
@@ -18,14 +19,16 @@ import (
 // ChapterController handles chapter-related HTTP requests
 type ChapterController struct {
 	chapterUsecase portuc.ChapterUsecase
+	readerUsecase  portuc.ReaderUseCase
 	validate       validation.Validator
 	log            logger.Logger
 }
 
 // NewChapterController creates a new chapter controller
-func NewChapterController(chapterUsecase portuc.ChapterUsecase, v validation.Validator, l logger.Logger) *ChapterController {
+func NewChapterController(chapterUsecase portuc.ChapterUsecase, readerUsecase portuc.ReaderUseCase, v validation.Validator, l logger.Logger) *ChapterController {
 	return &ChapterController{
 		chapterUsecase: chapterUsecase,
+		readerUsecase:  readerUsecase,
 		validate:       v,
 		log:            l,
 	}
@@ -70,26 +73,31 @@ func (c *ChapterController) List(ctx *fiber.Ctx) error {
 
 	out := make([]dto.ListChapterResponse, 0, len(result.Data))
 	for _, u := range result.Data {
-		out = append(out, c.toListChapterResponse(&u))
+		out = append(out, toListChapterResponse(&u))
 	}
 
 	return response.SendPaginated(ctx, out, page, limit, result.Total, totalPages, len(result.Data))
 }
 
 // toListChapterResponse converts a Chapter to a ListChapterResponse
-func (c *ChapterController) toListChapterResponse(chapter *entity.Chapter) dto.ListChapterResponse {
+func toListChapterResponse(chapter *entity.Chapter) dto.ListChapterResponse {
 	resp := dto.ListChapterResponse{
 		ID:            chapter.ID,
 		BookID:        chapter.BookID,
 		ChapterNumber: chapter.ChapterNumber,
 		Title:         chapter.Title,
-		Category:      chapter.Category,
+		CategoryID:    chapter.CategoryID,
 		Description:   chapter.Description,
 		TotalVerses:   chapter.TotalVerses,
 		CreatedAt:     chapter.CreatedAt.UTC().Format(time.RFC3339),
 		UpdatedAt:     chapter.UpdatedAt.UTC().Format(time.RFC3339),
 	}
 
+	if chapter.Category != nil {
+		resp.CategorySlug = chapter.Category.Slug
+		resp.Category = chapter.Category.Name
+	}
+
 	if chapter.Book != nil {
 		resp.Book = &dto.BookResponse{
 			ID:            chapter.Book.ID,
@@ -98,6 +106,7 @@ func (c *ChapterController) toListChapterResponse(chapter *entity.Chapter) dto.L
 			Description:   chapter.Book.Description,
 			PublishedYear: chapter.Book.PublishedYear,
 			CoverImageURL: chapter.Book.CoverImageURL,
+			CoverImages:   response.MapImageVariantsResponse(chapter.Book.CoverImages),
 			CreatedAt:     chapter.Book.CreatedAt.UTC().Format(time.RFC3339),
 			UpdatedAt:     chapter.Book.UpdatedAt.UTC().Format(time.RFC3339),
 		}
@@ -119,7 +128,62 @@ func (c *ChapterController) GetByID(ctx *fiber.Ctx) error {
 		return response.SendDomainError(ctx, err, c.log)
 	}
 
-	return response.SendOK(ctx, c.toListChapterResponse(chapter))
+	return response.SendOK(ctx, toListChapterResponse(chapter))
+}
+
+// GetReader returns the chapter, its book and all verses with translations and media
+// GET /api/chapters/:id/reader?lang=id,en&media=audio
+func (c *ChapterController) GetReader(ctx *fiber.Ctx) error {
+	id, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil || id <= 0 {
+		return response.SendBadRequest(ctx, "invalid chapter ID", err, nil, "")
+	}
+
+	reader, err := c.readerUsecase.GetChapter(ctx.UserContext(), portuc.ChapterReaderParams{
+		ChapterID:  uint(id),
+		Languages:  splitQueryList(ctx.Query("lang")),
+		MediaTypes: splitQueryList(ctx.Query("media")),
+	})
+	if err != nil {
+		return response.SendDomainError(ctx, err, c.log)
+	}
+
+	resp := dto.ChapterReaderResponse{
+		Chapter: toListChapterResponse(&reader.Chapter),
+		Verses:  make([]dto.ReaderVerseResponse, 0, len(reader.Verses)),
+	}
+	for _, v := range reader.Verses {
+		verse := dto.ReaderVerseResponse{
+			ID:              v.ID,
+			VerseNumber:     v.VerseNumber,
+			ArabicText:      v.ArabicText,
+			Transliteration: v.Transliteration,
+			Translations:    make([]dto.ReaderTranslationResponse, 0, len(v.Translations)),
+			Media:           make([]dto.VerseMediaResponse, 0, len(v.Media)),
+		}
+		for _, t := range v.Translations {
+			verse.Translations = append(verse.Translations, dto.ReaderTranslationResponse{
+				ID:              t.ID,
+				LanguageCode:    t.LanguageCode,
+				TranslationText: t.TranslationText,
+				TranslatorName:  t.TranslatorName,
+			})
+		}
+		for i := range v.Media {
+			verse.Media = append(verse.Media, toVerseMediaResponse(&v.Media[i]))
+		}
+		resp.Verses = append(resp.Verses, verse)
+	}
+
+	return response.SendOK(ctx, resp)
+}
+
+// splitQueryList splits a comma-separated query value such as "id,en"
+func splitQueryList(raw string) []string {
+	if strings.TrimSpace(raw) == "" {
+		return nil
+	}
+	return strings.Split(raw, ",")
 }
 
 // Create handles creating a new chapter
@@ -138,9 +202,8 @@ func (c *ChapterController) Create(ctx *fiber.Ctx) error {
 		BookID:        req.BookID,
 		ChapterNumber: req.ChapterNumber,
 		Title:         req.Title,
-		Category:      req.Category,
+		CategoryID:    req.CategoryID,
 		Description:   req.Description,
-		TotalVerses:   req.TotalVerses,
 	}
 
 	chapter, err := c.chapterUsecase.Create(ctx.UserContext(), input)
@@ -148,7 +211,7 @@ func (c *ChapterController) Create(ctx *fiber.Ctx) error {
 		return response.SendDomainError(ctx, err, c.log)
 	}
 
-	return response.SendCreated(ctx, "chapter created successfully", c.toListChapterResponse(chapter))
+	return response.SendCreated(ctx, "chapter created successfully", toListChapterResponse(chapter))
 }
 
 // Update handles updating a chapter
@@ -172,9 +235,8 @@ func (c *ChapterController) Update(ctx *fiber.Ctx) error {
 		BookID:        req.BookID,
 		ChapterNumber: req.ChapterNumber,
 		Title:         req.Title,
-		Category:      req.Category,
+		CategoryID:    req.CategoryID,
 		Description:   req.Description,
-		TotalVerses:   req.TotalVerses,
 	}
 
 	chapter, err := c.chapterUsecase.Update(ctx.UserContext(), uint(id), input)
@@ -182,7 +244,7 @@ func (c *ChapterController) Update(ctx *fiber.Ctx) error {
 		return response.SendDomainError(ctx, err, c.log)
 	}
 
-	return response.SendOK(ctx, c.toListChapterResponse(chapter))
+	return response.SendOK(ctx, toListChapterResponse(chapter))
 }
 
 // Delete handles deleting a chapter
@@ -251,8 +313,86 @@ func (c *ChapterController) GetByBookID(ctx *fiber.Ctx) error {
 
 	out := make([]dto.ListChapterResponse, 0, len(result.Data))
 	for _, chapter := range result.Data {
-		out = append(out, c.toListChapterResponse(&chapter))
+		out = append(out, toListChapterResponse(&chapter))
 	}
 
 	return response.SendPaginated(ctx, out, result.Page, result.Limit, result.Total, result.TotalPages, len(result.Data))
 }
+
+// ReconcileVerseCounts handles recomputing total_verses of every chapter
+// POST /api/v1/chapters/reconcile-verse-counts
+func (c *ChapterController) ReconcileVerseCounts(ctx *fiber.Ctx) error {
+	corrections, err := c.chapterUsecase.ReconcileVerseCounts(ctx.UserContext())
+	if err != nil {
+		return response.SendDomainError(ctx, err, c.log)
+	}
+
+	resp := dto.ReconcileVerseCountsResponse{
+		Corrected: len(corrections),
+		Chapters:  make([]dto.VerseCountCorrectionResponse, 0, len(corrections)),
+	}
+	for _, fix := range corrections {
+		resp.Chapters = append(resp.Chapters, dto.VerseCountCorrectionResponse{
+			ChapterID:     fix.ChapterID,
+			BookID:        fix.BookID,
+			ChapterNumber: fix.ChapterNumber,
+			Title:         fix.Title,
+			StoredTotal:   fix.StoredTotal,
+			ActualTotal:   fix.ActualTotal,
+		})
+	}
+
+	return response.SendOK(ctx, resp)
+}
+
+// Reorder handles renumbering the chapters of a book in the given order
+// POST /api/v1/books/:id/chapters/reorder
+func (c *ChapterController) Reorder(ctx *fiber.Ctx) error {
+	bookID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil || bookID <= 0 {
+		return response.SendBadRequest(ctx, "invalid book ID", err, nil, "")
+	}
+
+	var req dto.ReorderChaptersRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return response.SendParseError(ctx, err, c.log, "Reorder chapters body parse error")
+	}
+
+	if err := c.validate.Struct(req); err != nil {
+		return response.SendValidationError(ctx, err, c.log, "Reorder chapters validation failed")
+	}
+
+	if err := c.chapterUsecase.Reorder(ctx.UserContext(), uint(bookID), req.IDs); err != nil {
+		return response.SendDomainError(ctx, err, c.log)
+	}
+
+	return response.SendOK(ctx, fiber.Map{"message": "chapters reordered successfully"})
+}
+
+// Move handles moving a chapter to a new position or book
+// POST /api/v1/chapters/:id/move
+func (c *ChapterController) Move(ctx *fiber.Ctx) error {
+	id, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return response.SendBadRequest(ctx, "invalid chapter ID", err, nil, "")
+	}
+
+	var req dto.MoveChapterRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return response.SendParseError(ctx, err, c.log, "Move chapter body parse error")
+	}
+
+	if err := c.validate.Struct(req); err != nil {
+		return response.SendValidationError(ctx, err, c.log, "Move chapter validation failed")
+	}
+
+	chapter, err := c.chapterUsecase.Move(ctx.UserContext(), uint(id), portuc.MoveChapterInput{
+		BookID:   req.BookID,
+		Position: req.Position,
+	})
+	if err != nil {
+		return response.SendDomainError(ctx, err, c.log)
+	}
+
+	return response.SendOK(ctx, toListChapterResponse(chapter))
+}
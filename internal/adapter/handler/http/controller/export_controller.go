@@ -0,0 +1,114 @@
+package controller
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"strconv"
+
+	"ishari-backend/internal/adapter/handler/http/dto"
+	"ishari-backend/internal/adapter/handler/http/response"
+	portuc "ishari-backend/internal/core/port/usecase"
+	"ishari-backend/pkg/logger"
+	"ishari-backend/pkg/validation"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// ExportController handles content export HTTP requests
+type ExportController struct {
+	exportUsecase portuc.ExportUseCase
+	validate      validation.Validator
+	log           logger.Logger
+}
+
+// NewExportController creates a new export controller
+func NewExportController(exportUsecase portuc.ExportUseCase, validate validation.Validator, l logger.Logger) *ExportController {
+	return &ExportController{
+		exportUsecase: exportUsecase,
+		validate:      validate,
+		log:           l,
+	}
+}
+
+// ExportBook handles streaming a whole book as JSON or as a zip of CSVs
+// GET /api/books/:id/export?format=json|csv
+func (c *ExportController) ExportBook(ctx *fiber.Ctx) error {
+	id, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil || id <= 0 {
+		return response.SendBadRequest(ctx, "invalid book ID", err, nil, "")
+	}
+
+	export, err := c.exportUsecase.PrepareBook(ctx.UserContext(), id, ctx.Query("format"))
+	if err != nil {
+		return response.SendDomainError(ctx, err, c.log)
+	}
+
+	return c.stream(ctx, export.Filename, export.ContentType, func(userCtx context.Context, w io.Writer) error {
+		return c.exportUsecase.WriteBook(userCtx, export, w)
+	})
+}
+
+// ExportBookEPUB handles streaming a whole book as an EPUB e-book
+// GET /api/books/:id/export.epub?lang=
+func (c *ExportController) ExportBookEPUB(ctx *fiber.Ctx) error {
+	id, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil || id <= 0 {
+		return response.SendBadRequest(ctx, "invalid book ID", err, nil, "")
+	}
+
+	export, err := c.exportUsecase.PrepareEPUB(ctx.UserContext(), portuc.EPUBExportInput{
+		BookID:   id,
+		Language: ctx.Query("lang"),
+	})
+	if err != nil {
+		return response.SendDomainError(ctx, err, c.log)
+	}
+
+	return c.stream(ctx, export.Filename, export.ContentType, func(userCtx context.Context, w io.Writer) error {
+		return c.exportUsecase.WriteEPUB(userCtx, export, w)
+	})
+}
+
+// ExportEPUB handles streaming selected chapters as an EPUB e-book
+// POST /api/exports/epub
+func (c *ExportController) ExportEPUB(ctx *fiber.Ctx) error {
+	var req dto.ExportEPUBRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return response.SendParseError(ctx, err, c.log, "Export e-book body parse error")
+	}
+
+	if err := c.validate.Struct(req); err != nil {
+		return response.SendValidationError(ctx, err, c.log, "Export e-book validation failed")
+	}
+
+	export, err := c.exportUsecase.PrepareEPUB(ctx.UserContext(), portuc.EPUBExportInput{
+		ChapterIDs: req.ChapterIDs,
+		Language:   req.Lang,
+		Title:      req.Title,
+	})
+	if err != nil {
+		return response.SendDomainError(ctx, err, c.log)
+	}
+
+	return c.stream(ctx, export.Filename, export.ContentType, func(userCtx context.Context, w io.Writer) error {
+		return c.exportUsecase.WriteEPUB(userCtx, export, w)
+	})
+}
+
+// stream sends the file written by write as an attachment. The body is written after the
+// handler returns, when the fiber context is no longer valid, so write gets the user
+// context instead; by then the status is sent and errors can only be logged.
+func (c *ExportController) stream(ctx *fiber.Ctx, filename, contentType string, write func(context.Context, io.Writer) error) error {
+	ctx.Attachment(filename)
+	ctx.Set(fiber.HeaderContentType, contentType)
+
+	userCtx := ctx.UserContext()
+	path := ctx.Path()
+	ctx.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		if err := write(userCtx, w); err != nil {
+			c.log.Error("failed to stream export", "error", err, "path", path)
+		}
+	})
+	return nil
+}
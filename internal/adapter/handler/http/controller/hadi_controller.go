@@ -5,6 +5,7 @@ import (
 	"strconv"
 
 	"ishari-backend/internal/adapter/handler/http/dto"
+	"ishari-backend/internal/adapter/handler/http/response"
 	portuc "ishari-backend/internal/core/port/usecase"
 	"ishari-backend/pkg/logger"
 	"ishari-backend/pkg/validation"
@@ -189,3 +190,35 @@ func (h *HadiController) Delete(c *fiber.Ctx) error {
 		"message": "hadi deleted successfully",
 	})
 }
+
+// GetRepertoire handles listing the chapters and verses a hadi has recordings for
+// GET /api/hadis/:id/repertoire
+func (h *HadiController) GetRepertoire(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil || id <= 0 {
+		return response.SendBadRequest(c, "invalid hadi ID", err, nil, "")
+	}
+
+	resp, err := h.hadiUseCase.GetRepertoire(c.UserContext(), id)
+	if err != nil {
+		return response.SendDomainError(c, err, h.log)
+	}
+
+	return response.SendOK(c, resp)
+}
+
+// ListByVerse handles listing the hadi who have recorded a verse
+// GET /api/verses/:id/hadis
+func (h *HadiController) ListByVerse(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil || id <= 0 {
+		return response.SendBadRequest(c, "invalid verse ID", err, nil, "")
+	}
+
+	resp, err := h.hadiUseCase.ListByVerse(c.UserContext(), uint(id))
+	if err != nil {
+		return response.SendDomainError(c, err, h.log)
+	}
+
+	return response.SendOK(c, resp)
+}
@@ -0,0 +1,186 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"ishari-backend/internal/adapter/handler/http/response"
+	portuc "ishari-backend/internal/core/port/usecase"
+	"ishari-backend/pkg/logger"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// mediaCacheControl lets players and proxies cache media but revalidate after an hour,
+// since the file behind a media ID can be replaced.
+const mediaCacheControl = "public, max-age=3600"
+
+// errRangeNotSatisfiable is returned by parseByteRange when no requested byte lies inside the file
+var errRangeNotSatisfiable = errors.New("range not satisfiable")
+
+// MediaStreamController serves stored verse media files with HTTP range support
+type MediaStreamController struct {
+	streamUsecase portuc.MediaStreamUseCase
+	log           logger.Logger
+}
+
+// NewMediaStreamController creates a new media stream controller
+func NewMediaStreamController(streamUsecase portuc.MediaStreamUseCase, l logger.Logger) *MediaStreamController {
+	return &MediaStreamController{
+		streamUsecase: streamUsecase,
+		log:           l,
+	}
+}
+
+// Stream handles streaming a media file, honoring Range, If-Range and If-None-Match
+// GET /api/media/:id/stream
+func (c *MediaStreamController) Stream(ctx *fiber.Ctx) error {
+	id, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil || id <= 0 {
+		return response.SendBadRequest(ctx, "invalid media ID", err, nil, "")
+	}
+
+	obj, err := c.streamUsecase.Resolve(ctx.UserContext(), uint(id))
+	if err != nil {
+		return response.SendDomainError(ctx, err, c.log)
+	}
+
+	// Files hosted elsewhere cannot be proxied, let the client fetch them directly
+	if obj.ExternalURL != "" {
+		return ctx.Redirect(obj.ExternalURL, fiber.StatusFound)
+	}
+
+	info := obj.Info
+	contentType := info.ContentType
+	if contentType == "" {
+		contentType = fiber.MIMEOctetStream
+	}
+
+	ctx.Set(fiber.HeaderAcceptRanges, "bytes")
+	ctx.Set(fiber.HeaderCacheControl, mediaCacheControl)
+	if info.ETag != "" {
+		ctx.Set(fiber.HeaderETag, info.ETag)
+	}
+	if !info.LastModified.IsZero() {
+		ctx.Set(fiber.HeaderLastModified, info.LastModified.UTC().Format(http.TimeFormat))
+	}
+
+	if inm := ctx.Get(fiber.HeaderIfNoneMatch); inm != "" && etagListMatches(inm, info.ETag) {
+		return ctx.SendStatus(fiber.StatusNotModified)
+	}
+
+	offset, length, status := int64(0), info.Size, fiber.StatusOK
+	if rangeHeader := ctx.Get(fiber.HeaderRange); rangeHeader != "" && ifRangeAllows(ctx.Get(fiber.HeaderIfRange), info.ETag, info.LastModified) {
+		start, n, err := parseByteRange(rangeHeader, info.Size)
+		switch {
+		case errors.Is(err, errRangeNotSatisfiable):
+			ctx.Set(fiber.HeaderContentRange, fmt.Sprintf("bytes */%d", info.Size))
+			return ctx.SendStatus(fiber.StatusRequestedRangeNotSatisfiable)
+		case err == nil:
+			offset, length, status = start, n, fiber.StatusPartialContent
+			ctx.Set(fiber.HeaderContentRange, fmt.Sprintf("bytes %d-%d/%d", start, start+n-1, info.Size))
+		}
+		// malformed or multi-range requests fall back to the full file
+	}
+
+	ctx.Status(status)
+	ctx.Set(fiber.HeaderContentType, contentType)
+
+	if ctx.Method() == fiber.MethodHead {
+		ctx.Response().Header.SetContentLength(int(length))
+		ctx.Response().SkipBody = true
+		return nil
+	}
+
+	rc, err := c.streamUsecase.Open(ctx.UserContext(), obj, offset, length)
+	if err != nil {
+		ctx.Response().Header.Del(fiber.HeaderContentRange)
+		return response.SendDomainError(ctx, err, c.log)
+	}
+
+	// fasthttp closes the stream once the body has been written
+	return ctx.SendStream(rc, int(length))
+}
+
+// parseByteRange parses a single "bytes=" range against a file of the given size
+// and returns the offset and length to serve.
+func parseByteRange(header string, size int64) (int64, int64, error) {
+	spec, ok := strings.CutPrefix(strings.TrimSpace(header), "bytes=")
+	if !ok || strings.Contains(spec, ",") {
+		return 0, 0, errors.New("unsupported range")
+	}
+
+	first, last, ok := strings.Cut(strings.TrimSpace(spec), "-")
+	if !ok {
+		return 0, 0, errors.New("malformed range")
+	}
+
+	if first == "" {
+		// suffix range: the last N bytes
+		n, err := strconv.ParseInt(last, 10, 64)
+		if err != nil || n < 0 {
+			return 0, 0, errors.New("malformed range")
+		}
+		if n == 0 || size == 0 {
+			return 0, 0, errRangeNotSatisfiable
+		}
+		n = min(n, size)
+		return size - n, n, nil
+	}
+
+	start, err := strconv.ParseInt(first, 10, 64)
+	if err != nil || start < 0 {
+		return 0, 0, errors.New("malformed range")
+	}
+	if start >= size {
+		return 0, 0, errRangeNotSatisfiable
+	}
+
+	end := size - 1
+	if last != "" {
+		end, err = strconv.ParseInt(last, 10, 64)
+		if err != nil || end < start {
+			return 0, 0, errors.New("malformed range")
+		}
+		end = min(end, size-1)
+	}
+
+	return start, end - start + 1, nil
+}
+
+// ifRangeAllows reports whether a Range header may be honored given an If-Range validator
+func ifRangeAllows(ifRange, etag string, lastModified time.Time) bool {
+	ifRange = strings.TrimSpace(ifRange)
+	if ifRange == "" {
+		return true
+	}
+	if strings.HasPrefix(ifRange, `"`) || strings.HasPrefix(ifRange, "W/") {
+		// If-Range requires a strong comparison
+		return etag != "" && !strings.HasPrefix(etag, "W/") && ifRange == etag
+	}
+	t, err := http.ParseTime(ifRange)
+	if err != nil || lastModified.IsZero() {
+		return false
+	}
+	return !lastModified.Truncate(time.Second).After(t)
+}
+
+// etagListMatches performs the weak comparison used by If-None-Match
+func etagListMatches(list, etag string) bool {
+	if etag == "" {
+		return false
+	}
+	if strings.TrimSpace(list) == "*" {
+		return true
+	}
+	want := strings.TrimPrefix(etag, "W/")
+	for _, candidate := range strings.Split(list, ",") {
+		if strings.TrimPrefix(strings.TrimSpace(candidate), "W/") == want {
+			return true
+		}
+	}
+	return false
+}
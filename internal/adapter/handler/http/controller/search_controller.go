@@ -0,0 +1,128 @@
+package controller
+
+import (
+	"math"
+	"strconv"
+
+	"ishari-backend/internal/adapter/handler/http/dto"
+	"ishari-backend/internal/adapter/handler/http/response"
+	"ishari-backend/internal/core/entity"
+	portuc "ishari-backend/internal/core/port/usecase"
+	"ishari-backend/pkg/logger"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// SearchController handles full-text search requests
+type SearchController struct {
+	searchUsecase  portuc.SearchUseCase
+	suggestUsecase portuc.SuggestUseCase
+	log            logger.Logger
+}
+
+// NewSearchController creates a new search controller
+func NewSearchController(searchUsecase portuc.SearchUseCase, suggestUsecase portuc.SuggestUseCase, l logger.Logger) *SearchController {
+	return &SearchController{
+		searchUsecase:  searchUsecase,
+		suggestUsecase: suggestUsecase,
+		log:            l,
+	}
+}
+
+// Search handles ranked search across verses and translations
+// GET /api/search?q=&mode=&threshold=&book_id=&category=&language=&page=&limit=
+func (c *SearchController) Search(ctx *fiber.Ctx) error {
+	page, _ := strconv.Atoi(ctx.Query("page", "1"))
+	limit, _ := strconv.Atoi(ctx.Query("limit", "20"))
+	if page < 0 || limit < 0 {
+		return response.SendBadRequest(ctx, "page and limit must be positive", nil, nil, "")
+	}
+
+	params := portuc.SearchParams{
+		Query:    ctx.Query("q"),
+		Category: ctx.Query("category"),
+		Language: ctx.Query("language", ctx.Query("lang")),
+		Mode:     ctx.Query("mode"),
+		Page:     uint(page),
+		Limit:    uint(limit),
+	}
+	if raw := ctx.Query("book_id", ctx.Query("bookId")); raw != "" {
+		bookID, err := strconv.Atoi(raw)
+		if err != nil || bookID <= 0 {
+			return response.SendBadRequest(ctx, "invalid book ID", err, nil, "")
+		}
+		id := uint(bookID)
+		params.BookID = &id
+	}
+
+	if raw := ctx.Query("threshold"); raw != "" {
+		threshold, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return response.SendBadRequest(ctx, "invalid threshold", err, nil, "")
+		}
+		params.Threshold = threshold
+	}
+
+	result, err := c.searchUsecase.Search(ctx.UserContext(), params)
+	if err != nil {
+		return response.SendDomainError(ctx, err, c.log)
+	}
+
+	var totalPages int
+	if result.Limit > 0 {
+		totalPages = int(math.Ceil(float64(result.Total) / float64(result.Limit)))
+	}
+
+	out := make([]dto.SearchResultResponse, 0, len(result.Data))
+	for _, r := range result.Data {
+		out = append(out, toSearchResultResponse(r))
+	}
+
+	return response.SendPaginated(ctx, out, result.Page, result.Limit, result.Total, totalPages, len(result.Data))
+}
+
+// Suggest returns autocomplete suggestions for a typed prefix
+// GET /api/search/suggest?q=&limit=
+func (c *SearchController) Suggest(ctx *fiber.Ctx) error {
+	suggestions, err := c.suggestUsecase.Suggest(ctx.UserContext(), portuc.SuggestParams{
+		Query: ctx.Query("q"),
+		Limit: ctx.QueryInt("limit", 10),
+	})
+	if err != nil {
+		return response.SendDomainError(ctx, err, c.log)
+	}
+
+	out := make([]dto.SuggestionResponse, 0, len(suggestions))
+	for _, s := range suggestions {
+		out = append(out, dto.SuggestionResponse{
+			Type:     s.Type,
+			TargetID: s.TargetID,
+			Text:     s.Text,
+		})
+	}
+	return response.SendOK(ctx, out)
+}
+
+func toSearchResultResponse(r entity.SearchResult) dto.SearchResultResponse {
+	return dto.SearchResultResponse{
+		Source:          r.Source,
+		VerseID:         r.VerseID,
+		VerseNumber:     r.VerseNumber,
+		ArabicText:      r.ArabicText,
+		Transliteration: r.Transliteration,
+		TranslationID:   r.TranslationID,
+		LanguageCode:    r.LanguageCode,
+		Snippet:         r.Snippet,
+		Rank:            r.Rank,
+		Chapter: dto.SearchChapterResponse{
+			ID:            r.ChapterID,
+			ChapterNumber: r.ChapterNumber,
+			Title:         r.ChapterTitle,
+			Category:      r.Category,
+		},
+		Book: dto.SearchBookResponse{
+			ID:    r.BookID,
+			Title: r.BookTitle,
+		},
+	}
+}
@@ -0,0 +1,98 @@
+package controller
+
+import (
+	"ishari-backend/internal/adapter/handler/http/dto"
+	"ishari-backend/internal/adapter/handler/http/middleware"
+	"ishari-backend/internal/adapter/handler/http/response"
+	portuc "ishari-backend/internal/core/port/usecase"
+	"ishari-backend/pkg/errors"
+	"ishari-backend/pkg/logger"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// SearchHistoryController handles the current user's search history
+type SearchHistoryController struct {
+	historyUsecase portuc.SearchHistoryUseCase
+	log            logger.Logger
+}
+
+// NewSearchHistoryController creates a new search history controller
+func NewSearchHistoryController(historyUsecase portuc.SearchHistoryUseCase, l logger.Logger) *SearchHistoryController {
+	return &SearchHistoryController{
+		historyUsecase: historyUsecase,
+		log:            l,
+	}
+}
+
+// List returns the current user's searches, newest first
+// GET /api/me/search-history?page=&limit=
+func (c *SearchHistoryController) List(ctx *fiber.Ctx) error {
+	user := middleware.GetUserFromContext(ctx)
+	if user == nil {
+		return errors.Unauthorized("unauthorized: missing user")
+	}
+
+	result, err := c.historyUsecase.List(ctx.UserContext(), portuc.ListSearchHistoryInput{
+		UserID: user.UserID,
+		Page:   ctx.QueryInt("page", 1),
+		Limit:  ctx.QueryInt("limit", 20),
+	})
+	if err != nil {
+		return response.SendDomainError(ctx, err, c.log)
+	}
+
+	items := make([]dto.SearchHistoryResponse, 0, len(result.Data))
+	for _, h := range result.Data {
+		items = append(items, dto.SearchHistoryResponse{
+			ID:           h.ID,
+			SearchQuery:  h.SearchQuery,
+			SearchType:   h.SearchType,
+			ResultsCount: h.ResultsCount,
+			SearchedAt:   h.SearchedAt,
+		})
+	}
+
+	return response.SendPaginated(ctx, items, result.Page, result.Limit, result.Total, result.TotalPages, len(items))
+}
+
+// Delete removes one entry from the current user's search history
+// DELETE /api/me/search-history/:id
+func (c *SearchHistoryController) Delete(ctx *fiber.Ctx) error {
+	user := middleware.GetUserFromContext(ctx)
+	if user == nil {
+		return errors.Unauthorized("unauthorized: missing user")
+	}
+
+	id, err := ctx.ParamsInt("id")
+	if err != nil || id <= 0 {
+		return errors.BadRequest("invalid search history id")
+	}
+
+	if err := c.historyUsecase.Delete(ctx.UserContext(), uint(id), user.UserID); err != nil {
+		return response.SendDomainError(ctx, err, c.log)
+	}
+
+	return response.SendOK(ctx, fiber.Map{
+		"message": "search history entry deleted successfully",
+	})
+}
+
+// Clear removes the current user's whole search history
+// DELETE /api/me/search-history
+func (c *SearchHistoryController) Clear(ctx *fiber.Ctx) error {
+	user := middleware.GetUserFromContext(ctx)
+	if user == nil {
+		return errors.Unauthorized("unauthorized: missing user")
+	}
+
+	count, err := c.historyUsecase.Clear(ctx.UserContext(), user.UserID)
+	if err != nil {
+		return response.SendDomainError(ctx, err, c.log)
+	}
+
+	return response.SendOK(ctx, fiber.Map{
+		"message":       "search history cleared successfully",
+		"deleted_count": count,
+	})
+}
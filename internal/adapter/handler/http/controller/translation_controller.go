@@ -17,13 +17,15 @@ import (
 
 type TranslationController struct {
 	translationUsecase portuc.TranslationUseCase
+	revisionUsecase    portuc.RevisionUseCase
 	validate           validation.Validator
 	log                logger.Logger
 }
 
-func NewTranslationController(translationUsecase portuc.TranslationUseCase, validate validation.Validator, log logger.Logger) *TranslationController {
+func NewTranslationController(translationUsecase portuc.TranslationUseCase, revisionUsecase portuc.RevisionUseCase, validate validation.Validator, log logger.Logger) *TranslationController {
 	return &TranslationController{
 		translationUsecase: translationUsecase,
+		revisionUsecase:    revisionUsecase,
 		validate:           validate,
 		log:                log,
 	}
@@ -257,3 +259,39 @@ func (c *TranslationController) GetDropdown(ctx *fiber.Ctx) error {
 		LanguageCodes:   data.LanguageCodes,
 	})
 }
+
+// ListRevisions handles listing the recorded versions of a translation, newest first
+// GET /api/translations/:id/revisions
+func (c *TranslationController) ListRevisions(ctx *fiber.Ctx) error {
+	id, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil || id <= 0 {
+		return response.SendBadRequest(ctx, "invalid translation ID", err, nil, "")
+	}
+
+	revisions, err := c.revisionUsecase.ListTranslationRevisions(ctx.UserContext(), uint(id))
+	if err != nil {
+		return response.SendDomainError(ctx, err, c.log)
+	}
+
+	return response.SendOK(ctx, toRevisionResponses(revisions))
+}
+
+// RevertRevision handles restoring a recorded version of a translation
+// POST /api/translations/:id/revisions/:revisionId/revert
+func (c *TranslationController) RevertRevision(ctx *fiber.Ctx) error {
+	id, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil || id <= 0 {
+		return response.SendBadRequest(ctx, "invalid translation ID", err, nil, "")
+	}
+	revisionID, err := strconv.Atoi(ctx.Params("revisionId"))
+	if err != nil || revisionID <= 0 {
+		return response.SendBadRequest(ctx, "invalid revision ID", err, nil, "")
+	}
+
+	translation, err := c.revisionUsecase.RevertTranslation(ctx.UserContext(), uint(id), uint(revisionID))
+	if err != nil {
+		return response.SendDomainError(ctx, err, c.log)
+	}
+
+	return response.SendOK(ctx, c.toListTranslationResponse(translation))
+}
@@ -0,0 +1,182 @@
+package controller
+
+import (
+	"ishari-backend/internal/adapter/handler/http/dto"
+	"ishari-backend/internal/adapter/handler/http/response"
+	portuc "ishari-backend/internal/core/port/usecase"
+	"ishari-backend/pkg/logger"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// uploadFormField is the multipart field holding the uploaded file
+const uploadFormField = "file"
+
+// UploadController handles multipart file uploads
+type UploadController struct {
+	uploadUsecase portuc.UploadUseCase
+	log           logger.Logger
+}
+
+// NewUploadController creates a new upload controller
+func NewUploadController(uploadUsecase portuc.UploadUseCase, l logger.Logger) *UploadController {
+	return &UploadController{
+		uploadUsecase: uploadUsecase,
+		log:           l,
+	}
+}
+
+// formFile opens the uploaded file; the returned close func must be called when done
+func (c *UploadController) formFile(ctx *fiber.Ctx) (portuc.UploadFile, func(), error) {
+	header, err := ctx.FormFile(uploadFormField)
+	if err != nil {
+		return portuc.UploadFile{}, nil, err
+	}
+
+	f, err := header.Open()
+	if err != nil {
+		return portuc.UploadFile{}, nil, err
+	}
+
+	file := portuc.UploadFile{
+		Filename:    header.Filename,
+		ContentType: header.Header.Get("Content-Type"),
+		Size:        header.Size,
+		Content:     f,
+	}
+	return file, func() { _ = f.Close() }, nil
+}
+
+// UploadVerseMedia handles uploading a media file for a verse
+// POST /api/media/upload
+func (c *UploadController) UploadVerseMedia(ctx *fiber.Ctx) error {
+	verseID, err := strconv.Atoi(ctx.FormValue("verse_id"))
+	if err != nil || verseID <= 0 {
+		return response.SendBadRequest(ctx, "invalid verse ID", err, nil, "")
+	}
+
+	return c.uploadVerseMedia(ctx, uint(verseID))
+}
+
+// UploadForVerse handles uploading a media file for the verse in the path
+// POST /api/verses/:id/media/upload
+func (c *UploadController) UploadForVerse(ctx *fiber.Ctx) error {
+	verseID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil || verseID <= 0 {
+		return response.SendBadRequest(ctx, "invalid verse ID", err, nil, "")
+	}
+
+	return c.uploadVerseMedia(ctx, uint(verseID))
+}
+
+func (c *UploadController) uploadVerseMedia(ctx *fiber.Ctx, verseID uint) error {
+	input := portuc.UploadVerseMediaInput{
+		VerseID:   verseID,
+		MediaType: strings.TrimSpace(ctx.FormValue("media_type")),
+	}
+
+	if hidStr := ctx.FormValue("hadi_id"); hidStr != "" {
+		hid, err := strconv.Atoi(hidStr)
+		if err != nil || hid <= 0 {
+			return response.SendBadRequest(ctx, "invalid hadi ID", err, nil, "")
+		}
+		input.HadiID = &hid
+	}
+
+	if desc := strings.TrimSpace(ctx.FormValue("description")); desc != "" {
+		input.Description = &desc
+	}
+
+	file, closeFile, err := c.formFile(ctx)
+	if err != nil {
+		return response.SendBadRequest(ctx, "file is required", err, nil, "")
+	}
+	defer closeFile()
+	input.File = file
+
+	media, err := c.uploadUsecase.UploadVerseMedia(ctx.UserContext(), input)
+	if err != nil {
+		return response.SendDomainError(ctx, err, c.log)
+	}
+
+	return response.SendCreated(ctx, "verse media uploaded successfully", toVerseMediaResponse(media))
+}
+
+// ReplaceVerseMediaFile handles replacing the file of existing verse media
+// PUT /api/media/:id/file
+func (c *UploadController) ReplaceVerseMediaFile(ctx *fiber.Ctx) error {
+	id, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil || id <= 0 {
+		return response.SendBadRequest(ctx, "invalid media ID", err, nil, "")
+	}
+
+	file, closeFile, err := c.formFile(ctx)
+	if err != nil {
+		return response.SendBadRequest(ctx, "file is required", err, nil, "")
+	}
+	defer closeFile()
+
+	media, err := c.uploadUsecase.ReplaceVerseMediaFile(ctx.UserContext(), uint(id), file)
+	if err != nil {
+		return response.SendDomainError(ctx, err, c.log)
+	}
+
+	return response.SendOK(ctx, toVerseMediaResponse(media))
+}
+
+// UploadBookCover handles uploading a book cover image
+// POST /api/books/:id/cover
+func (c *UploadController) UploadBookCover(ctx *fiber.Ctx) error {
+	id, err := strconv.ParseInt(ctx.Params("id"), 10, 64)
+	if err != nil || id <= 0 {
+		return response.SendBadRequest(ctx, "invalid book ID", err, nil, "")
+	}
+
+	file, closeFile, err := c.formFile(ctx)
+	if err != nil {
+		return response.SendBadRequest(ctx, "file is required", err, nil, "")
+	}
+	defer closeFile()
+
+	book, err := c.uploadUsecase.UploadBookCover(ctx.UserContext(), id, file)
+	if err != nil {
+		return response.SendDomainError(ctx, err, c.log)
+	}
+
+	return response.SendOK(ctx, dto.BookResponse{
+		ID:            book.ID,
+		Title:         book.Title,
+		Author:        book.Author,
+		Description:   book.Description,
+		PublishedYear: book.PublishedYear,
+		CoverImageURL: book.CoverImageURL,
+		CoverImages:   response.MapImageVariantsResponse(book.CoverImages),
+		CreatedAt:     book.CreatedAt.UTC().Format(time.RFC3339),
+		UpdatedAt:     book.UpdatedAt.UTC().Format(time.RFC3339),
+	})
+}
+
+// UploadHadiImage handles uploading a hadi picture
+// POST /api/hadis/:id/image
+func (c *UploadController) UploadHadiImage(ctx *fiber.Ctx) error {
+	id, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil || id <= 0 {
+		return response.SendBadRequest(ctx, "invalid hadi ID", err, nil, "")
+	}
+
+	file, closeFile, err := c.formFile(ctx)
+	if err != nil {
+		return response.SendBadRequest(ctx, "file is required", err, nil, "")
+	}
+	defer closeFile()
+
+	hadi, err := c.uploadUsecase.UploadHadiImage(ctx.UserContext(), id, file)
+	if err != nil {
+		return response.SendDomainError(ctx, err, c.log)
+	}
+
+	return response.SendOK(ctx, response.MapHadiResponse(hadi))
+}
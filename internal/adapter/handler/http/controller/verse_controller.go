@@ -16,16 +16,18 @@ import (
 )
 
 type VerseController struct {
-	verseUsecase portuc.VerseUseCase
-	validate     validation.Validator
-	log          logger.Logger
+	verseUsecase    portuc.VerseUseCase
+	revisionUsecase portuc.RevisionUseCase
+	validate        validation.Validator
+	log             logger.Logger
 }
 
-func NewVerseController(verseUsecase portuc.VerseUseCase, validate validation.Validator, log logger.Logger) *VerseController {
+func NewVerseController(verseUsecase portuc.VerseUseCase, revisionUsecase portuc.RevisionUseCase, validate validation.Validator, log logger.Logger) *VerseController {
 	return &VerseController{
-		verseUsecase: verseUsecase,
-		validate:     validate,
-		log:          log,
+		verseUsecase:    verseUsecase,
+		revisionUsecase: revisionUsecase,
+		validate:        validate,
+		log:             log,
 	}
 }
 
@@ -64,13 +66,18 @@ func (c *VerseController) toListVerseResponse(verse *entity.Verse) dto.ListVerse
 			BookID:        verse.Chapter.BookID,
 			ChapterNumber: verse.Chapter.ChapterNumber,
 			Title:         verse.Chapter.Title,
-			Category:      verse.Chapter.Category,
+			CategoryID:    verse.Chapter.CategoryID,
 			Description:   verse.Chapter.Description,
 			TotalVerses:   verse.Chapter.TotalVerses,
 			CreatedAt:     verse.Chapter.CreatedAt.UTC().Format(time.RFC3339),
 			UpdatedAt:     verse.Chapter.UpdatedAt.UTC().Format(time.RFC3339),
 		}
 
+		if verse.Chapter.Category != nil {
+			chapterResp.CategorySlug = verse.Chapter.Category.Slug
+			chapterResp.Category = verse.Chapter.Category.Name
+		}
+
 		if verse.Chapter.Book != nil {
 			chapterResp.Book = &dto.BookResponse{
 				ID:            verse.Chapter.Book.ID,
@@ -79,6 +86,7 @@ func (c *VerseController) toListVerseResponse(verse *entity.Verse) dto.ListVerse
 				Description:   verse.Chapter.Book.Description,
 				PublishedYear: verse.Chapter.Book.PublishedYear,
 				CoverImageURL: verse.Chapter.Book.CoverImageURL,
+				CoverImages:   response.MapImageVariantsResponse(verse.Chapter.Book.CoverImages),
 				CreatedAt:     verse.Chapter.Book.CreatedAt.UTC().Format(time.RFC3339),
 				UpdatedAt:     verse.Chapter.Book.UpdatedAt.UTC().Format(time.RFC3339),
 			}
@@ -242,3 +250,144 @@ func (c *VerseController) BulkDelete(ctx *fiber.Ctx) error {
 
 	return response.SendOK(ctx, fiber.Map{"message": "verses deleted successfully"})
 }
+
+// Reorder handles renumbering the verses of a chapter in the given order
+// POST /api/chapters/:id/verses/reorder
+func (c *VerseController) Reorder(ctx *fiber.Ctx) error {
+	chapterID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil || chapterID <= 0 {
+		return response.SendBadRequest(ctx, "invalid chapter ID", err, nil, "")
+	}
+
+	var req dto.ReorderVersesRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return response.SendParseError(ctx, err, c.log, "Reorder verses body parse error")
+	}
+
+	if err := c.validate.Struct(req); err != nil {
+		return response.SendValidationError(ctx, err, c.log, "Reorder verses validation failed")
+	}
+
+	if err := c.verseUsecase.Reorder(ctx.UserContext(), uint(chapterID), req.IDs); err != nil {
+		return response.SendDomainError(ctx, err, c.log)
+	}
+
+	return response.SendOK(ctx, fiber.Map{"message": "verses reordered successfully"})
+}
+
+// Import handles importing the verses of a chapter from CSV or JSON. With dry_run=true
+// nothing is written and the response reports what would happen to each row.
+// POST /api/chapters/:id/verses/import
+func (c *VerseController) Import(ctx *fiber.Ctx) error {
+	chapterID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil || chapterID <= 0 {
+		return response.SendBadRequest(ctx, "invalid chapter ID", err, nil, "")
+	}
+
+	rows, err := parseImportRows(ctx)
+	if err != nil {
+		return response.SendBadRequest(ctx, "invalid import file", err, nil, "")
+	}
+
+	result, err := c.verseUsecase.Import(ctx.UserContext(), portuc.ImportVersesInput{
+		ChapterID:  uint(chapterID),
+		Rows:       rows,
+		DryRun:     ctx.QueryBool("dry_run"),
+		OnConflict: ctx.Query("on_conflict"),
+	})
+	if err != nil {
+		return response.SendDomainError(ctx, err, c.log)
+	}
+
+	res := toImportVersesResponse(result)
+	switch {
+	case result.DryRun:
+		return response.SendOK(ctx, res)
+	case !result.Valid:
+		return response.SendErrorWithData(ctx, fiber.StatusUnprocessableEntity, "import has invalid rows, nothing was written", res)
+	}
+	return response.SendSuccess(ctx, fiber.StatusOK, "verses imported successfully", res)
+}
+
+// Move handles moving a verse to a new position or chapter
+// POST /api/verses/:id/move
+func (c *VerseController) Move(ctx *fiber.Ctx) error {
+	id, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return response.SendBadRequest(ctx, "invalid verse ID", err, nil, "")
+	}
+
+	var req dto.MoveVerseRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return response.SendParseError(ctx, err, c.log, "Move verse body parse error")
+	}
+
+	if err := c.validate.Struct(req); err != nil {
+		return response.SendValidationError(ctx, err, c.log, "Move verse validation failed")
+	}
+
+	verse, err := c.verseUsecase.Move(ctx.UserContext(), uint(id), portuc.MoveVerseInput{
+		ChapterID: req.ChapterID,
+		Position:  req.Position,
+	})
+	if err != nil {
+		return response.SendDomainError(ctx, err, c.log)
+	}
+
+	return response.SendOK(ctx, c.toListVerseResponse(verse))
+}
+
+// ListRevisions handles listing the recorded versions of a verse, newest first
+// GET /api/verses/:id/revisions
+func (c *VerseController) ListRevisions(ctx *fiber.Ctx) error {
+	id, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil || id <= 0 {
+		return response.SendBadRequest(ctx, "invalid verse ID", err, nil, "")
+	}
+
+	revisions, err := c.revisionUsecase.ListVerseRevisions(ctx.UserContext(), uint(id))
+	if err != nil {
+		return response.SendDomainError(ctx, err, c.log)
+	}
+
+	return response.SendOK(ctx, toRevisionResponses(revisions))
+}
+
+// RevertRevision handles restoring a recorded version of a verse
+// POST /api/verses/:id/revisions/:revisionId/revert
+func (c *VerseController) RevertRevision(ctx *fiber.Ctx) error {
+	id, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil || id <= 0 {
+		return response.SendBadRequest(ctx, "invalid verse ID", err, nil, "")
+	}
+	revisionID, err := strconv.Atoi(ctx.Params("revisionId"))
+	if err != nil || revisionID <= 0 {
+		return response.SendBadRequest(ctx, "invalid revision ID", err, nil, "")
+	}
+
+	verse, err := c.revisionUsecase.RevertVerse(ctx.UserContext(), uint(id), uint(revisionID))
+	if err != nil {
+		return response.SendDomainError(ctx, err, c.log)
+	}
+
+	return response.SendOK(ctx, c.toListVerseResponse(verse))
+}
+
+func toImportVersesResponse(result *portuc.ImportVersesResult) dto.ImportVersesResponse {
+	rows := make([]dto.ImportRowReport, 0, len(result.Rows))
+	for _, r := range result.Rows {
+		rows = append(rows, dto.ImportRowReport{
+			Row:         r.Row,
+			VerseNumber: r.VerseNumber,
+			Action:      r.Action,
+			Errors:      r.Errors,
+		})
+	}
+	return dto.ImportVersesResponse{
+		DryRun:  result.DryRun,
+		Valid:   result.Valid,
+		Created: result.Created,
+		Updated: result.Updated,
+		Rows:    rows,
+	}
+}
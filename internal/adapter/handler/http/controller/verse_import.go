@@ -0,0 +1,136 @@
+package controller
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"ishari-backend/internal/adapter/handler/http/dto"
+	portuc "ishari-backend/internal/core/port/usecase"
+	"mime"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// csvTranslationPrefix marks the CSV columns holding a translation, e.g. translation_id
+const csvTranslationPrefix = "translation_"
+
+var errUnsupportedImportFormat = errors.New("import must be CSV or JSON, sent as the body or as a multipart file")
+
+// parseImportRows reads the rows of a verse import from the multipart file field or, when
+// there is none, from the body. The format comes from the file extension or Content-Type.
+func parseImportRows(ctx *fiber.Ctx) ([]portuc.ImportVerseRow, error) {
+	if header, err := ctx.FormFile(uploadFormField); err == nil {
+		f, err := header.Open()
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+
+		switch strings.ToLower(filepath.Ext(header.Filename)) {
+		case ".csv":
+			return parseImportCSV(f)
+		case ".json":
+			return parseImportJSON(f)
+		}
+		return parseImportAs(header.Header.Get("Content-Type"), f)
+	}
+	return parseImportAs(ctx.Get(fiber.HeaderContentType), bytes.NewReader(ctx.Body()))
+}
+
+func parseImportAs(contentType string, r io.Reader) ([]portuc.ImportVerseRow, error) {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	switch mediaType {
+	case "text/csv":
+		return parseImportCSV(r)
+	case fiber.MIMEApplicationJSON:
+		return parseImportJSON(r)
+	}
+	return nil, errUnsupportedImportFormat
+}
+
+func parseImportJSON(r io.Reader) ([]portuc.ImportVerseRow, error) {
+	var req dto.ImportVersesRequest
+	if err := json.NewDecoder(r).Decode(&req); err != nil {
+		return nil, err
+	}
+
+	rows := make([]portuc.ImportVerseRow, 0, len(req.Verses))
+	for _, v := range req.Verses {
+		rows = append(rows, portuc.ImportVerseRow{
+			VerseNumber:     v.VerseNumber,
+			ArabicText:      strings.TrimSpace(v.ArabicText),
+			Transliteration: v.Transliteration,
+			Translations:    v.Translations,
+		})
+	}
+	return rows, nil
+}
+
+// parseImportCSV reads a CSV with a header naming verse_number, arabic_text and optionally
+// transliteration and translation_<language> columns. Empty optional cells are left out.
+func parseImportCSV(r io.Reader) ([]portuc.ImportVerseRow, error) {
+	reader := csv.NewReader(r)
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("reading CSV header: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+		switch {
+		case name == "verse_number", name == "arabic_text", name == "transliteration":
+		case strings.HasPrefix(name, csvTranslationPrefix):
+		default:
+			return nil, fmt.Errorf("unknown CSV column %q", name)
+		}
+		columns[name] = i
+	}
+	for _, required := range []string{"verse_number", "arabic_text"} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("CSV column %q is required", required)
+		}
+	}
+
+	var rows []portuc.ImportVerseRow
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := reader.FieldPos(0)
+
+		cell := func(name string) string { return strings.TrimSpace(record[columns[name]]) }
+		number, err := strconv.ParseUint(cell("verse_number"), 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: verse_number must be a whole number", line)
+		}
+
+		row := portuc.ImportVerseRow{VerseNumber: uint(number), ArabicText: cell("arabic_text")}
+		for name := range columns {
+			value := cell(name)
+			if value == "" {
+				continue
+			}
+			if name == "transliteration" {
+				row.Transliteration = &value
+			} else if lang, ok := strings.CutPrefix(name, csvTranslationPrefix); ok {
+				if row.Translations == nil {
+					row.Translations = make(map[string]string)
+				}
+				row.Translations[lang] = value
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
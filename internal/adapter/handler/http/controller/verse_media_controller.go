@@ -0,0 +1,253 @@
+package controller
+
+import (
+	"ishari-backend/internal/adapter/handler/http/dto"
+	"ishari-backend/internal/adapter/handler/http/response"
+	"ishari-backend/internal/core/entity"
+	portuc "ishari-backend/internal/core/port/usecase"
+	"ishari-backend/pkg/logger"
+	"ishari-backend/pkg/validation"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// VerseMediaController handles verse media HTTP requests
+type VerseMediaController struct {
+	mediaUsecase portuc.VerseMediaUseCase
+	validate     validation.Validator
+	log          logger.Logger
+}
+
+// NewVerseMediaController creates a new verse media controller
+func NewVerseMediaController(mediaUsecase portuc.VerseMediaUseCase, v validation.Validator, l logger.Logger) *VerseMediaController {
+	return &VerseMediaController{
+		mediaUsecase: mediaUsecase,
+		validate:     v,
+		log:          l,
+	}
+}
+
+// toVerseMediaResponse maps a verse media entity to its API representation
+func toVerseMediaResponse(media *entity.VerseMedia) dto.VerseMediaResponse {
+	resp := dto.VerseMediaResponse{
+		ID:          media.ID,
+		VerseID:     media.VerseID,
+		MediaType:   media.MediaType,
+		MediaURL:    media.MediaURL,
+		FileSize:    media.FileSize,
+		Duration:    media.Duration,
+		Codec:       media.Codec,
+		Bitrate:     media.Bitrate,
+		Description: media.Description,
+		HadiID:      media.HadiID,
+		CreatedAt:   media.CreatedAt.UTC().Format(time.RFC3339),
+	}
+
+	if media.Verse != nil {
+		resp.Verse = &dto.VerseDropdownItem{
+			ID:         media.Verse.ID,
+			ArabicText: media.Verse.ArabicText,
+		}
+	}
+
+	if media.Hadi != nil {
+		resp.Hadi = &dto.MediaHadiItem{
+			ID:       media.Hadi.ID,
+			Name:     media.Hadi.Name,
+			ImageURL: media.Hadi.ImageURL,
+		}
+	}
+
+	return resp
+}
+
+// parseListParams reads pagination and filter query parameters shared by list endpoints
+func (c *VerseMediaController) parseListParams(ctx *fiber.Ctx) portuc.ListVerseMediaParams {
+	page, _ := strconv.Atoi(ctx.Query("page", "1"))
+	limit, _ := strconv.Atoi(ctx.Query("limit", "20"))
+
+	params := portuc.ListVerseMediaParams{
+		Page:      uint(page),
+		Limit:     uint(limit),
+		MediaType: ctx.Query("media_type", ctx.Query("mediaType", "")),
+	}
+
+	if vidStr := ctx.Query("verse_id", ctx.Query("verseId", "")); vidStr != "" {
+		if vid, err := strconv.Atoi(vidStr); err == nil && vid > 0 {
+			u := uint(vid)
+			params.VerseID = &u
+		}
+	}
+
+	if hidStr := ctx.Query("hadi_id", ctx.Query("hadiId", "")); hidStr != "" {
+		if hid, err := strconv.Atoi(hidStr); err == nil && hid > 0 {
+			params.HadiID = &hid
+		}
+	}
+
+	return params
+}
+
+func (c *VerseMediaController) sendList(ctx *fiber.Ctx, params portuc.ListVerseMediaParams) error {
+	result, err := c.mediaUsecase.List(ctx.UserContext(), params)
+	if err != nil {
+		return response.SendDomainError(ctx, err, c.log)
+	}
+
+	var totalPages int
+	if result.Limit > 0 {
+		totalPages = int(math.Ceil(float64(result.Total) / float64(result.Limit)))
+	}
+
+	out := make([]dto.VerseMediaResponse, 0, len(result.Data))
+	for _, media := range result.Data {
+		out = append(out, toVerseMediaResponse(&media))
+	}
+
+	return response.SendPaginated(ctx, out, result.Page, result.Limit, result.Total, totalPages, len(result.Data))
+}
+
+// List handles listing verse media
+// GET /api/media
+func (c *VerseMediaController) List(ctx *fiber.Ctx) error {
+	return c.sendList(ctx, c.parseListParams(ctx))
+}
+
+// ListByVerse handles listing media attached to a single verse
+// GET /api/verses/:id/media
+func (c *VerseMediaController) ListByVerse(ctx *fiber.Ctx) error {
+	verseID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil || verseID <= 0 {
+		return response.SendBadRequest(ctx, "invalid verse ID", err, nil, "")
+	}
+
+	params := c.parseListParams(ctx)
+	vid := uint(verseID)
+	params.VerseID = &vid
+
+	return c.sendList(ctx, params)
+}
+
+// GetByID handles getting verse media by ID
+// GET /api/media/:id
+func (c *VerseMediaController) GetByID(ctx *fiber.Ctx) error {
+	id, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return response.SendBadRequest(ctx, "invalid media ID", err, nil, "")
+	}
+
+	media, err := c.mediaUsecase.GetById(ctx.UserContext(), uint(id))
+	if err != nil {
+		return response.SendDomainError(ctx, err, c.log)
+	}
+
+	return response.SendOK(ctx, toVerseMediaResponse(media))
+}
+
+// Create handles creating verse media
+// POST /api/media
+func (c *VerseMediaController) Create(ctx *fiber.Ctx) error {
+	var req dto.CreateVerseMediaRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return response.SendParseError(ctx, err, c.log, "Create verse media body parse error")
+	}
+
+	return c.create(ctx, req)
+}
+
+// CreateForVerse handles attaching media to the verse in the path
+// POST /api/verses/:id/media
+func (c *VerseMediaController) CreateForVerse(ctx *fiber.Ctx) error {
+	verseID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil || verseID <= 0 {
+		return response.SendBadRequest(ctx, "invalid verse ID", err, nil, "")
+	}
+
+	var req dto.CreateVerseMediaRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return response.SendParseError(ctx, err, c.log, "Create verse media body parse error")
+	}
+	req.VerseID = uint(verseID)
+
+	return c.create(ctx, req)
+}
+
+func (c *VerseMediaController) create(ctx *fiber.Ctx, req dto.CreateVerseMediaRequest) error {
+	if err := c.validate.Struct(req); err != nil {
+		return response.SendValidationError(ctx, err, c.log, "Create verse media validation failed")
+	}
+
+	input := portuc.CreateVerseMediaInput{
+		VerseID:     req.VerseID,
+		MediaType:   req.MediaType,
+		MediaURL:    req.MediaURL,
+		FileSize:    req.FileSize,
+		Duration:    req.Duration,
+		Codec:       req.Codec,
+		Bitrate:     req.Bitrate,
+		Description: req.Description,
+		HadiID:      req.HadiID,
+	}
+
+	media, err := c.mediaUsecase.Create(ctx.UserContext(), input)
+	if err != nil {
+		return response.SendDomainError(ctx, err, c.log)
+	}
+
+	return response.SendCreated(ctx, "verse media created successfully", toVerseMediaResponse(media))
+}
+
+// Update handles updating verse media
+// PUT /api/media/:id
+func (c *VerseMediaController) Update(ctx *fiber.Ctx) error {
+	id, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return response.SendBadRequest(ctx, "invalid media ID", err, nil, "")
+	}
+
+	var req dto.UpdateVerseMediaRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return response.SendParseError(ctx, err, c.log, "Update verse media body parse error")
+	}
+
+	if err := c.validate.Struct(req); err != nil {
+		return response.SendValidationError(ctx, err, c.log, "Update verse media validation failed")
+	}
+
+	input := portuc.UpdateVerseMediaInput{
+		VerseID:     req.VerseID,
+		MediaType:   req.MediaType,
+		MediaURL:    req.MediaURL,
+		FileSize:    req.FileSize,
+		Duration:    req.Duration,
+		Codec:       req.Codec,
+		Bitrate:     req.Bitrate,
+		Description: req.Description,
+		HadiID:      req.HadiID,
+	}
+
+	media, err := c.mediaUsecase.Update(ctx.UserContext(), uint(id), input)
+	if err != nil {
+		return response.SendDomainError(ctx, err, c.log)
+	}
+
+	return response.SendOK(ctx, toVerseMediaResponse(media))
+}
+
+// Delete handles deleting verse media
+// DELETE /api/media/:id
+func (c *VerseMediaController) Delete(ctx *fiber.Ctx) error {
+	id, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return response.SendBadRequest(ctx, "invalid media ID", err, nil, "")
+	}
+
+	if err := c.mediaUsecase.Delete(ctx.UserContext(), uint(id)); err != nil {
+		return response.SendDomainError(ctx, err, c.log)
+	}
+
+	return response.SendOK(ctx, "verse media deleted successfully")
+}
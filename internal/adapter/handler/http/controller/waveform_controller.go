@@ -0,0 +1,56 @@
+package controller
+
+import (
+	"ishari-backend/internal/adapter/handler/http/dto"
+	"ishari-backend/internal/adapter/handler/http/response"
+	portuc "ishari-backend/internal/core/port/usecase"
+	"ishari-backend/pkg/logger"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// WaveformController serves precomputed waveform peaks for audio media
+type WaveformController struct {
+	waveformUsecase portuc.WaveformUseCase
+	log             logger.Logger
+}
+
+// NewWaveformController creates a new waveform controller
+func NewWaveformController(waveformUsecase portuc.WaveformUseCase, l logger.Logger) *WaveformController {
+	return &WaveformController{
+		waveformUsecase: waveformUsecase,
+		log:             l,
+	}
+}
+
+// Get handles fetching the waveform peaks of an audio media
+// GET /api/media/:id/waveform?points=N
+func (c *WaveformController) Get(ctx *fiber.Ctx) error {
+	id, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil || id <= 0 {
+		return response.SendBadRequest(ctx, "invalid media ID", err, nil, "")
+	}
+
+	points := 0
+	if raw := ctx.Query("points"); raw != "" {
+		points, err = strconv.Atoi(raw)
+		if err != nil {
+			return response.SendBadRequest(ctx, "invalid points", err, nil, "")
+		}
+	}
+
+	waveform, err := c.waveformUsecase.Get(ctx.UserContext(), uint(id), points)
+	if err != nil {
+		return response.SendDomainError(ctx, err, c.log)
+	}
+
+	// peaks only change when the file is replaced, which also changes the stream ETag
+	ctx.Set(fiber.HeaderCacheControl, mediaCacheControl)
+	return response.SendOK(ctx, dto.WaveformResponse{
+		MediaID:  waveform.MediaID,
+		Points:   waveform.Points,
+		Duration: waveform.Duration,
+		Peaks:    waveform.Peaks,
+	})
+}
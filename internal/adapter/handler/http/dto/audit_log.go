@@ -0,0 +1,32 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// AuditLogResponse represents a recorded change to an audited table
+type AuditLogResponse struct {
+	ID                uint            `json:"id"`
+	TableName         string          `json:"table_name"`
+	RecordID          uint            `json:"record_id"`
+	Operation         string          `json:"operation"`
+	OldData           json.RawMessage `json:"old_data"`
+	NewData           json.RawMessage `json:"new_data"`
+	ChangedByUserID   *uint           `json:"changed_by_user_id"`
+	ChangedByUsername *string         `json:"changed_by_username"`
+	ChangedAt         time.Time       `json:"changed_at"`
+}
+
+// AuditLogDetailResponse is an audit log entry with the columns that changed
+type AuditLogDetailResponse struct {
+	AuditLogResponse
+	Changes []FieldChangeResponse `json:"changes"`
+}
+
+// FieldChangeResponse represents the old and new value of a changed column
+type FieldChangeResponse struct {
+	Field string          `json:"field"`
+	Old   json.RawMessage `json:"old"`
+	New   json.RawMessage `json:"new"`
+}
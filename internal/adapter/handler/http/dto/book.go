@@ -9,12 +9,51 @@ type CreateBookRequest struct {
 }
 
 type BookResponse struct {
-	ID            int     `json:"id"`
-	Title         string  `json:"title"`
-	Author        *string `json:"author,omitempty"`
-	Description   *string `json:"description,omitempty"`
-	PublishedYear *int    `json:"published_year,omitempty"`
-	CoverImageURL *string `json:"cover_image_url,omitempty"`
-	CreatedAt     string  `json:"created_at"`
-	UpdatedAt     string  `json:"updated_at"`
+	ID            int                    `json:"id"`
+	Title         string                 `json:"title"`
+	Author        *string                `json:"author,omitempty"`
+	Description   *string                `json:"description,omitempty"`
+	PublishedYear *int                   `json:"published_year,omitempty"`
+	CoverImageURL *string                `json:"cover_image_url,omitempty"`
+	CoverImages   *ImageVariantsResponse `json:"cover_images,omitempty"`
+	CreatedAt     string                 `json:"created_at"`
+	UpdatedAt     string                 `json:"updated_at"`
+}
+
+// ImageVariantsResponse holds the URLs of the resized copies of an uploaded image
+type ImageVariantsResponse struct {
+	Thumb  string `json:"thumb"`
+	Medium string `json:"medium"`
+	Large  string `json:"large"`
+}
+
+// BookTOCResponse represents a book's table of contents
+type BookTOCResponse struct {
+	Book       BookResponse          `json:"book"`
+	Categories []TOCCategoryResponse `json:"categories"`
+}
+
+// TOCCategoryResponse represents a chapter category in the table of contents
+type TOCCategoryResponse struct {
+	ID       uint                 `json:"id"`
+	Slug     string               `json:"slug"`
+	Name     string               `json:"name"`
+	Chapters []TOCChapterResponse `json:"chapters"`
+}
+
+// TOCChapterResponse represents a chapter entry in the table of contents
+type TOCChapterResponse struct {
+	ID            uint                          `json:"id"`
+	ChapterNumber uint                          `json:"chapter_number"`
+	Title         string                        `json:"title"`
+	VerseCount    uint                          `json:"verse_count"`
+	HasAudio      bool                          `json:"has_audio"`
+	Translations  []TranslationCoverageResponse `json:"translations"`
+}
+
+// TranslationCoverageResponse represents how much of a chapter is translated into a language
+type TranslationCoverageResponse struct {
+	LanguageCode     string  `json:"language_code"`
+	TranslatedVerses uint    `json:"translated_verses"`
+	Coverage         float64 `json:"coverage"`
 }
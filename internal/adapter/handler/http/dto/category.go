@@ -0,0 +1,29 @@
+package dto
+
+// CategoryResponse represents a chapter category
+type CategoryResponse struct {
+	ID          uint    `json:"id"`
+	Slug        string  `json:"slug"`
+	Name        string  `json:"name"`
+	SortOrder   int     `json:"sort_order"`
+	Description *string `json:"description,omitempty"`
+	CreatedAt   string  `json:"created_at"`
+	UpdatedAt   string  `json:"updated_at"`
+}
+
+// CreateCategoryRequest represents the HTTP request for creating a category.
+// The slug is derived from the name when omitted.
+type CreateCategoryRequest struct {
+	Slug        string  `json:"slug" validate:"omitempty,max=100"`
+	Name        string  `json:"name" validate:"required,max=100"`
+	SortOrder   int     `json:"sort_order"`
+	Description *string `json:"description"`
+}
+
+// UpdateCategoryRequest represents the HTTP request for updating a category
+type UpdateCategoryRequest struct {
+	Slug        *string `json:"slug" validate:"omitempty,max=100"`
+	Name        *string `json:"name" validate:"omitempty,max=100"`
+	SortOrder   *int    `json:"sort_order"`
+	Description *string `json:"description"`
+}
@@ -6,6 +6,8 @@ type ListChapterResponse struct {
 	BookID        uint          `json:"book_id"`
 	ChapterNumber uint          `json:"chapter_number"`
 	Title         string        `json:"title"`
+	CategoryID    uint          `json:"category_id"`
+	CategorySlug  string        `json:"category_slug"`
 	Category      string        `json:"category"`
 	Description   *string       `json:"description,omitempty"`
 	TotalVerses   uint          `json:"total_verses"`
@@ -19,9 +21,8 @@ type CreateChapterRequest struct {
 	BookID        uint    `json:"book_id" validate:"required"`
 	ChapterNumber uint    `json:"chapter_number" validate:"required"`
 	Title         string  `json:"title" validate:"required"`
-	Category      string  `json:"category" validate:"required"`
+	CategoryID    uint    `json:"category_id" validate:"required"`
 	Description   *string `json:"description"`
-	TotalVerses   uint    `json:"total_verses" validate:"required"`
 }
 
 // UpdateChapterRequest represents the HTTP request for updating a chapter
@@ -29,12 +30,63 @@ type UpdateChapterRequest struct {
 	BookID        *uint   `json:"book_id"`
 	ChapterNumber *uint   `json:"chapter_number"`
 	Title         *string `json:"title"`
-	Category      *string `json:"category"`
+	CategoryID    *uint   `json:"category_id"`
 	Description   *string `json:"description"`
-	TotalVerses   *uint   `json:"total_verses"`
 }
 
 // BulkDeleteChapterRequest represents the HTTP request for bulk deleting chapters
 type BulkDeleteChapterRequest struct {
 	IDs []uint `json:"ids" query:"ids" form:"ids" validate:"required,min=1"`
 }
+
+// ReorderChaptersRequest lists every chapter of a book in its new order
+type ReorderChaptersRequest struct {
+	IDs []uint `json:"ids" validate:"required,min=1"`
+}
+
+// MoveChapterRequest moves a chapter to a position (1-based, last when omitted) in its book
+// or in book_id
+type MoveChapterRequest struct {
+	BookID   *uint `json:"book_id"`
+	Position *uint `json:"position" validate:"omitempty,min=1"`
+}
+
+// ChapterReaderResponse represents a chapter with everything needed to render it
+type ChapterReaderResponse struct {
+	Chapter ListChapterResponse   `json:"chapter"`
+	Verses  []ReaderVerseResponse `json:"verses"`
+}
+
+// ReaderVerseResponse represents a verse in the chapter reader
+type ReaderVerseResponse struct {
+	ID              uint                        `json:"id"`
+	VerseNumber     uint                        `json:"verse_number"`
+	ArabicText      string                      `json:"arabic_text"`
+	Transliteration *string                     `json:"transliteration,omitempty"`
+	Translations    []ReaderTranslationResponse `json:"translations"`
+	Media           []VerseMediaResponse        `json:"media"`
+}
+
+// ReaderTranslationResponse represents a verse translation in the chapter reader
+type ReaderTranslationResponse struct {
+	ID              uint    `json:"id"`
+	LanguageCode    string  `json:"language_code"`
+	TranslationText string  `json:"translation_text"`
+	TranslatorName  *string `json:"translator_name"`
+}
+
+// ReconcileVerseCountsResponse lists the chapters whose total_verses was corrected
+type ReconcileVerseCountsResponse struct {
+	Corrected int                            `json:"corrected"`
+	Chapters  []VerseCountCorrectionResponse `json:"chapters"`
+}
+
+// VerseCountCorrectionResponse represents a chapter whose total_verses was wrong
+type VerseCountCorrectionResponse struct {
+	ChapterID     uint   `json:"chapter_id"`
+	BookID        uint   `json:"book_id"`
+	ChapterNumber uint   `json:"chapter_number"`
+	Title         string `json:"title"`
+	StoredTotal   uint   `json:"stored_total"`
+	ActualTotal   uint   `json:"actual_total"`
+}
@@ -0,0 +1,9 @@
+package dto
+
+// ExportEPUBRequest selects the chapters of an e-book in reading order, the translation
+// printed under each verse and an optional title
+type ExportEPUBRequest struct {
+	ChapterIDs []uint `json:"chapter_ids" validate:"required,min=1,max=500"`
+	Lang       string `json:"lang" validate:"omitempty,max=10"`
+	Title      string `json:"title" validate:"omitempty,max=255"`
+}
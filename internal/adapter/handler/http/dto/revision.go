@@ -0,0 +1,16 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// RevisionResponse represents a recorded version of a verse or translation
+type RevisionResponse struct {
+	ID                uint            `json:"id"`
+	Operation         string          `json:"operation"`
+	Data              json.RawMessage `json:"data"`
+	ChangedByUserID   *uint           `json:"changed_by_user_id"`
+	ChangedByUsername *string         `json:"changed_by_username"`
+	ChangedAt         time.Time       `json:"changed_at"`
+}
@@ -0,0 +1,48 @@
+package dto
+
+import "time"
+
+// SearchResultResponse represents a single ranked search match
+type SearchResultResponse struct {
+	Source          string                `json:"source"`
+	VerseID         uint                  `json:"verse_id"`
+	VerseNumber     uint                  `json:"verse_number"`
+	ArabicText      string                `json:"arabic_text"`
+	Transliteration *string               `json:"transliteration,omitempty"`
+	TranslationID   *uint                 `json:"translation_id,omitempty"`
+	LanguageCode    string                `json:"language_code"`
+	Snippet         string                `json:"snippet"`
+	Rank            float64               `json:"rank"`
+	Chapter         SearchChapterResponse `json:"chapter"`
+	Book            SearchBookResponse    `json:"book"`
+}
+
+// SearchChapterResponse is the chapter context of a search match
+type SearchChapterResponse struct {
+	ID            uint   `json:"id"`
+	ChapterNumber uint   `json:"chapter_number"`
+	Title         string `json:"title"`
+	Category      string `json:"category"`
+}
+
+// SearchBookResponse is the book context of a search match
+type SearchBookResponse struct {
+	ID    uint   `json:"id"`
+	Title string `json:"title"`
+}
+
+// SearchHistoryResponse represents a search recorded for the current user
+type SearchHistoryResponse struct {
+	ID           uint      `json:"id"`
+	SearchQuery  string    `json:"search_query"`
+	SearchType   string    `json:"search_type"`
+	ResultsCount int       `json:"results_count"`
+	SearchedAt   time.Time `json:"searched_at"`
+}
+
+// SuggestionResponse represents an autocomplete suggestion
+type SuggestionResponse struct {
+	Type     string `json:"type"`
+	TargetID *uint  `json:"target_id"`
+	Text     string `json:"text"`
+}
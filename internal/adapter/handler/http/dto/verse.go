@@ -32,3 +32,45 @@ type UpdateVerseRequest struct {
 type BulkDeleteVerseRequest struct {
 	IDs []uint `json:"ids" query:"ids" form:"ids" validate:"required,min=1"`
 }
+
+// ReorderVersesRequest lists every verse of a chapter in its new order
+type ReorderVersesRequest struct {
+	IDs []uint `json:"ids" validate:"required,min=1"`
+}
+
+// MoveVerseRequest moves a verse to a position (1-based, last when omitted) in its chapter
+// or in chapter_id
+type MoveVerseRequest struct {
+	ChapterID *uint `json:"chapter_id"`
+	Position  *uint `json:"position" validate:"omitempty,min=1"`
+}
+
+// ImportVersesRequest is the JSON form of a verse import
+type ImportVersesRequest struct {
+	Verses []ImportVerseRow `json:"verses"`
+}
+
+// ImportVerseRow is one verse of an import; translations maps language codes to text
+type ImportVerseRow struct {
+	VerseNumber     uint              `json:"verse_number"`
+	ArabicText      string            `json:"arabic_text"`
+	Transliteration *string           `json:"transliteration,omitempty"`
+	Translations    map[string]string `json:"translations,omitempty"`
+}
+
+// ImportVersesResponse reports a verse import row by row
+type ImportVersesResponse struct {
+	DryRun  bool              `json:"dry_run"`
+	Valid   bool              `json:"valid"`
+	Created int               `json:"created"`
+	Updated int               `json:"updated"`
+	Rows    []ImportRowReport `json:"rows"`
+}
+
+// ImportRowReport is the outcome of one import row
+type ImportRowReport struct {
+	Row         int      `json:"row"`
+	VerseNumber uint     `json:"verse_number"`
+	Action      string   `json:"action"`
+	Errors      []string `json:"errors,omitempty"`
+}
@@ -0,0 +1,59 @@
+package dto
+
+// CreateVerseMediaRequest represents the HTTP request for attaching media to a verse
+type CreateVerseMediaRequest struct {
+	VerseID     uint    `json:"verse_id" validate:"required"`
+	MediaType   string  `json:"media_type" validate:"required,oneof=audio image"`
+	MediaURL    string  `json:"media_url" validate:"required"`
+	FileSize    *int    `json:"file_size,omitempty" validate:"omitempty,min=0"`
+	Duration    *int    `json:"duration,omitempty" validate:"omitempty,min=0"`
+	Codec       *string `json:"codec,omitempty" validate:"omitempty,max=20"`
+	Bitrate     *int    `json:"bitrate,omitempty" validate:"omitempty,min=0"`
+	Description *string `json:"description,omitempty"`
+	HadiID      *int    `json:"hadi_id,omitempty" validate:"omitempty,min=1"`
+}
+
+// UpdateVerseMediaRequest represents the HTTP request for updating verse media
+type UpdateVerseMediaRequest struct {
+	VerseID     *uint   `json:"verse_id" validate:"omitempty,min=1"`
+	MediaType   *string `json:"media_type" validate:"omitempty,oneof=audio image"`
+	MediaURL    *string `json:"media_url" validate:"omitempty,min=1"`
+	FileSize    *int    `json:"file_size" validate:"omitempty,min=0"`
+	Duration    *int    `json:"duration" validate:"omitempty,min=0"`
+	Codec       *string `json:"codec" validate:"omitempty,max=20"`
+	Bitrate     *int    `json:"bitrate" validate:"omitempty,min=0"`
+	Description *string `json:"description"`
+	HadiID      *int    `json:"hadi_id" validate:"omitempty,min=1"`
+}
+
+// MediaHadiItem is a minimal hadi representation embedded in media responses
+type MediaHadiItem struct {
+	ID       int     `json:"id"`
+	Name     string  `json:"name"`
+	ImageURL *string `json:"image_url,omitempty"`
+}
+
+// VerseMediaResponse represents the HTTP response for verse media
+type VerseMediaResponse struct {
+	ID          uint               `json:"id"`
+	VerseID     uint               `json:"verse_id"`
+	Verse       *VerseDropdownItem `json:"verse,omitempty"`
+	MediaType   string             `json:"media_type"`
+	MediaURL    string             `json:"media_url"`
+	FileSize    *int               `json:"file_size,omitempty"`
+	Duration    *int               `json:"duration,omitempty"`
+	Codec       *string            `json:"codec,omitempty"`
+	Bitrate     *int               `json:"bitrate,omitempty"`
+	Description *string            `json:"description,omitempty"`
+	HadiID      *int               `json:"hadi_id,omitempty"`
+	Hadi        *MediaHadiItem     `json:"hadi,omitempty"`
+	CreatedAt   string             `json:"created_at"`
+}
+
+// WaveformResponse represents the HTTP response for an audio waveform
+type WaveformResponse struct {
+	MediaID  uint      `json:"media_id"`
+	Points   int       `json:"points"`
+	Duration *int      `json:"duration,omitempty"`
+	Peaks    []float64 `json:"peaks"`
+}
@@ -0,0 +1,23 @@
+package http
+
+import (
+	"ishari-backend/internal/adapter/handler/http/controller"
+	"ishari-backend/internal/adapter/handler/http/middleware"
+	portuc "ishari-backend/internal/core/port/usecase"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// RegisterExportRoutes registers the content export routes. Exports read whole books, so
+// they require a signed-in user.
+func RegisterExportRoutes(router fiber.Router, ctrl *controller.ExportController, authUC portuc.AuthUseCase) {
+	exports := router.Group("/exports", middleware.AuthMiddleware(authUC))
+	exports.Post("/epub", ctrl.ExportEPUB)
+}
+
+// RegisterBookExportRoutes registers the exports of a whole book on the protected group
+// returned by RegisterBookRoutes
+func RegisterBookExportRoutes(books fiber.Router, ctrl *controller.ExportController) {
+	books.Get("/:id/export", ctrl.ExportBook)
+	books.Get("/:id/export.epub", ctrl.ExportBookEPUB)
+}
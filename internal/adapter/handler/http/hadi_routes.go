@@ -14,6 +14,7 @@ func RegisterHadiRoutes(api fiber.Router, ctrl *controller.HadiController, authU
 	// Public routes
 	hadis.Get("/", ctrl.List)
 	hadis.Get("/:id", ctrl.GetByID)
+	hadis.Get("/:id/repertoire", ctrl.GetRepertoire)
 
 	// Protected block
 	adminBlock := hadis.Group("")
@@ -24,3 +25,9 @@ func RegisterHadiRoutes(api fiber.Router, ctrl *controller.HadiController, authU
 	adminBlock.Put("/:id", ctrl.Update)
 	adminBlock.Delete("/:id", ctrl.Delete)
 }
+
+// RegisterVerseHadiRoutes registers the public GET /verses/:id/hadis route.
+// It must run before RegisterVerseRoutes, whose auth group guards the /verses prefix.
+func RegisterVerseHadiRoutes(router fiber.Router, ctrl *controller.HadiController) {
+	router.Get("/verses/:id/hadis", ctrl.ListByVerse)
+}
@@ -0,0 +1,14 @@
+package http
+
+import (
+	"ishari-backend/internal/adapter/handler/http/controller"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// RegisterMediaStreamRoutes registers the public media streaming route.
+// It must run before RegisterVerseMediaRoutes, whose admin group guards the /media prefix.
+func RegisterMediaStreamRoutes(router fiber.Router, ctrl *controller.MediaStreamController) {
+	// Get also answers HEAD requests, which players use to probe size and range support
+	router.Get("/media/:id/stream", ctrl.Stream)
+}
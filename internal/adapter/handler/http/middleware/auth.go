@@ -52,6 +52,28 @@ func AuthMiddleware(authUC portuc.AuthUseCase) fiber.Handler {
 	}
 }
 
+// OptionalAuthMiddleware identifies the user when a valid bearer token is sent, for public
+// routes that behave differently for signed-in users. Missing or invalid tokens are ignored.
+func OptionalAuthMiddleware(authUC portuc.AuthUseCase) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		parts := strings.Split(c.Get("Authorization"), " ")
+		if len(parts) != 2 || strings.ToLower(parts[0]) != "bearer" {
+			return c.Next()
+		}
+
+		claims, err := authUC.ValidateToken(c.UserContext(), parts[1])
+		if err != nil {
+			return c.Next()
+		}
+
+		c.Locals("user", claims)
+		c.Locals("token", parts[1])
+		c.SetUserContext(portuc.NewContextWithUser(c.UserContext(), claims))
+
+		return c.Next()
+	}
+}
+
 // GetUserFromContext retrieves user claims from fiber context
 func GetUserFromContext(c *fiber.Ctx) *portuc.TokenClaims {
 	claims, ok := c.Locals("user").(*portuc.TokenClaims)
@@ -0,0 +1,52 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+
+	"ishari-backend/internal/core/port/repository"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// errErrorResponse rolls back the unit of work of a request answered with an error status
+var errErrorResponse = errors.New("request answered with an error status")
+
+// Transaction runs each mutating request in one unit of work, so its writes commit together
+// and the audit log attributes them to the signed-in user. The unit is rolled back when the
+// handler fails or answers with a 4xx/5xx status. Requests for which skip returns true run
+// without a unit and must open their own transactions; skip may be nil.
+func Transaction(transactor repository.Transactor, skip func(c *fiber.Ctx) bool) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		switch c.Method() {
+		case fiber.MethodGet, fiber.MethodHead, fiber.MethodOptions:
+			return c.Next()
+		}
+		if skip != nil && skip(c) {
+			return c.Next()
+		}
+
+		var handlerErr error
+		err := transactor.WithinTransaction(c.UserContext(), func(ctx context.Context) error {
+			c.SetUserContext(ctx)
+			if handlerErr = c.Next(); handlerErr != nil {
+				return handlerErr
+			}
+			if c.Response().StatusCode() >= fiber.StatusBadRequest {
+				return errErrorResponse
+			}
+			return nil
+		})
+		if handlerErr != nil || errors.Is(err, errErrorResponse) {
+			return handlerErr
+		}
+		if err != nil {
+			// The handler already wrote a success response; replace it since nothing was saved
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"status":  "error",
+				"message": "failed to commit changes",
+			})
+		}
+		return nil
+	}
+}
@@ -0,0 +1,142 @@
+package middleware_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"ishari-backend/internal/adapter/handler/http/middleware"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// MockTransactor is a manual mock for testing. It records whether the unit committed.
+type MockTransactor struct {
+	Calls     int
+	Committed bool
+	// FnErr is the error the unit of work returned, nil when it succeeded
+	FnErr     error
+	CommitErr error
+}
+
+func (m *MockTransactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	m.Calls++
+	if m.FnErr = fn(ctx); m.FnErr != nil {
+		return m.FnErr
+	}
+	if m.CommitErr != nil {
+		return m.CommitErr
+	}
+	m.Committed = true
+	return nil
+}
+
+func newApp(transactor *MockTransactor, skip func(c *fiber.Ctx) bool, handler fiber.Handler) *fiber.App {
+	app := fiber.New()
+	app.Use(middleware.Transaction(transactor, skip))
+	app.All("/verses", handler)
+	return app
+}
+
+func created(c *fiber.Ctx) error {
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{"status": "success"})
+}
+
+func TestTransaction_SkipsSafeMethods(t *testing.T) {
+	for _, method := range []string{fiber.MethodGet, fiber.MethodHead, fiber.MethodOptions} {
+		t.Run(method, func(t *testing.T) {
+			transactor := &MockTransactor{}
+			app := newApp(transactor, nil, created)
+
+			if _, err := app.Test(httptest.NewRequest(method, "/verses", nil)); err != nil {
+				t.Fatalf("request failed: %v", err)
+			}
+			if transactor.Calls != 0 {
+				t.Errorf("expected no transaction for %s, got %d", method, transactor.Calls)
+			}
+		})
+	}
+}
+
+func TestTransaction_CommitsSuccessfulRequest(t *testing.T) {
+	transactor := &MockTransactor{}
+	app := newApp(transactor, nil, created)
+
+	resp, err := app.Test(httptest.NewRequest(fiber.MethodPost, "/verses", nil))
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	if resp.StatusCode != fiber.StatusCreated {
+		t.Errorf("expected status 201, got %d", resp.StatusCode)
+	}
+	if transactor.Calls != 1 || !transactor.Committed {
+		t.Errorf("expected one committed transaction, got %d calls, committed %v", transactor.Calls, transactor.Committed)
+	}
+}
+
+func TestTransaction_RollsBackOnHandlerError(t *testing.T) {
+	transactor := &MockTransactor{}
+	handlerErr := fiber.NewError(fiber.StatusConflict, "verse number taken")
+	app := newApp(transactor, nil, func(c *fiber.Ctx) error { return handlerErr })
+
+	resp, err := app.Test(httptest.NewRequest(fiber.MethodPost, "/verses", nil))
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	if !errors.Is(transactor.FnErr, handlerErr) || transactor.Committed {
+		t.Errorf("expected a rollback with the handler error, got %v", transactor.FnErr)
+	}
+	if resp.StatusCode != fiber.StatusConflict {
+		t.Errorf("expected the handler error to be answered with 409, got %d", resp.StatusCode)
+	}
+}
+
+func TestTransaction_RollsBackOnErrorStatus(t *testing.T) {
+	transactor := &MockTransactor{}
+	app := newApp(transactor, nil, func(c *fiber.Ctx) error {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"status": "error", "message": "invalid verse"})
+	})
+
+	resp, err := app.Test(httptest.NewRequest(fiber.MethodPut, "/verses", nil))
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	if transactor.FnErr == nil || transactor.Committed {
+		t.Error("expected a 400 response to roll the transaction back")
+	}
+	body, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode != fiber.StatusBadRequest || !strings.Contains(string(body), "invalid verse") {
+		t.Errorf("expected the handler response to be kept, got %d %s", resp.StatusCode, body)
+	}
+}
+
+func TestTransaction_CommitFailureAnswers500(t *testing.T) {
+	transactor := &MockTransactor{CommitErr: errors.New("serialization failure")}
+	app := newApp(transactor, nil, created)
+
+	resp, err := app.Test(httptest.NewRequest(fiber.MethodDelete, "/verses", nil))
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode != fiber.StatusInternalServerError || !strings.Contains(string(body), "failed to commit changes") {
+		t.Errorf("expected 500 replacing the success response, got %d %s", resp.StatusCode, body)
+	}
+}
+
+func TestTransaction_SkippedRequestRunsWithoutUnit(t *testing.T) {
+	transactor := &MockTransactor{}
+	skip := func(c *fiber.Ctx) bool { return c.Path() == "/verses" }
+	app := newApp(transactor, skip, created)
+
+	resp, err := app.Test(httptest.NewRequest(fiber.MethodPost, "/verses", nil))
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	if resp.StatusCode != fiber.StatusCreated || transactor.Calls != 0 {
+		t.Errorf("expected the handler to run without a transaction, got %d and %d calls", resp.StatusCode, transactor.Calls)
+	}
+}
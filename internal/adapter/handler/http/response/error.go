@@ -10,9 +10,10 @@ import (
 
 // ErrorResponse represents a standard error response structure
 type ErrorResponse struct {
-	Status  string `json:"status"`
-	Message string `json:"message"`
-	Error   string `json:"error,omitempty"`
+	Status  string      `json:"status"`
+	Message string      `json:"message"`
+	Error   string      `json:"error,omitempty"`
+	Data    interface{} `json:"data,omitempty"`
 }
 
 // SendError sends a standardized error response
@@ -33,6 +34,16 @@ func SendError(ctx *fiber.Ctx, statusCode int, message string, err error, log lo
 	return ctx.Status(statusCode).JSON(response)
 }
 
+// SendErrorWithData sends an error response carrying data that explains it, such as a
+// per-item validation report
+func SendErrorWithData(ctx *fiber.Ctx, statusCode int, message string, data interface{}) error {
+	return ctx.Status(statusCode).JSON(ErrorResponse{
+		Status:  "error",
+		Message: message,
+		Data:    data,
+	})
+}
+
 // SendBadRequest sends a 400 Bad Request error
 func SendBadRequest(ctx *fiber.Ctx, message string, err error, log logger.Logger, logContext string) error {
 	return SendError(ctx, fiber.StatusBadRequest, message, err, log, logContext)
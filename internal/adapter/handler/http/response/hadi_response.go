@@ -3,16 +3,18 @@ package response
 import (
 	"time"
 
+	"ishari-backend/internal/adapter/handler/http/dto"
 	"ishari-backend/internal/core/entity"
 )
 
 type HadiResponse struct {
-	ID          int       `json:"id"`
-	Name        string    `json:"name"`
-	Description *string   `json:"description,omitempty"`
-	ImageURL    *string   `json:"image_url,omitempty"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID          int                        `json:"id"`
+	Name        string                     `json:"name"`
+	Description *string                    `json:"description,omitempty"`
+	ImageURL    *string                    `json:"image_url,omitempty"`
+	Images      *dto.ImageVariantsResponse `json:"images,omitempty"`
+	CreatedAt   time.Time                  `json:"created_at"`
+	UpdatedAt   time.Time                  `json:"updated_at"`
 }
 
 func MapHadiResponse(h *entity.Hadi) *HadiResponse {
@@ -25,11 +27,24 @@ func MapHadiResponse(h *entity.Hadi) *HadiResponse {
 		Name:        h.Name,
 		Description: h.Description,
 		ImageURL:    h.ImageURL,
+		Images:      MapImageVariantsResponse(h.Images),
 		CreatedAt:   h.CreatedAt,
 		UpdatedAt:   h.UpdatedAt,
 	}
 }
 
+// MapImageVariantsResponse maps generated image variants, nil when none were generated
+func MapImageVariantsResponse(v *entity.ImageVariants) *dto.ImageVariantsResponse {
+	if v == nil {
+		return nil
+	}
+	return &dto.ImageVariantsResponse{
+		Thumb:  v.Thumb,
+		Medium: v.Medium,
+		Large:  v.Large,
+	}
+}
+
 func MapHadiListResponse(hadis []entity.Hadi) []HadiResponse {
 	responses := make([]HadiResponse, 0, len(hadis))
 	for _, h := range hadis {
@@ -37,3 +52,54 @@ func MapHadiListResponse(hadis []entity.Hadi) []HadiResponse {
 	}
 	return responses
 }
+
+// HadiRepertoireResponse lists the verses a hadi has recordings for, grouped by book and category
+type HadiRepertoireResponse struct {
+	Hadi            *HadiResponse         `json:"hadi"`
+	TotalRecordings int                   `json:"total_recordings"`
+	Books           []RepertoireBookGroup `json:"books"`
+}
+
+// RepertoireBookGroup groups repertoire chapters of one book by category
+type RepertoireBookGroup struct {
+	ID         uint                      `json:"id"`
+	Title      string                    `json:"title"`
+	Categories []RepertoireCategoryGroup `json:"categories"`
+}
+
+// RepertoireCategoryGroup holds the repertoire chapters of one category
+type RepertoireCategoryGroup struct {
+	Category string              `json:"category"`
+	Chapters []RepertoireChapter `json:"chapters"`
+}
+
+// RepertoireChapter holds the recorded verses of a chapter
+type RepertoireChapter struct {
+	ID            uint              `json:"id"`
+	ChapterNumber uint              `json:"chapter_number"`
+	Title         string            `json:"title"`
+	Verses        []RepertoireVerse `json:"verses"`
+}
+
+// RepertoireVerse holds the recordings of a verse
+type RepertoireVerse struct {
+	ID          uint            `json:"id"`
+	VerseNumber uint            `json:"verse_number"`
+	Recordings  []RecordingItem `json:"recordings"`
+}
+
+// RecordingItem is a minimal audio media representation
+type RecordingItem struct {
+	MediaID  uint   `json:"media_id"`
+	MediaURL string `json:"media_url"`
+	Duration *int   `json:"duration,omitempty"`
+}
+
+// VerseHadiResponse is a hadi with their recordings of a verse
+type VerseHadiResponse struct {
+	ID         int                        `json:"id"`
+	Name       string                     `json:"name"`
+	ImageURL   *string                    `json:"image_url,omitempty"`
+	Images     *dto.ImageVariantsResponse `json:"images,omitempty"`
+	Recordings []RecordingItem            `json:"recordings"`
+}
@@ -61,3 +61,28 @@ func SendPaginated(ctx *fiber.Ctx, data interface{}, page, limit int, total int6
 	}
 	return ctx.JSON(response)
 }
+
+// CursorPaginatedResponse represents a page of a listing walked with a cursor
+type CursorPaginatedResponse struct {
+	Data interface{} `json:"data"`
+	Meta CursorMeta  `json:"meta"`
+}
+
+// CursorMeta represents cursor pagination metadata. NextCursor is null on the last page.
+type CursorMeta struct {
+	Limit      int   `json:"limit"`
+	Count      int   `json:"count"`
+	NextCursor *uint `json:"next_cursor"`
+}
+
+// SendCursorPaginated sends a cursor-paginated response
+func SendCursorPaginated(ctx *fiber.Ctx, data interface{}, limit, count int, nextCursor *uint) error {
+	return ctx.JSON(CursorPaginatedResponse{
+		Data: data,
+		Meta: CursorMeta{
+			Limit:      limit,
+			Count:      count,
+			NextCursor: nextCursor,
+		},
+	})
+}
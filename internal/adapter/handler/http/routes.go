@@ -9,16 +9,25 @@ import (
 
 // Controllers holds all HTTP controllers to be registered.
 type Controllers struct {
-	Health      *controller.HealthController
-	Book        *controller.BookController
-	Chapter     *controller.ChapterController
-	User        *controller.UserController
-	Auth        *controller.AuthController
-	Verse       *controller.VerseController
-	Translation *controller.TranslationController
-	Bookmark    *controller.BookmarkController
-	Hadi        *controller.HadiController
-	Dashboard   *controller.DashboardController
+	Health        *controller.HealthController
+	Book          *controller.BookController
+	Chapter       *controller.ChapterController
+	Category      *controller.CategoryController
+	User          *controller.UserController
+	Auth          *controller.AuthController
+	Verse         *controller.VerseController
+	Translation   *controller.TranslationController
+	Bookmark      *controller.BookmarkController
+	Hadi          *controller.HadiController
+	Dashboard     *controller.DashboardController
+	VerseMedia    *controller.VerseMediaController
+	Upload        *controller.UploadController
+	MediaStream   *controller.MediaStreamController
+	Waveform      *controller.WaveformController
+	Search        *controller.SearchController
+	SearchHistory *controller.SearchHistoryController
+	AuditLog      *controller.AuditLogController
+	Export        *controller.ExportController
 }
 
 // AuthDeps holds auth-related dependencies for route registration
@@ -37,11 +46,29 @@ func RegisterRoutes(app *fiber.App, ctrls Controllers, authDeps *AuthDeps) {
 
 	// Book and User routes (require authDeps for protected endpoints)
 	if authDeps != nil && authDeps.AuthUC != nil {
+		// Upload routes nest under other resources and must be registered first (see RegisterUploadRoutes)
+		if ctrls.Upload != nil {
+			RegisterUploadRoutes(api, ctrls.Upload, authDeps.AuthUC)
+		}
+		if ctrls.MediaStream != nil {
+			RegisterMediaStreamRoutes(api, ctrls.MediaStream)
+		}
+		if ctrls.Waveform != nil {
+			RegisterWaveformRoutes(api, ctrls.Waveform)
+		}
+		// Routes nested under another resource go on its protected group, so auth runs once
+		var books, chapters fiber.Router
 		if ctrls.Book != nil {
-			RegisterBookRoutes(api, ctrls.Book, authDeps.AuthUC)
+			books = RegisterBookRoutes(api, ctrls.Book, authDeps.AuthUC)
 		}
 		if ctrls.Chapter != nil {
-			RegisterChapterRoutes(api, ctrls.Chapter, authDeps.AuthUC)
+			chapters = RegisterChapterRoutes(api, ctrls.Chapter, authDeps.AuthUC)
+			if books != nil {
+				RegisterBookChapterRoutes(books, ctrls.Chapter)
+			}
+		}
+		if ctrls.Category != nil {
+			RegisterCategoryRoutes(api, ctrls.Category, authDeps.AuthUC)
 		}
 		if ctrls.User != nil {
 			RegisterUserRoutes(api, ctrls.User, authDeps.AuthUC)
@@ -49,8 +76,18 @@ func RegisterRoutes(app *fiber.App, ctrls Controllers, authDeps *AuthDeps) {
 		if ctrls.Auth != nil {
 			RegisterAuthRoutes(api, ctrls.Auth, authDeps.AuthUC)
 		}
+		if ctrls.Hadi != nil {
+			RegisterVerseHadiRoutes(api, ctrls.Hadi)
+		}
+		// Verse media must be registered before verses (see RegisterVerseMediaRoutes)
+		if ctrls.VerseMedia != nil {
+			RegisterVerseMediaRoutes(api, ctrls.VerseMedia, authDeps.AuthUC)
+		}
 		if ctrls.Verse != nil {
 			RegisterVerseRoutes(api, ctrls.Verse, authDeps.AuthUC)
+			if chapters != nil {
+				RegisterChapterVerseRoutes(chapters, ctrls.Verse)
+			}
 		}
 		if ctrls.Translation != nil {
 			RegisterTranslationRoutes(api, ctrls.Translation, authDeps.AuthUC)
@@ -64,5 +101,20 @@ func RegisterRoutes(app *fiber.App, ctrls Controllers, authDeps *AuthDeps) {
 		if ctrls.Dashboard != nil {
 			RegisterDashboardRoutes(api, ctrls.Dashboard, authDeps.AuthUC)
 		}
+		if ctrls.Search != nil {
+			RegisterSearchRoutes(api, ctrls.Search, authDeps.AuthUC)
+		}
+		if ctrls.SearchHistory != nil {
+			RegisterSearchHistoryRoutes(api, ctrls.SearchHistory, authDeps.AuthUC)
+		}
+		if ctrls.AuditLog != nil {
+			RegisterAuditLogRoutes(api, ctrls.AuditLog, authDeps.AuthUC)
+		}
+		if ctrls.Export != nil {
+			RegisterExportRoutes(api, ctrls.Export, authDeps.AuthUC)
+			if books != nil {
+				RegisterBookExportRoutes(books, ctrls.Export)
+			}
+		}
 	}
 }
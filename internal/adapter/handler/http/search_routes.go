@@ -0,0 +1,25 @@
+package http
+
+import (
+	"ishari-backend/internal/adapter/handler/http/controller"
+	"ishari-backend/internal/adapter/handler/http/middleware"
+	portuc "ishari-backend/internal/core/port/usecase"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// RegisterSearchRoutes registers the public search routes.
+// Signed-in users are identified so their searches are recorded in their history.
+func RegisterSearchRoutes(router fiber.Router, ctrl *controller.SearchController, authUC portuc.AuthUseCase) {
+	search := router.Group("/search", middleware.OptionalAuthMiddleware(authUC))
+	search.Get("/", ctrl.Search)
+	search.Get("/suggest", ctrl.Suggest)
+}
+
+// RegisterSearchHistoryRoutes registers the current user's search history routes
+func RegisterSearchHistoryRoutes(router fiber.Router, ctrl *controller.SearchHistoryController, authUC portuc.AuthUseCase) {
+	history := router.Group("/me/search-history", middleware.AuthMiddleware(authUC))
+	history.Get("/", ctrl.List)
+	history.Delete("/", ctrl.Clear)
+	history.Delete("/:id", ctrl.Delete)
+}
@@ -19,6 +19,7 @@ func NewServer(cfg config.ServerConfig, l logger.Logger) *Server {
 		IdleTimeout:  cfg.IdleTimeout,
 		WriteTimeout: cfg.WriteTimeout,
 		ReadTimeout:  cfg.ReadTimeout,
+		BodyLimit:    cfg.BodyLimit,
 		ErrorHandler: errors.HandlerWithLogger(l),
 	})
 
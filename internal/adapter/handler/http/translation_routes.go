@@ -23,4 +23,6 @@ func RegisterTranslationRoutes(router fiber.Router, ctrl *controller.Translation
 	protected.Post("/bulk-delete", ctrl.BulkDelete)
 	protected.Put("/:id", ctrl.Update)
 	protected.Delete("/:id", ctrl.Delete)
+	protected.Get("/:id/revisions", middleware.RequireRoles("super_admin", "admin_content"), ctrl.ListRevisions)
+	protected.Post("/:id/revisions/:revisionId/revert", middleware.RequireRoles("super_admin", "admin_content"), ctrl.RevertRevision)
 }
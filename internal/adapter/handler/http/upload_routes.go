@@ -0,0 +1,45 @@
+package http
+
+import (
+	"regexp"
+
+	"ishari-backend/internal/adapter/handler/http/controller"
+	"ishari-backend/internal/adapter/handler/http/middleware"
+	portuc "ishari-backend/internal/core/port/usecase"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// RegisterUploadRoutes registers multipart upload routes nested under /media, /verses, /books and /hadis.
+// Middleware is attached per route and the function must run before the owning
+// resource routes so their group-level middleware does not shadow these endpoints.
+func RegisterUploadRoutes(router fiber.Router, ctrl *controller.UploadController, authUC portuc.AuthUseCase) {
+	auth := middleware.AuthMiddleware(authUC)
+	adminRole := middleware.RequireRoles("super_admin", "admin_content")
+
+	// Verse media files (admin only, same as verse media mutations)
+	router.Post("/media/upload", auth, adminRole, ctrl.UploadVerseMedia)
+	router.Put("/media/:id/file", auth, adminRole, ctrl.ReplaceVerseMediaFile)
+	router.Post("/verses/:id/media/upload", auth, adminRole, ctrl.UploadForVerse)
+
+	// Book cover (any authenticated user, same as book mutations)
+	router.Post("/books/:id/cover", auth, ctrl.UploadBookCover)
+
+	// Hadi picture (admin only, same as hadi mutations)
+	router.Post("/hadis/:id/image", auth, adminRole, ctrl.UploadHadiImage)
+}
+
+// uploadRoutes matches the paths of the routes above per method, under the /api group.
+// Routing is case-insensitive and ignores a trailing slash, and so are these patterns.
+var uploadRoutes = map[string]*regexp.Regexp{
+	fiber.MethodPost: regexp.MustCompile(`(?i)^/api/(media/upload|verses/[^/]+/media/upload|books/[^/]+/cover|hadis/[^/]+/image)/?$`),
+	fiber.MethodPut:  regexp.MustCompile(`(?i)^/api/media/[^/]+/file/?$`),
+}
+
+// IsUploadRequest reports whether c targets an upload route. Uploads are kept out of the
+// request transaction: they write to storage first and wrap only their database writes in
+// a transaction, so none stays open while a large file is transferred.
+func IsUploadRequest(c *fiber.Ctx) bool {
+	pattern, ok := uploadRoutes[c.Method()]
+	return ok && pattern.MatchString(c.Path())
+}
@@ -0,0 +1,46 @@
+package http_test
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	httpadapter "ishari-backend/internal/adapter/handler/http"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func TestIsUploadRequest(t *testing.T) {
+	tests := []struct {
+		method string
+		path   string
+		want   bool
+	}{
+		{method: fiber.MethodPost, path: "/api/media/upload", want: true},
+		{method: fiber.MethodPut, path: "/api/media/12/file", want: true},
+		{method: fiber.MethodPost, path: "/api/verses/3/media/upload", want: true},
+		{method: fiber.MethodPost, path: "/api/books/3/cover/", want: true},
+		{method: fiber.MethodPost, path: "/api/Hadis/3/image", want: true},
+		{method: fiber.MethodPost, path: "/api/media", want: false},
+		{method: fiber.MethodPut, path: "/api/media/12", want: false},
+		{method: fiber.MethodPost, path: "/api/media/12/file", want: false},
+		{method: fiber.MethodDelete, path: "/api/books/3/cover", want: false},
+		{method: fiber.MethodPost, path: "/api/books/3/cover/extra", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.path, func(t *testing.T) {
+			var got bool
+			app := fiber.New()
+			app.Use(func(c *fiber.Ctx) error {
+				got = httpadapter.IsUploadRequest(c)
+				return c.SendStatus(fiber.StatusNoContent)
+			})
+
+			if _, err := app.Test(httptest.NewRequest(tt.method, tt.path, nil)); err != nil {
+				t.Fatalf("request failed: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
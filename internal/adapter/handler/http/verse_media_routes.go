@@ -0,0 +1,36 @@
+package http
+
+import (
+	"ishari-backend/internal/adapter/handler/http/controller"
+	"ishari-backend/internal/adapter/handler/http/middleware"
+	portuc "ishari-backend/internal/core/port/usecase"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// RegisterVerseMediaRoutes registers verse media routes under /media and /verses/:id/media.
+// It must run before RegisterVerseRoutes, otherwise the auth middleware of the
+// /verses group also catches the public nested GET.
+func RegisterVerseMediaRoutes(router fiber.Router, ctrl *controller.VerseMediaController, authUC portuc.AuthUseCase) {
+	adminOnly := []fiber.Handler{
+		middleware.AuthMiddleware(authUC),
+		middleware.RequireRoles("super_admin", "admin_content"),
+	}
+
+	// Nested verse routes (per-route middleware so the /verses prefix stays public)
+	verseMedia := router.Group("/verses/:id/media")
+	verseMedia.Get("/", ctrl.ListByVerse)
+	verseMedia.Post("/", append(adminOnly, ctrl.CreateForVerse)...)
+
+	media := router.Group("/media")
+
+	// Public routes (no auth required)
+	media.Get("/", ctrl.List)
+	media.Get("/:id", ctrl.GetByID)
+
+	// Admin routes (require JWT token AND super_admin or admin_content roles)
+	admin := media.Group("", adminOnly...)
+	admin.Post("/", ctrl.Create)
+	admin.Put("/:id", ctrl.Update)
+	admin.Delete("/:id", ctrl.Delete)
+}
@@ -21,4 +21,15 @@ func RegisterVerseRoutes(router fiber.Router, ctrl *controller.VerseController,
 	protected.Put("/:id", ctrl.Update)
 	protected.Delete("/:id", ctrl.Delete)
 	protected.Post("/bulk-delete", ctrl.BulkDelete)
+	protected.Post("/:id/move", ctrl.Move)
+	protected.Get("/:id/revisions", middleware.RequireRoles("super_admin", "admin_content"), ctrl.ListRevisions)
+	protected.Post("/:id/revisions/:revisionId/revert", middleware.RequireRoles("super_admin", "admin_content"), ctrl.RevertRevision)
+}
+
+// RegisterChapterVerseRoutes registers the verse routes addressed by chapter on the protected
+// group returned by RegisterChapterRoutes. Reordering and importing are addressed by chapter
+// but write verses.
+func RegisterChapterVerseRoutes(chapters fiber.Router, ctrl *controller.VerseController) {
+	chapters.Post("/:id/verses/reorder", ctrl.Reorder)
+	chapters.Post("/:id/verses/import", ctrl.Import)
 }
@@ -0,0 +1,13 @@
+package http
+
+import (
+	"ishari-backend/internal/adapter/handler/http/controller"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// RegisterWaveformRoutes registers the public waveform route.
+// It must run before RegisterVerseMediaRoutes, whose admin group guards the /media prefix.
+func RegisterWaveformRoutes(router fiber.Router, ctrl *controller.WaveformController) {
+	router.Get("/media/:id/waveform", ctrl.Get)
+}
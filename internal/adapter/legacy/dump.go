@@ -0,0 +1,147 @@
+package legacy
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"ishari-backend/internal/core/entity"
+)
+
+// ReadDump reads the records of table from a JSON or CSV export, told apart by the file
+// extension
+func ReadDump(path, table string, renames map[string]string) ([]entity.LegacyRecord, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		return ReadJSON(f, table, renames)
+	case ".csv":
+		return ReadCSV(f, renames)
+	default:
+		return nil, fmt.Errorf("unsupported dump %s: use a .json or .csv file", filepath.Base(path))
+	}
+}
+
+// ReadJSON reads an array of rows keyed by column name, or the export of phpMyAdmin, an
+// array of header, database and table objects from which the rows of table are read
+func ReadJSON(r io.Reader, table string, renames map[string]string) ([]entity.LegacyRecord, error) {
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+	var items []map[string]any
+	if err := dec.Decode(&items); err != nil {
+		return nil, fmt.Errorf("invalid JSON dump: %w", err)
+	}
+
+	rows := items
+	if isPHPMyAdminExport(items) {
+		rows = nil
+		found := false
+		for _, item := range items {
+			if item["type"] != "table" || item["name"] != table {
+				continue
+			}
+			found = true
+			data, _ := item["data"].([]any)
+			for _, row := range data {
+				values, ok := row.(map[string]any)
+				if !ok {
+					return nil, errors.New("invalid JSON dump: table rows must be objects")
+				}
+				rows = append(rows, values)
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("JSON dump has no table %q", table)
+		}
+	}
+
+	records := make([]entity.LegacyRecord, 0, len(rows))
+	for i, row := range rows {
+		values := make(map[string]string, len(row))
+		for name, value := range row {
+			values[name] = jsonString(value)
+		}
+		record, err := toRecord(i+1, values, renames)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// isPHPMyAdminExport reports whether the items are the objects of a phpMyAdmin export
+// rather than rows
+func isPHPMyAdminExport(items []map[string]any) bool {
+	for _, item := range items {
+		if _, ok := item["data"].([]any); ok && item["type"] == "table" {
+			return true
+		}
+	}
+	return false
+}
+
+// jsonString returns a JSON value as the text MySQL would return; null is ""
+func jsonString(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// ReadCSV reads a CSV export whose header names the columns. NULL reads as "".
+func ReadCSV(r io.Reader, renames map[string]string) ([]entity.LegacyRecord, error) {
+	cr := csv.NewReader(r)
+	header, err := cr.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, errors.New("CSV dump is empty")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid CSV dump: %w", err)
+	}
+	if len(header) > 0 {
+		header[0] = strings.TrimPrefix(header[0], "\ufeff")
+	}
+	for i := range header {
+		header[i] = strings.TrimSpace(header[i])
+	}
+
+	var records []entity.LegacyRecord
+	for row := 1; ; row++ {
+		fields, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid CSV dump: %w", err)
+		}
+		values := make(map[string]string, len(header))
+		for i, name := range header {
+			if fields[i] != nullValue {
+				values[name] = fields[i]
+			}
+		}
+		record, err := toRecord(row, values, renames)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
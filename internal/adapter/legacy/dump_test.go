@@ -0,0 +1,137 @@
+package legacy_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"ishari-backend/internal/adapter/legacy"
+)
+
+var shalawatRenames = map[string]string{"text_shalawat": "text", "translation_id": "translation"}
+
+func TestReadJSON_Rows(t *testing.T) {
+	dump := `[
+		{"id": 7, "muhud_id": "3", "text_shalawat": "نص", "transliteration": "nas", "translation_id": "teks", "numberOfDiwan": null, "numberOfMaulidSyarafulAnam": 2}
+	]`
+	records, err := legacy.ReadJSON(strings.NewReader(dump), "shalawats", shalawatRenames)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(records) != 1 {
+		t.Fatalf("expected 1 record, got %d", len(records))
+	}
+	r := records[0]
+	if r.ID != 7 || r.MuhudID != 3 || r.Position != 0 || r.Text != "نص" || r.Transliteration != "nas" || r.Translation != "teks" {
+		t.Errorf("unexpected record: %+v", r)
+	}
+	if v, ok := r.Columns["numberOfDiwan"]; !ok || v != "" {
+		t.Errorf("expected null to read as an empty column, got %q (%v)", v, ok)
+	}
+	if r.Columns["numberOfMaulidSyarafulAnam"] != "2" {
+		t.Errorf("expected numbers to read as text, got %q", r.Columns["numberOfMaulidSyarafulAnam"])
+	}
+}
+
+func TestReadJSON_PHPMyAdminExport(t *testing.T) {
+	dump := `[
+		{"type": "header", "version": "5.2.1", "comment": "Export to JSON plugin for PHPMyAdmin"},
+		{"type": "database", "name": "ishari"},
+		{"type": "table", "name": "muhud", "database": "ishari", "data": [{"id": "1", "position": "1", "name": "x"}]},
+		{"type": "table", "name": "arabic_text", "database": "ishari", "data": [
+			{"id": "1", "muhud_id": "1", "position": "2", "text": "a", "is_diba": "Y"},
+			{"id": "2", "muhud_id": "1", "position": "3", "text": "b", "is_diba": "N"}
+		]}
+	]`
+	records, err := legacy.ReadJSON(strings.NewReader(dump), "arabic_text", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(records) != 2 || records[1].Position != 3 || records[0].Columns["is_diba"] != "Y" {
+		t.Errorf("unexpected records: %+v", records)
+	}
+
+	if _, err := legacy.ReadJSON(strings.NewReader(dump), "shalawats", nil); err == nil {
+		t.Error("expected an error for a table missing from the export")
+	}
+}
+
+func TestReadCSV(t *testing.T) {
+	dump := "\ufeffid,muhud_id,position,text,is_diba\n1,4,1,\"نص, طويل\",NULL\n2,4,2,نص,Y\n"
+	records, err := legacy.ReadCSV(strings.NewReader(dump), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(records) != 2 {
+		t.Fatalf("expected 2 records, got %d", len(records))
+	}
+	if records[0].ID != 1 || records[0].Text != "نص, طويل" || records[0].Columns["is_diba"] != "" {
+		t.Errorf("unexpected first record: %+v", records[0])
+	}
+	if records[1].Position != 2 || records[1].Columns["is_diba"] != "Y" {
+		t.Errorf("unexpected second record: %+v", records[1])
+	}
+}
+
+func TestReadCSV_InvalidRows(t *testing.T) {
+	tests := map[string]string{
+		"empty":            "",
+		"id":               "id,muhud_id,text\nx,1,a\n",
+		"muhud":            "id,muhud_id,text\n1,,a\n",
+		"position":         "id,muhud_id,position,text\n1,1,-2,a\n",
+		"field count":      "id,muhud_id,text\n1,1\n",
+		"unterminated row": "id,muhud_id,text\n1,1,\"a\n",
+	}
+	for name, dump := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := legacy.ReadCSV(strings.NewReader(dump), nil); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+func TestReadDump_ByExtension(t *testing.T) {
+	dir := t.TempDir()
+	csvPath := filepath.Join(dir, "arabic_text.CSV")
+	if err := os.WriteFile(csvPath, []byte("id,muhud_id,text\n1,1,a\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if records, err := legacy.ReadDump(csvPath, "arabic_text", nil); err != nil || len(records) != 1 {
+		t.Errorf("expected 1 record, got %v, %v", records, err)
+	}
+
+	sqlPath := filepath.Join(dir, "arabic_text.sql")
+	if err := os.WriteFile(sqlPath, []byte("INSERT INTO ..."), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := legacy.ReadDump(sqlPath, "arabic_text", nil); err == nil {
+		t.Error("expected an error for an unsupported dump")
+	}
+}
+
+func TestLoadMapping(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "mapping.json")
+	mapping := `{"source": "ishari", "table": "arabic_text", "muhuds": {"12": 3},
+		"categories": [{"column": "is_diba", "value": "Y", "category": "diba"}], "default_category": "diwan"}`
+	if err := os.WriteFile(path, []byte(mapping), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	m, err := legacy.LoadMapping(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	im := m.ImportMapping()
+	if im.Muhuds[12] != 3 || len(im.Categories) != 1 || im.Categories[0].Category != "diba" || im.DefaultCategory != "diwan" {
+		t.Errorf("unexpected mapping: %+v", im)
+	}
+
+	if err := os.WriteFile(path, []byte(`{"source": "ishari", "muhud": {"12": 3}}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := legacy.LoadMapping(path); err == nil {
+		t.Error("expected an error for an unknown key")
+	}
+}
@@ -0,0 +1,64 @@
+package legacy
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	portuc "ishari-backend/internal/core/port/usecase"
+)
+
+// Mapping is the JSON mapping file of a legacy import. It names the legacy database and
+// table, renames table columns to the record columns and places records in chapters.
+type Mapping struct {
+	Source              string            `json:"source"`
+	Table               string            `json:"table"`
+	BookID              uint              `json:"book_id"`
+	TranslationLanguage string            `json:"translation_language"`
+	Columns             map[string]string `json:"columns"`
+	Muhuds              map[int64]uint    `json:"muhuds"`
+	Categories          []CategoryRule    `json:"categories"`
+	DefaultCategory     string            `json:"default_category"`
+}
+
+// CategoryRule is a category rule of the mapping file
+type CategoryRule struct {
+	Column   string `json:"column"`
+	Value    string `json:"value"`
+	Category string `json:"category"`
+}
+
+// LoadMapping reads a mapping file, rejecting unknown keys so typos do not go unnoticed
+func LoadMapping(path string) (*Mapping, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	dec := json.NewDecoder(f)
+	dec.DisallowUnknownFields()
+	var m Mapping
+	if err := dec.Decode(&m); err != nil {
+		return nil, fmt.Errorf("invalid mapping file %s: %w", path, err)
+	}
+	return &m, nil
+}
+
+// ImportMapping returns the part of the mapping the import use case places records with
+func (m *Mapping) ImportMapping() portuc.LegacyMapping {
+	mapping := portuc.LegacyMapping{
+		BookID:              m.BookID,
+		Muhuds:              m.Muhuds,
+		DefaultCategory:     m.DefaultCategory,
+		TranslationLanguage: m.TranslationLanguage,
+	}
+	for _, rule := range m.Categories {
+		mapping.Categories = append(mapping.Categories, portuc.LegacyCategoryRule{
+			Column:   rule.Column,
+			Value:    rule.Value,
+			Category: rule.Category,
+		})
+	}
+	return mapping
+}
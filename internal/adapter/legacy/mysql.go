@@ -0,0 +1,74 @@
+package legacy
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+
+	"ishari-backend/internal/core/entity"
+
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// tableName guards the table name interpolated into the query
+var tableName = regexp.MustCompile(`^[A-Za-z0-9_]+$`)
+
+// OpenMySQL connects to the legacy database. The DSN is that of go-sql-driver/mysql,
+// e.g. user:password@tcp(localhost:3306)/ishari?charset=utf8mb4.
+func OpenMySQL(dsn string) (*gorm.DB, error) {
+	db, err := gorm.Open(mysql.Open(dsn), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to legacy database: %w", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get legacy database instance: %w", err)
+	}
+	if err := sqlDB.Ping(); err != nil {
+		_ = sqlDB.Close()
+		return nil, fmt.Errorf("failed to ping legacy database: %w", err)
+	}
+	return db, nil
+}
+
+// ReadMySQL reads every row of table from the legacy database in ID order
+func ReadMySQL(ctx context.Context, db *gorm.DB, table string, renames map[string]string) ([]entity.LegacyRecord, error) {
+	if !tableName.MatchString(table) {
+		return nil, fmt.Errorf("invalid legacy table name %q", table)
+	}
+	rows, err := db.WithContext(ctx).Raw(fmt.Sprintf("SELECT * FROM `%s` ORDER BY id", table)).Rows()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	names, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	fields := make([]sql.NullString, len(names))
+	dest := make([]any, len(names))
+	for i := range fields {
+		dest[i] = &fields[i]
+	}
+
+	var records []entity.LegacyRecord
+	for row := 1; rows.Next(); row++ {
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		values := make(map[string]string, len(names))
+		for i, name := range names {
+			values[name] = fields[i].String
+		}
+		record, err := toRecord(row, values, renames)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, rows.Err()
+}
@@ -0,0 +1,58 @@
+// Package legacy reads the verses of the legacy MySQL application, from its database or
+// from a dump of one of its tables, for the legacy import.
+package legacy
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"ishari-backend/internal/core/entity"
+)
+
+// Columns a record is read from, once the renames of the mapping are applied. Every other
+// column is kept in LegacyRecord.Columns for the category rules.
+const (
+	ColumnID              = "id"
+	ColumnMuhudID         = "muhud_id"
+	ColumnPosition        = "position"
+	ColumnText            = "text"
+	ColumnTransliteration = "transliteration"
+	ColumnTranslation     = "translation"
+)
+
+// nullValue is how MySQL and phpMyAdmin write NULL in CSV exports
+const nullValue = "NULL"
+
+// toRecord builds a record from the columns of one row; row is 1-based for errors
+func toRecord(row int, values map[string]string, renames map[string]string) (entity.LegacyRecord, error) {
+	columns := make(map[string]string, len(values))
+	for name, value := range values {
+		if renamed, ok := renames[name]; ok {
+			name = renamed
+		}
+		columns[name] = value
+	}
+
+	record := entity.LegacyRecord{
+		Text:            columns[ColumnText],
+		Transliteration: columns[ColumnTransliteration],
+		Translation:     columns[ColumnTranslation],
+		Columns:         columns,
+	}
+	var err error
+	if record.ID, err = strconv.ParseInt(strings.TrimSpace(columns[ColumnID]), 10, 64); err != nil {
+		return record, fmt.Errorf("row %d: invalid %s %q", row, ColumnID, columns[ColumnID])
+	}
+	if record.MuhudID, err = strconv.ParseInt(strings.TrimSpace(columns[ColumnMuhudID]), 10, 64); err != nil {
+		return record, fmt.Errorf("row %d (id %d): invalid %s %q", row, record.ID, ColumnMuhudID, columns[ColumnMuhudID])
+	}
+	if position := strings.TrimSpace(columns[ColumnPosition]); position != "" {
+		n, err := strconv.ParseUint(position, 10, 32)
+		if err != nil {
+			return record, fmt.Errorf("row %d (id %d): invalid %s %q", row, record.ID, ColumnPosition, position)
+		}
+		record.Position = uint(n)
+	}
+	return record, nil
+}
@@ -0,0 +1,76 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"ishari-backend/internal/core/entity"
+	"ishari-backend/internal/core/port/repository"
+
+	"gorm.io/gorm"
+)
+
+type auditLogRepository struct {
+	db *gorm.DB
+}
+
+// NewAuditLogRepository creates a new audit log repository instance
+func NewAuditLogRepository(db *gorm.DB) repository.AuditLogRepository {
+	return &auditLogRepository{db: db}
+}
+
+// entries selects audit log entries with the username of whoever made the change
+func (r *auditLogRepository) entries(ctx context.Context) *gorm.DB {
+	return conn(ctx, r.db).
+		Table("audit_logs a").
+		Select("a.*, u.username AS changed_by_username").
+		Joins("LEFT JOIN users u ON u.id = a.changed_by_user_id")
+}
+
+// List retrieves audit log entries matching the filter, newest first
+func (r *auditLogRepository) List(ctx context.Context, filter repository.AuditLogFilter) ([]entity.AuditLog, error) {
+	query := r.entries(ctx)
+	if filter.BeforeID > 0 {
+		query = query.Where("a.id < ?", filter.BeforeID)
+	}
+	if filter.Table != "" {
+		query = query.Where("a.table_name = ?", filter.Table)
+	}
+	if filter.RecordID != nil {
+		query = query.Where("a.record_id = ?", *filter.RecordID)
+	}
+	if filter.Operation != "" {
+		query = query.Where("a.operation = ?", filter.Operation)
+	}
+	if filter.ChangedByUserID != nil {
+		query = query.Where("a.changed_by_user_id = ?", *filter.ChangedByUserID)
+	}
+	if filter.From != nil {
+		query = query.Where("a.changed_at >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		query = query.Where("a.changed_at < ?", *filter.To)
+	}
+
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+
+	var logs []entity.AuditLog
+	if err := query.Order("a.id DESC").Find(&logs).Error; err != nil {
+		return nil, err
+	}
+	return logs, nil
+}
+
+// GetByID retrieves an audit log entry by its ID
+func (r *auditLogRepository) GetByID(ctx context.Context, id uint) (*entity.AuditLog, error) {
+	var log entity.AuditLog
+	if err := r.entries(ctx).Where("a.id = ?", id).Take(&log).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &log, nil
+}
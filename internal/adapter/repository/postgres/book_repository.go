@@ -25,7 +25,7 @@ func (r *bookRepository) List(ctx context.Context, offset, limit int, search str
 		books []entity.Book
 	)
 
-	base := r.db.WithContext(ctx).Model(&entity.Book{}).Where("deleted_at IS NULL")
+	base := conn(ctx, r.db).Model(&entity.Book{}).Where("deleted_at IS NULL")
 	if search = strings.TrimSpace(search); search != "" {
 		q := "%" + search + "%"
 		base = base.Where("title ILIKE ? OR author ILIKE ?", q, q)
@@ -44,15 +44,15 @@ func (r *bookRepository) List(ctx context.Context, offset, limit int, search str
 }
 
 func (r *bookRepository) Create(ctx context.Context, book *entity.Book) error {
-	return r.db.WithContext(ctx).Create(book).Error
+	return conn(ctx, r.db).Create(book).Error
 }
 
 func (r *bookRepository) Edit(ctx context.Context, book *entity.Book) error {
-	return r.db.WithContext(ctx).Save(book).Error
+	return conn(ctx, r.db).Save(book).Error
 }
 
 func (r *bookRepository) Delete(ctx context.Context, id int64) error {
-	return r.db.WithContext(ctx).
+	return conn(ctx, r.db).
 		Model(&entity.Book{}).
 		Where("id = ?", id).
 		Update("deleted_at", time.Now()).Error
@@ -60,7 +60,7 @@ func (r *bookRepository) Delete(ctx context.Context, id int64) error {
 
 func (r *bookRepository) GetById(ctx context.Context, id int64) (*entity.Book, error) {
 	var book entity.Book
-	if err := r.db.WithContext(ctx).Where("deleted_at IS NULL").First(&book, id).Error; err != nil {
+	if err := conn(ctx, r.db).Where("deleted_at IS NULL").First(&book, id).Error; err != nil {
 		return nil, err
 	}
 	return &book, nil
@@ -21,13 +21,13 @@ func NewBookmarkRepository(db *gorm.DB) repository.BookmarkRepository {
 
 // CreateBookmark creates a new bookmark
 func (r *bookmarkRepository) CreateBookmark(ctx context.Context, bookmark *entity.Bookmark) error {
-	return r.db.WithContext(ctx).Create(bookmark).Error
+	return conn(ctx, r.db).Create(bookmark).Error
 }
 
 // GetBookmarkByID retrieves a bookmark by its ID
 func (r *bookmarkRepository) GetBookmarkByID(ctx context.Context, id uint) (*entity.Bookmark, error) {
 	var bookmark entity.Bookmark
-	err := r.db.WithContext(ctx).First(&bookmark, id).Error
+	err := conn(ctx, r.db).First(&bookmark, id).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, err
@@ -40,7 +40,7 @@ func (r *bookmarkRepository) GetBookmarkByID(ctx context.Context, id uint) (*ent
 // GetBookmarkByUserIDAndVerseID retrieves a bookmark by UserID and VerseID
 func (r *bookmarkRepository) GetBookmarkByUserIDAndVerseID(ctx context.Context, userID uint, verseID uint) (*entity.Bookmark, error) {
 	var bookmark entity.Bookmark
-	err := r.db.WithContext(ctx).Where("user_id = ? AND verse_id = ?", userID, verseID).First(&bookmark).Error
+	err := conn(ctx, r.db).Where("user_id = ? AND verse_id = ?", userID, verseID).First(&bookmark).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, nil // Return nil if not found, to allow checking for existence
@@ -55,7 +55,7 @@ func (r *bookmarkRepository) ListBookmarksByUserID(ctx context.Context, userID u
 	var bookmarks []entity.Bookmark
 	var total int64
 
-	query := r.db.WithContext(ctx).Model(&entity.Bookmark{}).Where("user_id = ?", userID)
+	query := conn(ctx, r.db).Model(&entity.Bookmark{}).Where("user_id = ?", userID)
 
 	// Get total count
 	if err := query.Count(&total).Error; err != nil {
@@ -79,10 +79,10 @@ func (r *bookmarkRepository) ListBookmarksByUserID(ctx context.Context, userID u
 
 // UpdateBookmark updates an existing bookmark
 func (r *bookmarkRepository) UpdateBookmark(ctx context.Context, bookmark *entity.Bookmark) error {
-	return r.db.WithContext(ctx).Save(bookmark).Error
+	return conn(ctx, r.db).Save(bookmark).Error
 }
 
 // DeleteBookmark deletes a bookmark by its ID
 func (r *bookmarkRepository) DeleteBookmark(ctx context.Context, id uint) error {
-	return r.db.WithContext(ctx).Delete(&entity.Bookmark{}, id).Error
+	return conn(ctx, r.db).Delete(&entity.Bookmark{}, id).Error
 }
@@ -0,0 +1,63 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"ishari-backend/internal/core/entity"
+	"ishari-backend/internal/core/port/repository"
+
+	"gorm.io/gorm"
+)
+
+type categoryRepository struct {
+	db *gorm.DB
+}
+
+// NewCategoryRepository creates a new chapter category repository instance
+func NewCategoryRepository(db *gorm.DB) repository.CategoryRepository {
+	return &categoryRepository{db: db}
+}
+
+// Create creates a new category
+func (r *categoryRepository) Create(ctx context.Context, category *entity.Category) error {
+	return conn(ctx, r.db).Create(category).Error
+}
+
+// List retrieves every category in reading order
+func (r *categoryRepository) List(ctx context.Context) ([]entity.Category, error) {
+	var categories []entity.Category
+	if err := conn(ctx, r.db).Order("sort_order ASC, name ASC").Find(&categories).Error; err != nil {
+		return nil, err
+	}
+	return categories, nil
+}
+
+// GetByID retrieves a category by its ID
+func (r *categoryRepository) GetByID(ctx context.Context, id uint) (*entity.Category, error) {
+	var category entity.Category
+	if err := conn(ctx, r.db).First(&category, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &category, nil
+}
+
+// Update updates an existing category
+func (r *categoryRepository) Update(ctx context.Context, category *entity.Category) error {
+	return conn(ctx, r.db).Save(category).Error
+}
+
+// Delete removes a category by ID
+func (r *categoryRepository) Delete(ctx context.Context, id uint) error {
+	return conn(ctx, r.db).Delete(&entity.Category{}, id).Error
+}
+
+// CountChapters counts the live chapters of a category
+func (r *categoryRepository) CountChapters(ctx context.Context, id uint) (int64, error) {
+	var total int64
+	err := conn(ctx, r.db).Model(&entity.Chapter{}).Where("category_id = ?", id).Count(&total).Error
+	return total, err
+}
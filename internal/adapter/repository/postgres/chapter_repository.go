@@ -1,13 +1,16 @@
 package postgres
 
 import (
+	"cmp"
 	"context"
+	"slices"
 	"strings"
 
 	"ishari-backend/internal/core/entity"
 	"ishari-backend/internal/core/port/repository"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type chapterRepository struct {
@@ -21,7 +24,7 @@ func NewChapterRepository(db *gorm.DB) repository.ChapterRepository {
 
 // CreateChapter creates a new chapter in the database
 func (r *chapterRepository) CreateChapter(ctx context.Context, chapter *entity.Chapter) error {
-	return r.db.WithContext(ctx).Create(chapter).Error
+	return conn(ctx, r.db).Create(chapter).Error
 }
 
 // ListChapters retrieves paginated chapters with optional search
@@ -31,10 +34,10 @@ func (r *chapterRepository) ListChapters(ctx context.Context, offset, limit int,
 		chapters []entity.Chapter
 	)
 
-	base := r.db.WithContext(ctx).Model(&entity.Chapter{})
+	base := conn(ctx, r.db).Model(&entity.Chapter{})
 	if search = strings.TrimSpace(search); search != "" {
 		q := "%" + search + "%"
-		base = base.Where("title ILIKE ? OR category ILIKE ?", q, q)
+		base = base.Where("title ILIKE ? OR category_id IN (SELECT id FROM categories WHERE name ILIKE ? AND deleted_at IS NULL)", q, q)
 	}
 
 	if bookID != nil {
@@ -46,14 +49,14 @@ func (r *chapterRepository) ListChapters(ctx context.Context, offset, limit int,
 	}
 
 	if category = strings.TrimSpace(category); category != "" {
-		base = base.Where("category = ?", category)
+		base = base.Where("category_id IN (SELECT id FROM categories WHERE (slug = ? OR name = ?) AND deleted_at IS NULL)", category, category)
 	}
 
 	if err := base.Count(&total).Error; err != nil {
 		return nil, 0, err
 	}
 
-	query := base.Order("book_id ASC, chapter_number ASC").Offset(offset).Limit(limit).Preload("Book")
+	query := base.Order("book_id ASC, chapter_number ASC").Offset(offset).Limit(limit).Preload("Book").Preload("Category")
 	if err := query.Find(&chapters).Error; err != nil {
 		return nil, 0, err
 	}
@@ -68,13 +71,34 @@ func (r *chapterRepository) GetChaptersByBookID(ctx context.Context, bookID uint
 		chapters []entity.Chapter
 	)
 
-	base := r.db.WithContext(ctx).Model(&entity.Chapter{}).Where("book_id = ?", bookID)
+	base := conn(ctx, r.db).Model(&entity.Chapter{}).Where("book_id = ?", bookID)
 
 	if err := base.Count(&total).Error; err != nil {
 		return nil, 0, err
 	}
 
-	if err := base.Order("chapter_number ASC").Preload("Book").Find(&chapters).Error; err != nil {
+	if err := base.Order("chapter_number ASC").Preload("Book").Preload("Category").Find(&chapters).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return chapters, total, nil
+}
+
+// ListChaptersByCategory implements ChapterRepository.
+func (r *chapterRepository) ListChaptersByCategory(ctx context.Context, categoryID uint, offset, limit int) ([]entity.Chapter, int64, error) {
+	var (
+		total    int64
+		chapters []entity.Chapter
+	)
+
+	base := conn(ctx, r.db).Model(&entity.Chapter{}).Where("category_id = ?", categoryID)
+
+	if err := base.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	query := base.Order("book_id ASC, chapter_number ASC").Offset(offset).Limit(limit).Preload("Book").Preload("Category")
+	if err := query.Find(&chapters).Error; err != nil {
 		return nil, 0, err
 	}
 
@@ -84,23 +108,84 @@ func (r *chapterRepository) GetChaptersByBookID(ctx context.Context, bookID uint
 // GetChapterByID retrieves a chapter by its ID
 func (r *chapterRepository) GetChapterByID(ctx context.Context, id uint) (*entity.Chapter, error) {
 	var chapter entity.Chapter
-	if err := r.db.WithContext(ctx).Preload("Book").First(&chapter, id).Error; err != nil {
+	if err := conn(ctx, r.db).Preload("Book").Preload("Category").First(&chapter, id).Error; err != nil {
 		return nil, err
 	}
 	return &chapter, nil
 }
 
-// UpdateChapter updates an existing chapter
+// UpdateChapter updates an existing chapter. Preloaded associations are not written back,
+// so a changed BookID or CategoryID is not overridden by the stale Book or Category.
 func (r *chapterRepository) UpdateChapter(ctx context.Context, chapter *entity.Chapter) error {
-	return r.db.WithContext(ctx).Save(chapter).Error
+	return conn(ctx, r.db).Omit(clause.Associations).Save(chapter).Error
 }
 
 // DeleteChapter removes a chapter by ID
 func (r *chapterRepository) DeleteChapter(ctx context.Context, id uint) error {
-	return r.db.WithContext(ctx).Delete(&entity.Chapter{}, id).Error
+	return conn(ctx, r.db).Delete(&entity.Chapter{}, id).Error
 }
 
 // DeleteChapters removes multiple chapters by IDs
 func (r *chapterRepository) DeleteChapters(ctx context.Context, ids []uint) error {
-	return r.db.WithContext(ctx).Delete(&entity.Chapter{}, "id IN ?", ids).Error
+	return conn(ctx, r.db).Delete(&entity.Chapter{}, "id IN ?", ids).Error
+}
+
+// ReconcileVerseCounts locks the live chapters and rewrites the counts that drifted in one statement
+func (r *chapterRepository) ReconcileVerseCounts(ctx context.Context) ([]entity.VerseCountCorrection, error) {
+	const reconcileSQL = `WITH counted AS (
+			SELECT c.id, COALESCE(c.total_verses, 0) AS stored_total,
+				(SELECT count(*) FROM verses v WHERE v.chapter_id = c.id AND v.deleted_at IS NULL) AS actual_total
+			FROM chapters c
+			WHERE c.deleted_at IS NULL
+			ORDER BY c.id
+			FOR UPDATE
+		)
+		UPDATE chapters c SET total_verses = counted.actual_total
+		FROM counted
+		WHERE c.id = counted.id AND counted.stored_total <> counted.actual_total
+		RETURNING c.id AS chapter_id, c.book_id, c.chapter_number, c.title, counted.stored_total, counted.actual_total`
+
+	var corrections []entity.VerseCountCorrection
+	if err := conn(ctx, r.db).Raw(reconcileSQL).Scan(&corrections).Error; err != nil {
+		return nil, err
+	}
+	slices.SortFunc(corrections, func(a, b entity.VerseCountCorrection) int {
+		if a.BookID != b.BookID {
+			return cmp.Compare(a.BookID, b.BookID)
+		}
+		return cmp.Compare(a.ChapterNumber, b.ChapterNumber)
+	})
+	return corrections, nil
+}
+
+// ReorderChapters renumbers the chapters of a book while holding the book row lock
+func (r *chapterRepository) ReorderChapters(ctx context.Context, bookID uint, chapterIDs []uint) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := lockBooks(tx, []uint{bookID}); err != nil {
+			return err
+		}
+		return chapterOrdering.reorder(tx, bookID, chapterIDs)
+	})
+}
+
+// MoveChapter moves a chapter within or across books while holding both book row locks
+func (r *chapterRepository) MoveChapter(ctx context.Context, id, bookID, position uint) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		var chapter entity.Chapter
+		if err := tx.Select("id", "book_id").First(&chapter, id).Error; err != nil {
+			return err
+		}
+		if err := lockBooks(tx, []uint{chapter.BookID, bookID}); err != nil {
+			return err
+		}
+		return chapterOrdering.move(tx, id, chapter.BookID, bookID, position)
+	})
+}
+
+// lockBooks takes row locks on the books, in id order, so that concurrent renumbering of
+// the same book's chapters serializes
+func lockBooks(tx *gorm.DB, bookIDs []uint) error {
+	var locked []uint
+	return tx.Model(&entity.Book{}).Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id IN ?", bookIDs).Order("id").Pluck("id", &locked).Error
 }
@@ -33,7 +33,7 @@ func (r *dashboardRepository) GetStats(ctx context.Context) (*entity.DashboardSt
 	`
 	// The table name dual isn't needed in Postgres for a SELECT without FROM,
 	// but GORM Raw executes it fine.
-	err := r.db.WithContext(ctx).Raw(query).Scan(&stats).Error
+	err := conn(ctx, r.db).Raw(query).Scan(&stats).Error
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,127 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"ishari-backend/internal/core/entity"
+	"ishari-backend/internal/core/port/repository"
+
+	"gorm.io/gorm"
+)
+
+type exportRepository struct {
+	db *gorm.DB
+}
+
+// NewExportRepository creates a new export repository implementation
+func NewExportRepository(db *gorm.DB) repository.ExportRepository {
+	return &exportRepository{db: db}
+}
+
+// GetBook implements ExportRepository.
+func (r *exportRepository) GetBook(ctx context.Context, id int) (*entity.ExportBook, error) {
+	var book entity.ExportBook
+	err := conn(ctx, r.db).Model(&entity.Book{}).
+		Select("id", "title", "author", "description", "published_year", "cover_image_url").
+		Where("deleted_at IS NULL").First(&book, id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &book, nil
+}
+
+// StreamBook implements ExportRepository. The sections are read in one read-only repeatable
+// read transaction, so translations and media always belong to exported verses.
+func (r *exportRepository) StreamBook(ctx context.Context, bookID int, sink repository.BookExportSink) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := streamRows(tx, sink.Chapter, `SELECT c.id, c.chapter_number, c.title, cat.slug AS category_slug,
+				cat.name AS category_name, c.description
+			FROM chapters c
+			JOIN categories cat ON cat.id = c.category_id
+			WHERE c.book_id = ? AND c.deleted_at IS NULL
+			ORDER BY c.chapter_number, c.id`, bookID); err != nil {
+			return err
+		}
+		if err := streamRows(tx, sink.Verse, `SELECT v.id, c.chapter_number, v.verse_number, v.arabic_text, v.transliteration
+			FROM verses v
+			JOIN chapters c ON c.id = v.chapter_id
+			WHERE c.book_id = ? AND c.deleted_at IS NULL AND v.deleted_at IS NULL
+			ORDER BY c.chapter_number, c.id, v.verse_number, v.id`, bookID); err != nil {
+			return err
+		}
+		if err := streamRows(tx, sink.Translation, `SELECT t.id, t.verse_id, c.chapter_number, v.verse_number,
+				t.language_code, t.translation_text, t.translator_name
+			FROM translations t
+			JOIN verses v ON v.id = t.verse_id
+			JOIN chapters c ON c.id = v.chapter_id
+			WHERE c.book_id = ? AND c.deleted_at IS NULL AND v.deleted_at IS NULL AND t.deleted_at IS NULL
+			ORDER BY c.chapter_number, c.id, v.verse_number, v.id, t.language_code, t.id`, bookID); err != nil {
+			return err
+		}
+		return streamRows(tx, sink.Media, `SELECT m.id, m.verse_id, c.chapter_number, v.verse_number, m.media_type,
+				m.media_url, m.file_size, m.duration, m.codec, m.bitrate, m.description, h.name AS hadi_name
+			FROM verse_media m
+			JOIN verses v ON v.id = m.verse_id
+			JOIN chapters c ON c.id = v.chapter_id
+			LEFT JOIN hadi h ON h.id = m.hadi_id AND h.deleted_at IS NULL
+			WHERE c.book_id = ? AND c.deleted_at IS NULL AND v.deleted_at IS NULL AND m.deleted_at IS NULL
+			ORDER BY c.chapter_number, c.id, v.verse_number, v.id, m.id`, bookID)
+	}, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+}
+
+// ListBookletChapters implements ExportRepository. Chapters of deleted books are left out.
+func (r *exportRepository) ListBookletChapters(ctx context.Context, bookID int, chapterIDs []uint) ([]entity.BookletChapter, error) {
+	query := conn(ctx, r.db).Table("chapters c").
+		Select("c.id, c.book_id, c.chapter_number, c.title").
+		Joins("JOIN books b ON b.id = c.book_id AND b.deleted_at IS NULL").
+		Where("c.deleted_at IS NULL")
+	if len(chapterIDs) > 0 {
+		query = query.Where("c.id IN ?", chapterIDs)
+	} else {
+		query = query.Where("c.book_id = ?", bookID)
+	}
+
+	var chapters []entity.BookletChapter
+	if err := query.Order("c.chapter_number, c.id").Scan(&chapters).Error; err != nil {
+		return nil, err
+	}
+	return chapters, nil
+}
+
+// StreamBookletVerses implements ExportRepository.
+func (r *exportRepository) StreamBookletVerses(ctx context.Context, chapterIDs []uint, language string, fn func(*entity.BookletVerse) error) error {
+	if len(chapterIDs) == 0 {
+		return nil
+	}
+	return streamRows(conn(ctx, r.db), fn, `SELECT v.chapter_id, v.verse_number, v.arabic_text, v.transliteration,
+			t.translation_text AS translation
+		FROM verses v
+		LEFT JOIN translations t ON t.verse_id = v.id AND t.language_code = ? AND t.deleted_at IS NULL
+		WHERE v.chapter_id IN ? AND v.deleted_at IS NULL
+		ORDER BY array_position(ARRAY[?]::int[], v.chapter_id), v.verse_number, v.id`, language, chapterIDs, chapterIDs)
+}
+
+// streamRows runs query and scans its rows one at a time into fn
+func streamRows[T any](tx *gorm.DB, fn func(*T) error, query string, args ...any) error {
+	rows, err := tx.Raw(query, args...).Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var row T
+		if err := tx.ScanRows(rows, &row); err != nil {
+			return err
+		}
+		if err := fn(&row); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
@@ -0,0 +1,154 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"regexp"
+	"sync"
+	"testing"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
+)
+
+// fakeTableName finds the table a query reads
+var fakeTableName = regexp.MustCompile(`FROM "(\w+)"`)
+
+// fakeStatement is a statement the fake database received
+type fakeStatement struct {
+	SQL  string
+	InTx bool
+}
+
+// fakeTable holds the rows returned for every query on a table, whatever its conditions
+type fakeTable struct {
+	Columns []string
+	Rows    [][]driver.Value
+}
+
+// fakeDB is an in-memory database/sql driver recording the statements it receives.
+// The repo has no database for tests, so repositories are checked on the queries they
+// send and on how they map canned rows.
+type fakeDB struct {
+	mu         sync.Mutex
+	statements []fakeStatement
+	tables     map[string]fakeTable
+	// CommitErr is returned by every commit
+	CommitErr error
+}
+
+// newFakeDB opens gorm on a fake database serving tables
+func newFakeDB(t *testing.T, tables map[string]fakeTable) (*gorm.DB, *fakeDB) {
+	t.Helper()
+	fake := &fakeDB{tables: tables}
+	sqlDB := sql.OpenDB(fakeConnector{db: fake})
+	t.Cleanup(func() { sqlDB.Close() })
+
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{Logger: gormlogger.Discard})
+	if err != nil {
+		t.Fatalf("failed to open fake database: %v", err)
+	}
+	return db, fake
+}
+
+// Statements returns the statements received so far
+func (f *fakeDB) Statements() []fakeStatement {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]fakeStatement(nil), f.statements...)
+}
+
+func (f *fakeDB) record(query string, inTx bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.statements = append(f.statements, fakeStatement{SQL: query, InTx: inTx})
+}
+
+type fakeConnector struct {
+	db *fakeDB
+}
+
+func (c fakeConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	return &fakeConn{db: c.db}, nil
+}
+
+func (c fakeConnector) Driver() driver.Driver { return fakeDriver{} }
+
+type fakeDriver struct{}
+
+func (fakeDriver) Open(name string) (driver.Conn, error) {
+	return nil, errors.New("fake driver opens through its connector")
+}
+
+type fakeConn struct {
+	db   *fakeDB
+	inTx bool
+}
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return nil, errors.New("fake driver does not prepare statements")
+}
+
+func (c *fakeConn) Close() error { return nil }
+
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	return c.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+func (c *fakeConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	c.db.record("BEGIN", false)
+	c.inTx = true
+	return &fakeTx{conn: c}, nil
+}
+
+func (c *fakeConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	c.db.record(query, c.inTx)
+	return driver.RowsAffected(1), nil
+}
+
+func (c *fakeConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	c.db.record(query, c.inTx)
+	var table fakeTable
+	if match := fakeTableName.FindStringSubmatch(query); match != nil {
+		table = c.db.tables[match[1]]
+	}
+	return &fakeRows{table: table}, nil
+}
+
+type fakeTx struct {
+	conn *fakeConn
+}
+
+func (t *fakeTx) Commit() error {
+	t.conn.db.record("COMMIT", true)
+	t.conn.inTx = false
+	return t.conn.db.CommitErr
+}
+
+func (t *fakeTx) Rollback() error {
+	t.conn.db.record("ROLLBACK", true)
+	t.conn.inTx = false
+	return nil
+}
+
+type fakeRows struct {
+	table fakeTable
+	next  int
+}
+
+func (r *fakeRows) Columns() []string { return r.table.Columns }
+
+func (r *fakeRows) Close() error { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.next >= len(r.table.Rows) {
+		return io.EOF
+	}
+	copy(dest, r.table.Rows[r.next])
+	r.next++
+	return nil
+}
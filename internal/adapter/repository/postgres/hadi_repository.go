@@ -19,12 +19,12 @@ func NewHadiRepository(db *gorm.DB) portrepo.HadiRepository {
 }
 
 func (r *hadiRepository) Create(ctx context.Context, hadi *entity.Hadi) error {
-	return r.db.WithContext(ctx).Create(hadi).Error
+	return conn(ctx, r.db).Create(hadi).Error
 }
 
 func (r *hadiRepository) GetByID(ctx context.Context, id int) (*entity.Hadi, error) {
 	var hadi entity.Hadi
-	err := r.db.WithContext(ctx).First(&hadi, id).Error
+	err := conn(ctx, r.db).First(&hadi, id).Error
 	if err != nil {
 		return nil, err
 	}
@@ -35,7 +35,7 @@ func (r *hadiRepository) List(ctx context.Context, limit, offset int) ([]entity.
 	var hadis []entity.Hadi
 	var total int64
 
-	query := r.db.WithContext(ctx).Model(&entity.Hadi{})
+	query := conn(ctx, r.db).Model(&entity.Hadi{})
 
 	err := query.Count(&total).Error
 	if err != nil {
@@ -51,9 +51,48 @@ func (r *hadiRepository) List(ctx context.Context, limit, offset int) ([]entity.
 }
 
 func (r *hadiRepository) Update(ctx context.Context, hadi *entity.Hadi) error {
-	return r.db.WithContext(ctx).Save(hadi).Error
+	return conn(ctx, r.db).Save(hadi).Error
 }
 
 func (r *hadiRepository) Delete(ctx context.Context, id int) error {
-	return r.db.WithContext(ctx).Delete(&entity.Hadi{}, id).Error
+	return conn(ctx, r.db).Delete(&entity.Hadi{}, id).Error
+}
+
+// ListRepertoire joins the hadi's audio media with their verses, chapters and books
+func (r *hadiRepository) ListRepertoire(ctx context.Context, hadiID int) ([]portrepo.HadiRepertoireRow, error) {
+	var rows []portrepo.HadiRepertoireRow
+	err := conn(ctx, r.db).
+		Table("verse_media AS vm").
+		Select(`b.id AS book_id, b.title AS book_title, cat.name AS category,
+			c.id AS chapter_id, c.chapter_number, c.title AS chapter_title,
+			v.id AS verse_id, v.verse_number,
+			vm.id AS media_id, vm.media_url, vm.duration`).
+		Joins("JOIN verses v ON v.id = vm.verse_id AND v.deleted_at IS NULL").
+		Joins("JOIN chapters c ON c.id = v.chapter_id AND c.deleted_at IS NULL").
+		Joins("JOIN categories cat ON cat.id = c.category_id").
+		Joins("JOIN books b ON b.id = c.book_id AND b.deleted_at IS NULL").
+		Where("vm.hadi_id = ? AND vm.media_type = ? AND vm.deleted_at IS NULL", hadiID, entity.MediaTypeAudio).
+		Order("b.title, b.id, cat.sort_order, cat.id, c.chapter_number, v.verse_number, vm.id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// ListByVerse joins the verse's audio media with the hadi leading each recording
+func (r *hadiRepository) ListByVerse(ctx context.Context, verseID uint) ([]portrepo.VerseHadiRow, error) {
+	var rows []portrepo.VerseHadiRow
+	err := conn(ctx, r.db).
+		Table("verse_media AS vm").
+		Select(`h.id AS hadi_id, h.name AS hadi_name, h.image_url AS hadi_image_url, h.images AS hadi_images,
+			vm.id AS media_id, vm.media_url, vm.duration`).
+		Joins("JOIN hadi h ON h.id = vm.hadi_id AND h.deleted_at IS NULL").
+		Where("vm.verse_id = ? AND vm.media_type = ? AND vm.deleted_at IS NULL", verseID, entity.MediaTypeAudio).
+		Order("h.name, h.id, vm.id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
 }
@@ -0,0 +1,52 @@
+package postgres
+
+import (
+	"context"
+
+	"ishari-backend/internal/core/entity"
+	"ishari-backend/internal/core/port/repository"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type legacyRepository struct {
+	db *gorm.DB
+}
+
+// NewLegacyRepository creates a new legacy import repository implementation
+func NewLegacyRepository(db *gorm.DB) repository.LegacyRepository {
+	return &legacyRepository{db: db}
+}
+
+// FindChapterIDs implements LegacyRepository. Chapters of deleted books are left out.
+func (r *legacyRepository) FindChapterIDs(ctx context.Context, categorySlug string, chapterNumber, bookID uint) ([]uint, error) {
+	query := conn(ctx, r.db).
+		Table("chapters AS c").
+		Joins("JOIN categories cat ON cat.id = c.category_id AND cat.deleted_at IS NULL").
+		Joins("JOIN books b ON b.id = c.book_id AND b.deleted_at IS NULL").
+		Where("c.deleted_at IS NULL AND c.chapter_number = ? AND cat.slug = ?", chapterNumber, categorySlug)
+	if bookID != 0 {
+		query = query.Where("c.book_id = ?", bookID)
+	}
+	var ids []uint
+	if err := query.Order("c.id").Pluck("c.id", &ids).Error; err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// SaveIDMappings implements LegacyRepository
+func (r *legacyRepository) SaveIDMappings(ctx context.Context, mappings []entity.LegacyIDMapping) error {
+	if len(mappings) == 0 {
+		return nil
+	}
+	upsert := clause.OnConflict{
+		Columns: []clause.Column{{Name: "source"}, {Name: "legacy_table"}, {Name: "legacy_id"}, {Name: "entity_table"}},
+		DoUpdates: clause.Assignments(map[string]any{
+			"entity_id":   gorm.Expr("excluded.entity_id"),
+			"imported_at": gorm.Expr("now()"),
+		}),
+	}
+	return conn(ctx, r.db).Clauses(upsert).CreateInBatches(mappings, 500).Error
+}
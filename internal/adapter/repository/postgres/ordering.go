@@ -0,0 +1,100 @@
+package postgres
+
+import (
+	"fmt"
+	"slices"
+
+	"ishari-backend/internal/core/entity"
+	"ishari-backend/internal/core/port/repository"
+
+	"gorm.io/gorm"
+)
+
+// ordering describes a table whose rows are numbered 1..n within a parent, guarded by a
+// partial unique index on (scope, number) for live rows
+type ordering struct {
+	model  any
+	table  string
+	scope  string
+	number string
+}
+
+var (
+	verseOrdering   = ordering{model: &entity.Verse{}, table: "verses", scope: "chapter_id", number: "verse_number"}
+	chapterOrdering = ordering{model: &entity.Chapter{}, table: "chapters", scope: "book_id", number: "chapter_number"}
+)
+
+// ids returns the live rows of the parent in their current order
+func (o ordering) ids(tx *gorm.DB, scopeID uint) ([]uint, error) {
+	var ids []uint
+	err := tx.Model(o.model).Where(o.scope+" = ?", scopeID).Order(o.number).Order("id").Pluck("id", &ids).Error
+	return ids, err
+}
+
+// reorder renumbers the parent's live rows in the given order after checking that the
+// order lists each of them exactly once
+func (o ordering) reorder(tx *gorm.DB, scopeID uint, orderedIDs []uint) error {
+	current, err := o.ids(tx, scopeID)
+	if err != nil {
+		return err
+	}
+	if !sameIDs(current, orderedIDs) {
+		return repository.ErrOrderMismatch
+	}
+	return o.renumber(tx, scopeID, orderedIDs)
+}
+
+// move takes a row out of its current parent and inserts it at position (1-based, 0 for
+// last) of scopeID, renumbering both parents
+func (o ordering) move(tx *gorm.DB, id, fromScopeID, scopeID, position uint) error {
+	target, err := o.ids(tx, scopeID)
+	if err != nil {
+		return err
+	}
+	target = slices.DeleteFunc(target, func(v uint) bool { return v == id })
+	at := len(target)
+	if position > 0 && int(position) <= len(target) {
+		at = int(position) - 1
+	}
+	target = slices.Insert(target, at, id)
+
+	// The target goes first so the moved row leaves the old parent before it is renumbered
+	if err := o.renumber(tx, scopeID, target); err != nil {
+		return err
+	}
+	if fromScopeID == scopeID {
+		return nil
+	}
+	source, err := o.ids(tx, fromScopeID)
+	if err != nil {
+		return err
+	}
+	return o.renumber(tx, fromScopeID, source)
+}
+
+// renumber assigns 1..n to orderedIDs within scopeID. The unique index is not deferrable,
+// so rows are first parked on negative numbers and flipped once every row has moved.
+func (o ordering) renumber(tx *gorm.DB, scopeID uint, orderedIDs []uint) error {
+	if len(orderedIDs) == 0 {
+		return nil
+	}
+	park := fmt.Sprintf(`UPDATE %[1]s t SET %[2]s = ?, %[3]s = -o.pos, updated_at = now()
+		FROM unnest(ARRAY[?]::int[]) WITH ORDINALITY AS o(id, pos)
+		WHERE t.id = o.id`, o.table, o.scope, o.number)
+	if err := tx.Exec(park, scopeID, orderedIDs).Error; err != nil {
+		return err
+	}
+	flip := fmt.Sprintf(`UPDATE %[1]s SET %[2]s = -%[2]s WHERE id IN ?`, o.table, o.number)
+	return tx.Exec(flip, orderedIDs).Error
+}
+
+// sameIDs reports whether both lists hold the same IDs, each exactly once
+func sameIDs(current, ordered []uint) bool {
+	if len(current) != len(ordered) {
+		return false
+	}
+	a, b := slices.Clone(current), slices.Clone(ordered)
+	slices.Sort(a)
+	slices.Sort(b)
+	return slices.Equal(a, b)
+}
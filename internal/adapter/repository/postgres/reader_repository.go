@@ -0,0 +1,145 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"ishari-backend/internal/core/entity"
+	"ishari-backend/internal/core/port/repository"
+
+	"gorm.io/gorm"
+)
+
+type readerRepository struct {
+	db *gorm.DB
+}
+
+// NewReaderRepository creates a new reader repository
+func NewReaderRepository(db *gorm.DB) repository.ReaderRepository {
+	return &readerRepository{db: db}
+}
+
+// GetChapterReader runs a fixed number of queries whatever the chapter size: the chapter
+// with its book and category, the verses, then the translations and the media (with their hadi) of all
+// verses at once through a chapter_id subquery.
+func (r *readerRepository) GetChapterReader(ctx context.Context, filter repository.ChapterReaderFilter) (*entity.ChapterReader, error) {
+	db := conn(ctx, r.db)
+
+	var chapter entity.Chapter
+	if err := db.Preload("Book").Preload("Category").First(&chapter, filter.ChapterID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var verses []entity.Verse
+	if err := db.Where("chapter_id = ?", chapter.ID).Order("verse_number ASC, id ASC").Find(&verses).Error; err != nil {
+		return nil, err
+	}
+	reader := &entity.ChapterReader{Chapter: chapter, Verses: make([]entity.ReaderVerse, len(verses))}
+	if len(verses) == 0 {
+		return reader, nil
+	}
+
+	position := make(map[uint]int, len(verses))
+	for i, v := range verses {
+		reader.Verses[i].Verse = v
+		position[v.ID] = i
+	}
+	chapterVerses := db.Model(&entity.Verse{}).Select("id").Where("chapter_id = ?", chapter.ID)
+
+	var translations []entity.Translation
+	query := db.Where("verse_id IN (?)", chapterVerses)
+	if len(filter.Languages) > 0 {
+		query = query.Where("language_code IN ?", filter.Languages)
+	}
+	if err := query.Order("verse_id ASC, language_code ASC, id ASC").Find(&translations).Error; err != nil {
+		return nil, err
+	}
+	for _, t := range translations {
+		if i, ok := position[t.VerseID]; ok {
+			reader.Verses[i].Translations = append(reader.Verses[i].Translations, t)
+		}
+	}
+
+	var media []entity.VerseMedia
+	query = db.Preload("Hadi").Where("verse_id IN (?)", chapterVerses)
+	if len(filter.MediaTypes) > 0 {
+		query = query.Where("media_type IN ?", filter.MediaTypes)
+	}
+	if err := query.Order("verse_id ASC, media_type ASC, id ASC").Find(&media).Error; err != nil {
+		return nil, err
+	}
+	for _, m := range media {
+		if i, ok := position[m.VerseID]; ok {
+			reader.Verses[i].Media = append(reader.Verses[i].Media, m)
+		}
+	}
+
+	return reader, nil
+}
+
+// GetBookTOC aggregates the chapters in two queries: one row per chapter with its counts,
+// then one row per chapter and language for the translation coverage
+func (r *readerRepository) GetBookTOC(ctx context.Context, bookID int) (*entity.Book, []entity.TOCChapter, error) {
+	db := conn(ctx, r.db)
+
+	var book entity.Book
+	if err := db.Where("deleted_at IS NULL").First(&book, bookID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, nil
+		}
+		return nil, nil, err
+	}
+
+	const chaptersSQL = `SELECT c.id, c.chapter_number, c.title,
+			cat.id AS category_id, cat.slug AS category_slug, cat.name AS category, cat.sort_order AS category_order,
+			(SELECT count(*) FROM verses v WHERE v.chapter_id = c.id AND v.deleted_at IS NULL) AS verse_count,
+			EXISTS (
+				SELECT 1 FROM verse_media m
+				JOIN verses v ON v.id = m.verse_id AND v.deleted_at IS NULL
+				WHERE v.chapter_id = c.id AND m.media_type = @audio AND m.deleted_at IS NULL
+			) AS has_audio
+		FROM chapters c
+		JOIN categories cat ON cat.id = c.category_id
+		WHERE c.book_id = @book_id AND c.deleted_at IS NULL
+		ORDER BY c.chapter_number ASC, c.id ASC`
+
+	var chapters []entity.TOCChapter
+	err := db.Raw(chaptersSQL, map[string]any{"book_id": bookID, "audio": entity.MediaTypeAudio}).Scan(&chapters).Error
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(chapters) == 0 {
+		return &book, chapters, nil
+	}
+
+	const coverageSQL = `SELECT v.chapter_id, t.language_code, count(DISTINCT t.verse_id) AS translated_verses
+		FROM translations t
+		JOIN verses v ON v.id = t.verse_id AND v.deleted_at IS NULL
+		JOIN chapters c ON c.id = v.chapter_id AND c.deleted_at IS NULL
+		WHERE c.book_id = @book_id AND t.deleted_at IS NULL
+		GROUP BY v.chapter_id, t.language_code
+		ORDER BY v.chapter_id, t.language_code`
+
+	var coverage []struct {
+		ChapterID uint
+		entity.TranslationCoverage
+	}
+	if err := db.Raw(coverageSQL, map[string]any{"book_id": bookID}).Scan(&coverage).Error; err != nil {
+		return nil, nil, err
+	}
+
+	position := make(map[uint]int, len(chapters))
+	for i, c := range chapters {
+		position[c.ID] = i
+	}
+	for _, row := range coverage {
+		if i, ok := position[row.ChapterID]; ok {
+			chapters[i].Translations = append(chapters[i].Translations, row.TranslationCoverage)
+		}
+	}
+
+	return &book, chapters, nil
+}
@@ -0,0 +1,40 @@
+package postgres
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+
+	"ishari-backend/internal/core/port/repository"
+)
+
+func TestReaderRepository_GetChapterReader_LoadsCategory(t *testing.T) {
+	db, _ := newFakeDB(t, map[string]fakeTable{
+		"chapters": {
+			Columns: []string{"id", "book_id", "chapter_number", "title", "category_id"},
+			Rows:    [][]driver.Value{{int64(7), int64(1), int64(3), "Ya Rabbi", int64(2)}},
+		},
+		"books": {
+			Columns: []string{"id", "title"},
+			Rows:    [][]driver.Value{{int64(1), "Diwan"}},
+		},
+		"categories": {
+			Columns: []string{"id", "slug", "name"},
+			Rows:    [][]driver.Value{{int64(2), "muhud", "Muhud"}},
+		},
+	})
+
+	reader, err := NewReaderRepository(db).GetChapterReader(context.Background(), repository.ChapterReaderFilter{ChapterID: 7})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if reader == nil {
+		t.Fatal("expected the chapter to be found")
+	}
+	if reader.Chapter.Book == nil || reader.Chapter.Book.Title != "Diwan" {
+		t.Errorf("expected the book to be loaded, got %+v", reader.Chapter.Book)
+	}
+	if reader.Chapter.Category == nil || reader.Chapter.Category.Slug != "muhud" {
+		t.Errorf("expected the category to be loaded, got %+v", reader.Chapter.Category)
+	}
+}
@@ -21,13 +21,13 @@ func NewRefreshTokenRepository(db *gorm.DB) repository.RefreshTokenRepository {
 
 // Create stores a new refresh token
 func (r *refreshTokenRepository) Create(ctx context.Context, token *entity.RefreshToken) error {
-	return r.db.WithContext(ctx).Create(token).Error
+	return conn(ctx, r.db).Create(token).Error
 }
 
 // GetByTokenHash retrieves a refresh token by its hash
 func (r *refreshTokenRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*entity.RefreshToken, error) {
 	var token entity.RefreshToken
-	err := r.db.WithContext(ctx).
+	err := conn(ctx, r.db).
 		Where("token_hash = ?", tokenHash).
 		First(&token).Error
 	if err != nil {
@@ -39,7 +39,7 @@ func (r *refreshTokenRepository) GetByTokenHash(ctx context.Context, tokenHash s
 // RevokeByTokenHash revokes a specific token
 func (r *refreshTokenRepository) RevokeByTokenHash(ctx context.Context, tokenHash string) error {
 	now := time.Now()
-	return r.db.WithContext(ctx).
+	return conn(ctx, r.db).
 		Model(&entity.RefreshToken{}).
 		Where("token_hash = ?", tokenHash).
 		Update("revoked_at", now).Error
@@ -48,7 +48,7 @@ func (r *refreshTokenRepository) RevokeByTokenHash(ctx context.Context, tokenHas
 // RevokeAllByUserID revokes all tokens for a user
 func (r *refreshTokenRepository) RevokeAllByUserID(ctx context.Context, userID uint) error {
 	now := time.Now()
-	return r.db.WithContext(ctx).
+	return conn(ctx, r.db).
 		Model(&entity.RefreshToken{}).
 		Where("user_id = ? AND revoked_at IS NULL", userID).
 		Update("revoked_at", now).Error
@@ -56,7 +56,7 @@ func (r *refreshTokenRepository) RevokeAllByUserID(ctx context.Context, userID u
 
 // DeleteExpired removes expired tokens from the database
 func (r *refreshTokenRepository) DeleteExpired(ctx context.Context) error {
-	return r.db.WithContext(ctx).
+	return conn(ctx, r.db).
 		Where("expires_at < ?", time.Now()).
 		Delete(&entity.RefreshToken{}).Error
 }
@@ -0,0 +1,56 @@
+package postgres
+
+import (
+	"context"
+
+	"ishari-backend/internal/core/entity"
+	"ishari-backend/internal/core/port/repository"
+
+	"gorm.io/gorm"
+)
+
+type searchHistoryRepository struct {
+	db *gorm.DB
+}
+
+// NewSearchHistoryRepository creates a new instance of SearchHistoryRepository
+func NewSearchHistoryRepository(db *gorm.DB) repository.SearchHistoryRepository {
+	return &searchHistoryRepository{db: db}
+}
+
+// Create records a search
+func (r *searchHistoryRepository) Create(ctx context.Context, history *entity.SearchHistory) error {
+	return conn(ctx, r.db).Create(history).Error
+}
+
+// ListByUserID retrieves the user's searches with pagination, newest first
+func (r *searchHistoryRepository) ListByUserID(ctx context.Context, userID uint, offset, limit int) ([]entity.SearchHistory, int64, error) {
+	var history []entity.SearchHistory
+	var total int64
+
+	query := conn(ctx, r.db).Model(&entity.SearchHistory{}).Where("user_id = ?", userID)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.Order("searched_at DESC, id DESC").Offset(offset).Limit(limit).Find(&history).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	return history, total, nil
+}
+
+// Delete soft deletes a search owned by the user
+func (r *searchHistoryRepository) Delete(ctx context.Context, id, userID uint) (bool, error) {
+	result := conn(ctx, r.db).Where("user_id = ?", userID).Delete(&entity.SearchHistory{}, id)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// DeleteByUserID soft deletes all of the user's searches
+func (r *searchHistoryRepository) DeleteByUserID(ctx context.Context, userID uint) (int64, error) {
+	result := conn(ctx, r.db).Where("user_id = ?", userID).Delete(&entity.SearchHistory{})
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,276 @@
+package postgres
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+
+	"ishari-backend/internal/core/domain/arabic"
+	"ishari-backend/internal/core/entity"
+	"ishari-backend/internal/core/port/repository"
+
+	"gorm.io/gorm"
+)
+
+// ts_headline wraps matches in <mark> and joins fragments with an ellipsis
+const (
+	headlineStartSel  = "<mark>"
+	headlineStopSel   = "</mark>"
+	headlineDelimiter = " … "
+	headlineOptions   = "StartSel=" + headlineStartSel + ", StopSel=" + headlineStopSel +
+		", MaxWords=25, MinWords=8, MaxFragments=2, FragmentDelimiter=\"" + headlineDelimiter + "\""
+)
+
+// searchCTE parses the raw and the Arabic-normalized query once and opens the matches CTE
+const searchCTE = "WITH q AS (SELECT websearch_to_tsquery('simple', @query) AS query, websearch_to_tsquery('simple', @arabic_query) AS arabic_query), matches AS ("
+
+// searchColumns and searchJoins add the chapter and book context to a match on verses v
+const (
+	searchColumns = `v.verse_number, v.arabic_text, v.transliteration,
+		c.id AS chapter_id, c.chapter_number, c.title AS chapter_title, cat.name AS category,
+		b.id AS book_id, b.title AS book_title`
+	searchJoins = `JOIN chapters c ON c.id = v.chapter_id AND c.deleted_at IS NULL
+		JOIN categories cat ON cat.id = c.category_id
+		JOIN books b ON b.id = c.book_id AND b.deleted_at IS NULL`
+)
+
+type searchRepository struct {
+	db *gorm.DB
+}
+
+// NewSearchRepository creates a new full-text search repository
+func NewSearchRepository(db *gorm.DB) repository.SearchRepository {
+	return &searchRepository{db: db}
+}
+
+// Search runs the query against the verse and translation tsvector indexes.
+// Verses are matched on their normalized text with a normalized query, so harakat
+// and letter variants do not matter. The to_tsvector expressions must stay identical
+// to the index definitions (idx_verses_arabic_normalized, idx_translations_text)
+// for the planner to use them. Verse snippets are highlighted on the normalized
+// text, then rebuilt on the original text so they keep their harakat.
+func (r *searchRepository) Search(ctx context.Context, filter repository.SearchFilter) ([]entity.SearchResult, uint, error) {
+	matches, args := searchMatches(filter)
+
+	var total int64
+	countSQL := searchCTE + matches + ") SELECT count(*) FROM matches"
+	if err := conn(ctx, r.db).Raw(countSQL, args).Scan(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	if total == 0 || filter.Limit == 0 {
+		return []entity.SearchResult{}, uint(total), nil
+	}
+
+	// snippets are only built for the requested page
+	args["limit"] = filter.Limit
+	args["offset"] = filter.Offset
+	args["headline"] = headlineOptions
+	pageSQL := searchCTE + matches + `),
+		page AS (SELECT * FROM matches ORDER BY rank DESC, verse_id, source DESC, translation_id LIMIT @limit OFFSET @offset)
+		SELECT page.source, page.verse_id, page.translation_id, page.language_code, page.rank,
+			page.verse_number, page.arabic_text, page.transliteration,
+			page.chapter_id, page.chapter_number, page.chapter_title, page.category,
+			page.book_id, page.book_title,
+			ts_headline('simple', page.body, page.query, @headline) AS snippet
+		FROM page
+		ORDER BY page.rank DESC, page.verse_id, page.source DESC, page.translation_id`
+
+	var results []entity.SearchResult
+	if err := conn(ctx, r.db).Raw(pageSQL, args).Scan(&results).Error; err != nil {
+		return nil, 0, err
+	}
+	for i := range results {
+		if results[i].Source == entity.SearchSourceVerse {
+			results[i].Snippet = verseSnippet(results[i].ArabicText, results[i].Snippet)
+		}
+	}
+	return results, uint(total), nil
+}
+
+// verseSnippet rebuilds a headline of the normalized verse text on the original text.
+// Normalization only drops and folds characters within words, so each normalized word
+// comes from one original word: every fragment is located in the normalized text and
+// replaced by the original words it spans, marking those that hold a match. The
+// headline is returned unchanged when it cannot be located.
+func verseSnippet(original, headline string) string {
+	tokens := strings.Fields(original)
+	var (
+		normalized strings.Builder
+		starts     []int // offset of each normalized word in normalized
+		origin     []int // index in tokens of each normalized word
+	)
+	for i, token := range tokens {
+		word := arabic.Normalize(token)
+		if word == "" {
+			// marks standing alone, such as Quranic pause signs, vanish from the normalized text
+			continue
+		}
+		if normalized.Len() > 0 {
+			normalized.WriteByte(' ')
+		}
+		starts = append(starts, normalized.Len())
+		origin = append(origin, i)
+		normalized.WriteString(word)
+	}
+	text := normalized.String()
+
+	fragments := strings.Split(headline, headlineDelimiter)
+	rebuilt := make([]string, 0, len(fragments))
+	offset := 0
+	for _, fragment := range fragments {
+		plain, marks := stripHeadlineMarks(fragment)
+		at := strings.Index(text[offset:], plain)
+		if plain == "" || at < 0 {
+			return headline
+		}
+		at += offset
+		offset = at + len(plain)
+
+		marked := make(map[int]bool)
+		for _, mark := range marks {
+			if mark[0] < 0 || mark[1] > len(plain) {
+				continue
+			}
+			for w := wordAt(starts, at+mark[0]); w <= wordAt(starts, at+mark[1]-1); w++ {
+				marked[origin[w]] = true
+			}
+		}
+		words := make([]string, 0, origin[wordAt(starts, offset-1)]-origin[wordAt(starts, at)]+1)
+		for i := origin[wordAt(starts, at)]; i <= origin[wordAt(starts, offset-1)]; i++ {
+			if marked[i] {
+				words = append(words, headlineStartSel+tokens[i]+headlineStopSel)
+			} else {
+				words = append(words, tokens[i])
+			}
+		}
+		rebuilt = append(rebuilt, strings.Join(words, " "))
+	}
+	return strings.Join(rebuilt, headlineDelimiter)
+}
+
+// stripHeadlineMarks removes the match markers of a headline fragment, returning the
+// trimmed text and the byte range of every non-empty match in it
+func stripHeadlineMarks(fragment string) (string, [][2]int) {
+	var (
+		plain strings.Builder
+		marks [][2]int
+	)
+	for {
+		start := strings.Index(fragment, headlineStartSel)
+		if start < 0 {
+			break
+		}
+		plain.WriteString(fragment[:start])
+		fragment = fragment[start+len(headlineStartSel):]
+		stop := strings.Index(fragment, headlineStopSel)
+		if stop < 0 {
+			stop = len(fragment)
+		}
+		if stop > 0 {
+			marks = append(marks, [2]int{plain.Len(), plain.Len() + stop})
+		}
+		plain.WriteString(fragment[:stop])
+		fragment = strings.TrimPrefix(fragment[stop:], headlineStopSel)
+	}
+	plain.WriteString(fragment)
+
+	text := strings.TrimLeft(plain.String(), " ")
+	trimmed := plain.Len() - len(text)
+	for i := range marks {
+		marks[i][0] -= trimmed
+		marks[i][1] -= trimmed
+	}
+	return strings.TrimRight(text, " "), marks
+}
+
+// wordAt returns the index of the word holding byte offset pos, given the word starts
+func wordAt(starts []int, pos int) int {
+	return sort.SearchInts(starts, pos+1) - 1
+}
+
+// searchMatches builds the UNION of verse and translation matches with their context.
+// It expects a CTE named q holding the tsqueries (see searchCTE).
+func searchMatches(filter repository.SearchFilter) (string, map[string]any) {
+	args := map[string]any{"query": filter.Query, "arabic_query": arabic.Normalize(filter.Query)}
+	contextWhere := searchContextWhere(filter, args)
+
+	verses := `SELECT 'verse' AS source, v.id AS verse_id, NULL::integer AS translation_id, 'ar' AS language_code,
+			v.arabic_normalized AS body, q.arabic_query AS query,
+			ts_rank(to_tsvector('simple', v.arabic_normalized), q.arabic_query) AS rank, ` + searchColumns + `
+		FROM verses v ` + searchJoins + `, q
+		WHERE to_tsvector('simple', v.arabic_normalized) @@ q.arabic_query AND v.deleted_at IS NULL` + contextWhere
+
+	translations := `SELECT 'translation' AS source, v.id AS verse_id, t.id AS translation_id, t.language_code,
+			t.translation_text AS body, q.query,
+			ts_rank(to_tsvector('simple', t.translation_text), q.query) AS rank, ` + searchColumns + `
+		FROM translations t
+		JOIN verses v ON v.id = t.verse_id AND v.deleted_at IS NULL ` + searchJoins + `, q
+		WHERE to_tsvector('simple', t.translation_text) @@ q.query AND t.deleted_at IS NULL` + contextWhere
+
+	switch filter.Language {
+	case "":
+		return verses + "\n\t\tUNION ALL\n\t\t" + translations, args
+	case "ar":
+		return verses, args
+	default:
+		args["language"] = filter.Language
+		return translations + " AND t.language_code = @language", args
+	}
+}
+
+// SearchTransliteration matches verse transliterations by pg_trgm word similarity.
+// The threshold is applied through pg_trgm.word_similarity_threshold so the <% operator
+// can use idx_verses_transliteration_trgm; set_config is transaction-local, hence the
+// transaction around the count and page queries.
+func (r *searchRepository) SearchTransliteration(ctx context.Context, filter repository.SearchFilter) ([]entity.SearchResult, uint, error) {
+	args := map[string]any{"query": filter.Query}
+	matches := `SELECT 'verse' AS source, v.id AS verse_id, 'ar' AS language_code,
+			v.transliteration AS snippet,
+			word_similarity(@query, v.transliteration) AS rank, ` + searchColumns + `
+		FROM verses v ` + searchJoins + `
+		WHERE @query <% v.transliteration AND v.deleted_at IS NULL` + searchContextWhere(filter, args)
+
+	var total int64
+	var results []entity.SearchResult
+	err := conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		threshold := strconv.FormatFloat(filter.Threshold, 'f', -1, 64)
+		if err := tx.Exec("SELECT set_config('pg_trgm.word_similarity_threshold', ?, true)", threshold).Error; err != nil {
+			return err
+		}
+		if err := tx.Raw("SELECT count(*) FROM ("+matches+") matches", args).Scan(&total).Error; err != nil {
+			return err
+		}
+		if total == 0 || filter.Limit == 0 {
+			return nil
+		}
+
+		args["limit"] = filter.Limit
+		args["offset"] = filter.Offset
+		return tx.Raw(matches+" ORDER BY rank DESC, v.id LIMIT @limit OFFSET @offset", args).Scan(&results).Error
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	if results == nil {
+		results = []entity.SearchResult{}
+	}
+	return results, uint(total), nil
+}
+
+// searchContextWhere returns the book and category conditions of filter, adding their args
+func searchContextWhere(filter repository.SearchFilter, args map[string]any) string {
+	var where []string
+	if filter.BookID != nil {
+		where = append(where, "b.id = @book_id")
+		args["book_id"] = *filter.BookID
+	}
+	if filter.Category != "" {
+		where = append(where, "(cat.slug = @category OR cat.name = @category)")
+		args["category"] = filter.Category
+	}
+	if len(where) == 0 {
+		return ""
+	}
+	return " AND " + strings.Join(where, " AND ")
+}
@@ -0,0 +1,50 @@
+package postgres
+
+import "testing"
+
+func TestVerseSnippet(t *testing.T) {
+	tests := []struct {
+		name     string
+		original string
+		headline string
+		want     string
+	}{
+		{
+			name:     "whole verse keeps its harakat",
+			original: "يَا رَبِّ صَلِّ عَلَى مُحَمَّدٍ",
+			headline: "يا رب صل <mark>علي</mark> <mark>محمد</mark>",
+			want:     "يَا رَبِّ صَلِّ <mark>عَلَى</mark> <mark>مُحَمَّدٍ</mark>",
+		},
+		{
+			name:     "fragments map to the words they span",
+			original: "اَلْحَمْدُ لِلّٰهِ رَبِّ الْعٰلَمِيْنَ وَالصَّلَاةُ عَلَى النَّبِيِّ",
+			headline: "<mark>الحمد</mark> لله … علي <mark>النبي</mark>",
+			want:     "<mark>اَلْحَمْدُ</mark> لِلّٰهِ … عَلَى <mark>النَّبِيِّ</mark>",
+		},
+		{
+			name:     "standalone marks inside a fragment are kept",
+			original: "رَبِّ ۖ الْعٰلَمِيْنَ",
+			headline: "رب <mark>العلمين</mark>",
+			want:     "رَبِّ ۖ <mark>الْعٰلَمِيْنَ</mark>",
+		},
+		{
+			name:     "fragment ending inside a word with punctuation",
+			original: "قَالَ، ثُمَّ",
+			headline: "<mark>قال</mark>",
+			want:     "<mark>قَالَ،</mark>",
+		},
+		{
+			name:     "unknown headline is returned unchanged",
+			original: "مُحَمَّدٍ",
+			headline: "<mark>احمد</mark>",
+			want:     "<mark>احمد</mark>",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := verseSnippet(tt.original, tt.headline); got != tt.want {
+				t.Errorf("verseSnippet() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
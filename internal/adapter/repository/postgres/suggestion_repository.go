@@ -0,0 +1,73 @@
+package postgres
+
+import (
+	"context"
+
+	"ishari-backend/internal/core/entity"
+	"ishari-backend/internal/core/port/repository"
+
+	"gorm.io/gorm"
+)
+
+type suggestionRepository struct {
+	db *gorm.DB
+}
+
+// NewSuggestionRepository creates a new autocomplete source repository
+func NewSuggestionRepository(db *gorm.DB) repository.SuggestionRepository {
+	return &suggestionRepository{db: db}
+}
+
+// ListSuggestions loads the suggestion sources in one round trip. Chapters and verses
+// of deleted books or chapters are skipped since their targets are not reachable.
+func (r *suggestionRepository) ListSuggestions(ctx context.Context, popular repository.PopularQueryFilter) ([]entity.Suggestion, error) {
+	const query = `SELECT 'book' AS type, b.id AS target_id, b.title AS text, 0 AS weight
+		FROM books b WHERE b.deleted_at IS NULL
+		UNION ALL
+		SELECT 'chapter', c.id, c.title, 0
+		FROM chapters c JOIN books b ON b.id = c.book_id AND b.deleted_at IS NULL
+		WHERE c.deleted_at IS NULL
+		UNION ALL
+		SELECT 'hadi', h.id, h.name, 0
+		FROM hadi h WHERE h.deleted_at IS NULL
+		UNION ALL
+		SELECT 'verse', v.id, v.transliteration, 0
+		FROM verses v
+		JOIN chapters c ON c.id = v.chapter_id AND c.deleted_at IS NULL
+		JOIN books b ON b.id = c.book_id AND b.deleted_at IS NULL
+		WHERE v.deleted_at IS NULL AND btrim(coalesce(v.transliteration, '')) <> ''
+		UNION ALL
+		(SELECT 'query', NULL, min(sh.search_query), count(DISTINCT sh.user_id)
+		FROM search_history sh
+		WHERE sh.deleted_at IS NULL AND sh.results_count > 0
+		GROUP BY lower(sh.search_query)
+		HAVING count(DISTINCT sh.user_id) >= @min_users
+		ORDER BY count(DISTINCT sh.user_id) DESC
+		LIMIT @limit)`
+
+	var suggestions []entity.Suggestion
+	err := conn(ctx, r.db).Raw(query, map[string]any{
+		"min_users": popular.MinUsers,
+		"limit":     popular.Limit,
+	}).Scan(&suggestions).Error
+	if err != nil {
+		return nil, err
+	}
+	return suggestions, nil
+}
+
+// ContentVersion combines the row count and the latest update and delete time of each
+// source table. Soft deletes move deleted_at and hard deletes change the count.
+func (r *suggestionRepository) ContentVersion(ctx context.Context) (string, error) {
+	const query = `SELECT concat_ws('|',
+		(SELECT concat_ws('/', count(*), max(updated_at), max(deleted_at)) FROM books),
+		(SELECT concat_ws('/', count(*), max(updated_at), max(deleted_at)) FROM chapters),
+		(SELECT concat_ws('/', count(*), max(updated_at), max(deleted_at)) FROM hadi),
+		(SELECT concat_ws('/', count(*), max(updated_at), max(deleted_at)) FROM verses))`
+
+	var version string
+	if err := conn(ctx, r.db).Raw(query).Scan(&version).Error; err != nil {
+		return "", err
+	}
+	return version, nil
+}
@@ -0,0 +1,111 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+
+	"ishari-backend/internal/core/port/repository"
+	portuc "ishari-backend/internal/core/port/usecase"
+
+	"gorm.io/gorm"
+)
+
+// errPanicked rolls back a unit of work whose function panicked
+var errPanicked = errors.New("unit of work panicked")
+
+type unitKey struct{}
+
+// unit is the transaction of one unit of work. It begins on the first repository call rather
+// than in WithinTransaction, so the HTTP middleware can open the unit before the auth
+// middleware has put the acting user into the context, and units that never touch the
+// database cost nothing.
+type unit struct {
+	mu   sync.Mutex
+	db   *gorm.DB
+	tx   *gorm.DB
+	done bool
+}
+
+type transactor struct {
+	db *gorm.DB
+}
+
+// NewTransactor creates a transactor whose transactions record the acting user for the
+// audit_logs trigger
+func NewTransactor(db *gorm.DB) repository.Transactor {
+	return &transactor{db: db}
+}
+
+// WithinTransaction runs fn in a unit of work; nested calls join the outer unit
+func (t *transactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(unitKey{}).(*unit); ok {
+		return fn(ctx)
+	}
+
+	u := &unit{db: t.db}
+	defer func() {
+		if p := recover(); p != nil {
+			_ = u.finish(errPanicked)
+			panic(p)
+		}
+	}()
+	return u.finish(fn(context.WithValue(ctx, unitKey{}, u)))
+}
+
+// conn returns the handle repositories query through: the transaction of the unit of work in
+// ctx when there is one, the connection pool otherwise
+func conn(ctx context.Context, db *gorm.DB) *gorm.DB {
+	u, ok := ctx.Value(unitKey{}).(*unit)
+	if !ok {
+		return db.WithContext(ctx)
+	}
+	return u.conn(ctx)
+}
+
+// conn begins the transaction on first use and records the acting user in it when there is
+// one. Goroutines that outlive the unit use the pool.
+func (u *unit) conn(ctx context.Context) *gorm.DB {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	if u.done {
+		return u.db.WithContext(ctx)
+	}
+	if u.tx != nil {
+		return u.tx.WithContext(ctx)
+	}
+
+	// The transaction lives as long as the unit, not as long as the first call's context
+	tx := u.db.WithContext(context.WithoutCancel(ctx)).Begin()
+	if tx.Error != nil {
+		return tx
+	}
+	if claims, ok := portuc.GetUserFromContext(ctx); ok && claims != nil {
+		// Equivalent to SET LOCAL app.current_user_id, which does not accept parameters
+		if err := tx.Exec("SELECT set_config('app.current_user_id', ?, true)", strconv.FormatUint(uint64(claims.UserID), 10)).Error; err != nil {
+			tx.Rollback()
+			db := u.db.WithContext(ctx)
+			_ = db.AddError(err)
+			return db
+		}
+	}
+	u.tx = tx
+	return u.tx.WithContext(ctx)
+}
+
+// finish commits the transaction when err is nil and rolls it back otherwise
+func (u *unit) finish(err error) error {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	u.done = true
+	if u.tx == nil {
+		return err
+	}
+	if err != nil {
+		return errors.Join(err, u.tx.Rollback().Error)
+	}
+	return u.tx.Commit().Error
+}
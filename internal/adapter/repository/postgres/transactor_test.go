@@ -0,0 +1,155 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	portuc "ishari-backend/internal/core/port/usecase"
+)
+
+const setUserSQL = "SELECT set_config('app.current_user_id', $1, true)"
+
+// trace lists statements as BEGIN, COMMIT and ROLLBACK, and queries prefixed with tx or pool
+func trace(statements []fakeStatement) []string {
+	out := make([]string, 0, len(statements))
+	for _, s := range statements {
+		switch {
+		case s.SQL == "BEGIN" || s.SQL == "COMMIT" || s.SQL == "ROLLBACK":
+			out = append(out, s.SQL)
+		case s.InTx:
+			out = append(out, "tx: "+s.SQL)
+		default:
+			out = append(out, "pool: "+s.SQL)
+		}
+	}
+	return out
+}
+
+func signedIn() context.Context {
+	return portuc.NewContextWithUser(context.Background(), &portuc.TokenClaims{UserID: 42})
+}
+
+func TestTransactor_CommitsAttributedWrites(t *testing.T) {
+	db, fake := newFakeDB(t, nil)
+
+	err := NewTransactor(db).WithinTransaction(signedIn(), func(ctx context.Context) error {
+		if err := conn(ctx, db).Exec("UPDATE verses SET arabic_text = 'a'").Error; err != nil {
+			return err
+		}
+		return conn(ctx, db).Exec("UPDATE verses SET arabic_text = 'b'").Error
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	want := []string{"BEGIN", "tx: " + setUserSQL, "tx: UPDATE verses SET arabic_text = 'a'", "tx: UPDATE verses SET arabic_text = 'b'", "COMMIT"}
+	if got := trace(fake.Statements()); !slices.Equal(got, want) {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
+
+func TestTransactor_AnonymousWritesStillCommitTogether(t *testing.T) {
+	db, fake := newFakeDB(t, nil)
+
+	err := NewTransactor(db).WithinTransaction(context.Background(), func(ctx context.Context) error {
+		if err := conn(ctx, db).Exec("UPDATE verses SET arabic_text = 'a'").Error; err != nil {
+			return err
+		}
+		return conn(ctx, db).Exec("UPDATE verses SET arabic_text = 'b'").Error
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	// there is no user to record, but the writes are still one transaction
+	want := []string{"BEGIN", "tx: UPDATE verses SET arabic_text = 'a'", "tx: UPDATE verses SET arabic_text = 'b'", "COMMIT"}
+	if got := trace(fake.Statements()); !slices.Equal(got, want) {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
+
+func TestTransactor_RollsBackOnError(t *testing.T) {
+	db, fake := newFakeDB(t, nil)
+	fnErr := errors.New("verse number taken")
+
+	err := NewTransactor(db).WithinTransaction(signedIn(), func(ctx context.Context) error {
+		if err := conn(ctx, db).Exec("UPDATE verses SET arabic_text = 'a'").Error; err != nil {
+			return err
+		}
+		return fnErr
+	})
+	if !errors.Is(err, fnErr) {
+		t.Fatalf("expected the error of fn, got %v", err)
+	}
+
+	want := []string{"BEGIN", "tx: " + setUserSQL, "tx: UPDATE verses SET arabic_text = 'a'", "ROLLBACK"}
+	if got := trace(fake.Statements()); !slices.Equal(got, want) {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
+
+func TestTransactor_ReturnsCommitError(t *testing.T) {
+	db, fake := newFakeDB(t, nil)
+	fake.CommitErr = errors.New("serialization failure")
+
+	err := NewTransactor(db).WithinTransaction(signedIn(), func(ctx context.Context) error {
+		return conn(ctx, db).Exec("UPDATE verses SET arabic_text = 'a'").Error
+	})
+	if !errors.Is(err, fake.CommitErr) {
+		t.Errorf("expected the commit error, got %v", err)
+	}
+}
+
+func TestTransactor_NestedCallsJoinOuterUnit(t *testing.T) {
+	db, fake := newFakeDB(t, nil)
+	transactor := NewTransactor(db)
+
+	err := transactor.WithinTransaction(signedIn(), func(ctx context.Context) error {
+		if err := conn(ctx, db).Exec("UPDATE verses SET arabic_text = 'a'").Error; err != nil {
+			return err
+		}
+		err := transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+			return conn(ctx, db).Exec("UPDATE translations SET translation_text = 'b'").Error
+		})
+		if err != nil {
+			return err
+		}
+		// the inner call must not have committed
+		if got := trace(fake.Statements()); slices.Contains(got, "COMMIT") {
+			t.Errorf("expected no commit before the outer unit returns, got %q", got)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	want := []string{"BEGIN", "tx: " + setUserSQL, "tx: UPDATE verses SET arabic_text = 'a'", "tx: UPDATE translations SET translation_text = 'b'", "COMMIT"}
+	if got := trace(fake.Statements()); !slices.Equal(got, want) {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
+
+func TestTransactor_FinishedUnitFallsBackToPool(t *testing.T) {
+	db, fake := newFakeDB(t, nil)
+
+	var unitCtx context.Context
+	err := NewTransactor(db).WithinTransaction(signedIn(), func(ctx context.Context) error {
+		unitCtx = ctx
+		return conn(ctx, db).Exec("UPDATE verses SET arabic_text = 'a'").Error
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	// a goroutine outliving the request still holds the unit in its context
+	if err := conn(unitCtx, db).Exec("INSERT INTO search_histories (query) VALUES ('a')").Error; err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	want := []string{"BEGIN", "tx: " + setUserSQL, "tx: UPDATE verses SET arabic_text = 'a'", "COMMIT", "pool: INSERT INTO search_histories (query) VALUES ('a')"}
+	if got := trace(fake.Statements()); !slices.Equal(got, want) {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
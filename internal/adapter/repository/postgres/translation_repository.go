@@ -20,7 +20,7 @@ func NewTranslationRepository(db *gorm.DB) repository.TranslationRepository {
 
 // Create implements TranslationRepository.
 func (r *TranslationRepository) Create(ctx context.Context, translation *entity.Translation) error {
-	return r.db.WithContext(ctx).Create(translation).Error
+	return conn(ctx, r.db).Create(translation).Error
 }
 
 // List implements TranslationRepository.
@@ -30,7 +30,7 @@ func (r *TranslationRepository) List(ctx context.Context, filter repository.Tran
 		translations []entity.Translation
 	)
 
-	base := r.db.WithContext(ctx).Model(&entity.Translation{})
+	base := conn(ctx, r.db).Model(&entity.Translation{})
 
 	if filter.Search = strings.TrimSpace(filter.Search); filter.Search != "" {
 		q := "%" + filter.Search + "%"
@@ -64,7 +64,7 @@ func (r *TranslationRepository) List(ctx context.Context, filter repository.Tran
 // GetByVerseId implements TranslationRepository.
 func (r *TranslationRepository) GetByVerseId(ctx context.Context, verseId uint) ([]entity.Translation, error) {
 	var translations []entity.Translation
-	if err := r.db.WithContext(ctx).Preload("Verse").Where("verse_id = ?", verseId).Find(&translations).Error; err != nil {
+	if err := conn(ctx, r.db).Preload("Verse").Where("verse_id = ?", verseId).Find(&translations).Error; err != nil {
 		return nil, err
 	}
 	return translations, nil
@@ -73,7 +73,7 @@ func (r *TranslationRepository) GetByVerseId(ctx context.Context, verseId uint)
 // GetById implements TranslationRepository.
 func (r *TranslationRepository) GetById(ctx context.Context, id uint) (*entity.Translation, error) {
 	var translation entity.Translation
-	if err := r.db.WithContext(ctx).Preload("Verse").Where("id = ?", id).First(&translation).Error; err != nil {
+	if err := conn(ctx, r.db).Preload("Verse").Where("id = ?", id).First(&translation).Error; err != nil {
 		return nil, err
 	}
 	return &translation, nil
@@ -81,28 +81,28 @@ func (r *TranslationRepository) GetById(ctx context.Context, id uint) (*entity.T
 
 // Update implements TranslationRepository.
 func (r *TranslationRepository) Update(ctx context.Context, translation *entity.Translation) error {
-	return r.db.WithContext(ctx).Save(translation).Error
+	return conn(ctx, r.db).Save(translation).Error
 }
 
 // Delete implements TranslationRepository.
 func (r *TranslationRepository) Delete(ctx context.Context, id uint) error {
-	return r.db.WithContext(ctx).Delete(&entity.Translation{}, id).Error
+	return conn(ctx, r.db).Delete(&entity.Translation{}, id).Error
 }
 
 // GetDropdownData implements TranslationRepository.
 func (r *TranslationRepository) GetDropdownData(ctx context.Context) (verses []entity.Verse, translatorNames []string, languageCodes []string, err error) {
 	// 1. Verses (id + arabic_text)
-	if err = r.db.WithContext(ctx).Model(&entity.Verse{}).Select("id, arabic_text").Order("id ASC").Find(&verses).Error; err != nil {
+	if err = conn(ctx, r.db).Model(&entity.Verse{}).Select("id, arabic_text").Order("id ASC").Find(&verses).Error; err != nil {
 		return
 	}
 
 	// 2. Distinct translator names
-	if err = r.db.WithContext(ctx).Model(&entity.Translation{}).Distinct("translator_name").Where("translator_name IS NOT NULL AND translator_name != ''").Order("translator_name ASC").Pluck("translator_name", &translatorNames).Error; err != nil {
+	if err = conn(ctx, r.db).Model(&entity.Translation{}).Distinct("translator_name").Where("translator_name IS NOT NULL AND translator_name != ''").Order("translator_name ASC").Pluck("translator_name", &translatorNames).Error; err != nil {
 		return
 	}
 
 	// 3. Distinct language codes
-	if err = r.db.WithContext(ctx).Model(&entity.Translation{}).Distinct("language_code").Order("language_code ASC").Pluck("language_code", &languageCodes).Error; err != nil {
+	if err = conn(ctx, r.db).Model(&entity.Translation{}).Distinct("language_code").Order("language_code ASC").Pluck("language_code", &languageCodes).Error; err != nil {
 		return
 	}
 
@@ -110,5 +110,5 @@ func (r *TranslationRepository) GetDropdownData(ctx context.Context) (verses []e
 }
 
 func (r *TranslationRepository) BulkDelete(ctx context.Context, ids []uint) error {
-	return r.db.WithContext(ctx).Delete(&entity.Translation{}, ids).Error
+	return conn(ctx, r.db).Delete(&entity.Translation{}, ids).Error
 }
@@ -21,13 +21,13 @@ func NewUserRepository(db *gorm.DB) repository.UserRepository {
 
 // Create inserts a new user into the database
 func (r *userRepository) Create(ctx context.Context, user *entity.User) error {
-	return r.db.WithContext(ctx).Create(user).Error
+	return conn(ctx, r.db).Create(user).Error
 }
 
 // GetByID retrieves a user by their primary key
 func (r *userRepository) GetByID(ctx context.Context, id uint) (*entity.User, error) {
 	var user entity.User
-	err := r.db.WithContext(ctx).Where("deleted_at IS NULL").First(&user, id).Error
+	err := conn(ctx, r.db).Where("deleted_at IS NULL").First(&user, id).Error
 	if err != nil {
 		return nil, err
 	}
@@ -37,7 +37,7 @@ func (r *userRepository) GetByID(ctx context.Context, id uint) (*entity.User, er
 // GetByUsernameOrEmail retrieves a user by username or email
 func (r *userRepository) GetByUsernameOrEmail(ctx context.Context, usernameOrEmail string) (*entity.User, error) {
 	var user entity.User
-	err := r.db.WithContext(ctx).
+	err := conn(ctx, r.db).
 		Where("deleted_at IS NULL").
 		Where("username = ? OR email = ?", usernameOrEmail, usernameOrEmail).
 		First(&user).Error
@@ -49,7 +49,7 @@ func (r *userRepository) GetByUsernameOrEmail(ctx context.Context, usernameOrEma
 
 // UpdateLastLoginAt updates the last login timestamp for a user
 func (r *userRepository) UpdateLastLoginAt(ctx context.Context, userID uint) error {
-	return r.db.WithContext(ctx).
+	return conn(ctx, r.db).
 		Model(&entity.User{}).
 		Where("id = ?", userID).
 		Update("last_login_at", time.Now()).Error
@@ -57,7 +57,7 @@ func (r *userRepository) UpdateLastLoginAt(ctx context.Context, userID uint) err
 
 // Delete performs a soft delete on the user
 func (r *userRepository) Delete(ctx context.Context, id uint) error {
-	return r.db.WithContext(ctx).
+	return conn(ctx, r.db).
 		Model(&entity.User{}).
 		Where("id = ?", id).
 		Update("deleted_at", time.Now()).Error
@@ -72,7 +72,7 @@ func (r *userRepository) Update(ctx context.Context, user *entity.User) error {
 		aktorID = "" // In a real app, make sure middleware sets this!
 	}
 
-	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
 		// Set the user_id for the PostgreSQL Trigger (Audit Log)
 		if aktorID != "" {
 			tx.Exec("SET LOCAL app.current_user_id = ?", aktorID)
@@ -93,7 +93,7 @@ func (r *userRepository) ListUsers(ctx context.Context, offset, limit int, searc
 	var users []entity.User
 	var total int64
 
-	query := r.db.WithContext(ctx).Model(&entity.User{}).Where("deleted_at IS NULL")
+	query := conn(ctx, r.db).Model(&entity.User{}).Where("deleted_at IS NULL")
 
 	// Apply search filter if provided
 	if search != "" {
@@ -116,7 +116,7 @@ func (r *userRepository) ListUsers(ctx context.Context, offset, limit int, searc
 
 // BulkDelete performs a soft delete on multiple users
 func (r *userRepository) BulkDelete(ctx context.Context, ids []uint) error {
-	return r.db.WithContext(ctx).
+	return conn(ctx, r.db).
 		Model(&entity.User{}).
 		Where("id IN ?", ids).
 		Update("deleted_at", time.Now()).Error
@@ -0,0 +1,82 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"ishari-backend/internal/core/entity"
+	"ishari-backend/internal/core/port/repository"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type verseMediaRepository struct {
+	db *gorm.DB
+}
+
+// NewVerseMediaRepository creates a new verse media repository instance
+func NewVerseMediaRepository(db *gorm.DB) repository.VerseMediaRepository {
+	return &verseMediaRepository{db: db}
+}
+
+// Create stores a new verse media record
+func (r *verseMediaRepository) Create(ctx context.Context, media *entity.VerseMedia) error {
+	return conn(ctx, r.db).Omit(clause.Associations).Create(media).Error
+}
+
+// List retrieves paginated verse media with optional filters
+func (r *verseMediaRepository) List(ctx context.Context, filter repository.VerseMediaFilter) ([]entity.VerseMedia, uint, error) {
+	var (
+		total int64
+		media []entity.VerseMedia
+	)
+
+	base := conn(ctx, r.db).Model(&entity.VerseMedia{})
+
+	if filter.VerseID != nil {
+		base = base.Where("verse_id = ?", *filter.VerseID)
+	}
+
+	if filter.HadiID != nil {
+		base = base.Where("hadi_id = ?", *filter.HadiID)
+	}
+
+	if filter.MediaType != "" {
+		base = base.Where("media_type = ?", filter.MediaType)
+	}
+
+	if err := base.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	query := base.Preload("Verse").Preload("Hadi").Order("verse_id ASC, media_type ASC, id ASC").Offset(int(filter.Offset)).Limit(int(filter.Limit))
+	if err := query.Find(&media).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return media, uint(total), nil
+}
+
+// GetById retrieves a verse media record by ID, returning nil when it does not exist
+func (r *verseMediaRepository) GetById(ctx context.Context, id uint) (*entity.VerseMedia, error) {
+	var media entity.VerseMedia
+	err := conn(ctx, r.db).Preload("Verse").Preload("Hadi").First(&media, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &media, nil
+}
+
+// Update saves changes to an existing verse media record
+func (r *verseMediaRepository) Update(ctx context.Context, media *entity.VerseMedia) error {
+	return conn(ctx, r.db).Omit(clause.Associations).Save(media).Error
+}
+
+// Delete removes a verse media record by ID
+func (r *verseMediaRepository) Delete(ctx context.Context, id uint) error {
+	return conn(ctx, r.db).Delete(&entity.VerseMedia{}, id).Error
+}
@@ -2,11 +2,15 @@ package postgres
 
 import (
 	"context"
+	"errors"
+	"ishari-backend/internal/core/domain/arabic"
 	"ishari-backend/internal/core/entity"
 	"ishari-backend/internal/core/port/repository"
+	"slices"
 	"strings"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type VerseRepository struct {
@@ -18,9 +22,17 @@ func NewVerseRepository(db *gorm.DB) repository.VerseRepository {
 	return &VerseRepository{db: db}
 }
 
-// Create implements VerseRepository.
+// Create implements VerseRepository. The chapter's total_verses is updated in the same transaction.
 func (r *VerseRepository) Create(ctx context.Context, verse *entity.Verse) error {
-	return r.db.WithContext(ctx).Create(verse).Error
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := lockChapters(tx, []uint{verse.ChapterID}); err != nil {
+			return err
+		}
+		if err := tx.Create(verse).Error; err != nil {
+			return err
+		}
+		return refreshTotalVerses(tx, []uint{verse.ChapterID})
+	})
 }
 
 // List implements VerseRepository.
@@ -30,14 +42,15 @@ func (r *VerseRepository) List(ctx context.Context, filter repository.VerseFilte
 		verses []entity.Verse
 	)
 
-	base := r.db.WithContext(ctx).Model(&entity.Verse{})
+	base := conn(ctx, r.db).Model(&entity.Verse{})
 
 	if filter.ChapterID != nil {
 		base = base.Where("chapter_id = ?", *filter.ChapterID)
 	}
 
+	// Arabic filters match the normalized column so vowelled and plain spellings find each other
 	if filter.ArabicText != "" {
-		base = base.Where("arabic_text ILIKE ?", "%"+filter.ArabicText+"%")
+		base = base.Where("arabic_normalized ILIKE ?", "%"+arabic.Normalize(filter.ArabicText)+"%")
 	}
 
 	if filter.Transliteration != "" {
@@ -46,14 +59,14 @@ func (r *VerseRepository) List(ctx context.Context, filter repository.VerseFilte
 
 	if search := strings.TrimSpace(filter.Search); search != "" {
 		q := "%" + search + "%"
-		base = base.Where("arabic_text ILIKE ? OR transliteration ILIKE ?", q, q)
+		base = base.Where("arabic_normalized ILIKE ? OR transliteration ILIKE ?", "%"+arabic.Normalize(search)+"%", q)
 	}
 
 	if err := base.Count(&total).Error; err != nil {
 		return nil, 0, err
 	}
 
-	query := base.Preload("Chapter").Preload("Chapter.Book").Order("chapter_id ASC, verse_number ASC").Offset(int(filter.Offset)).Limit(int(filter.Limit))
+	query := base.Preload("Chapter").Preload("Chapter.Book").Preload("Chapter.Category").Order("chapter_id ASC, verse_number ASC").Offset(int(filter.Offset)).Limit(int(filter.Limit))
 	if err := query.Find(&verses).Error; err != nil {
 		return nil, 0, err
 	}
@@ -64,23 +77,194 @@ func (r *VerseRepository) List(ctx context.Context, filter repository.VerseFilte
 // GetById implements VerseRepository.
 func (r *VerseRepository) GetById(ctx context.Context, id uint) (*entity.Verse, error) {
 	var verse entity.Verse
-	if err := r.db.WithContext(ctx).Preload("Chapter").Preload("Chapter.Book").First(&verse, id).Error; err != nil {
+	if err := conn(ctx, r.db).Preload("Chapter").Preload("Chapter.Book").Preload("Chapter.Category").First(&verse, id).Error; err != nil {
 		return nil, err
 	}
 	return &verse, nil
 }
 
-// Update implements VerseRepository.
+// Update implements VerseRepository. When the verse moves to another chapter both
+// chapters' total_verses are updated in the same transaction.
 func (r *VerseRepository) Update(ctx context.Context, verse *entity.Verse) error {
-	return r.db.WithContext(ctx).Save(verse).Error
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		chapterIDs, err := verseChapterIDs(tx, []uint{verse.ID})
+		if err != nil {
+			return err
+		}
+		if !slices.Contains(chapterIDs, verse.ChapterID) {
+			chapterIDs = append(chapterIDs, verse.ChapterID)
+		}
+		if err := lockChapters(tx, chapterIDs); err != nil {
+			return err
+		}
+		// the preloaded Chapter would otherwise be written back and reset ChapterID
+		if err := tx.Omit(clause.Associations).Save(verse).Error; err != nil {
+			return err
+		}
+		return refreshTotalVerses(tx, chapterIDs)
+	})
 }
 
-// Delete implements VerseRepository.
+// Delete implements VerseRepository. The chapter's total_verses is updated in the same transaction.
 func (r *VerseRepository) Delete(ctx context.Context, id uint) error {
-	return r.db.WithContext(ctx).Delete(&entity.Verse{}, id).Error
+	return r.BulkDelete(ctx, []uint{id})
 }
 
-// BulkDelete removes multiple verses by IDs
+// BulkDelete removes multiple verses by IDs and updates the total_verses of their chapters
+// in the same transaction
 func (r *VerseRepository) BulkDelete(ctx context.Context, ids []uint) error {
-	return r.db.WithContext(ctx).Delete(&entity.Verse{}, "id IN ?", ids).Error
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		chapterIDs, err := verseChapterIDs(tx, ids)
+		if err != nil {
+			return err
+		}
+		if err := lockChapters(tx, chapterIDs); err != nil {
+			return err
+		}
+		if err := tx.Delete(&entity.Verse{}, "id IN ?", ids).Error; err != nil {
+			return err
+		}
+		return refreshTotalVerses(tx, chapterIDs)
+	})
+}
+
+// Reorder implements VerseRepository.
+func (r *VerseRepository) Reorder(ctx context.Context, chapterID uint, verseIDs []uint) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := lockChapters(tx, []uint{chapterID}); err != nil {
+			return err
+		}
+		return verseOrdering.reorder(tx, chapterID, verseIDs)
+	})
+}
+
+// Move implements VerseRepository. Both chapters' total_verses are updated in the same transaction.
+func (r *VerseRepository) Move(ctx context.Context, id, chapterID, position uint) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		fromIDs, err := verseChapterIDs(tx, []uint{id})
+		if err != nil {
+			return err
+		}
+		if len(fromIDs) == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		chapterIDs := []uint{fromIDs[0]}
+		if fromIDs[0] != chapterID {
+			chapterIDs = append(chapterIDs, chapterID)
+		}
+		if err := lockChapters(tx, chapterIDs); err != nil {
+			return err
+		}
+		if err := verseOrdering.move(tx, id, fromIDs[0], chapterID, position); err != nil {
+			return err
+		}
+		return refreshTotalVerses(tx, chapterIDs)
+	})
+}
+
+// VerseNumbers implements VerseRepository.
+func (r *VerseRepository) VerseNumbers(ctx context.Context, chapterID uint) (map[uint]uint, error) {
+	var rows []struct {
+		ID          uint
+		VerseNumber uint
+	}
+	if err := conn(ctx, r.db).Model(&entity.Verse{}).Select("id", "verse_number").
+		Where("chapter_id = ?", chapterID).Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	numbers := make(map[uint]uint, len(rows))
+	for _, row := range rows {
+		numbers[row.VerseNumber] = row.ID
+	}
+	return numbers, nil
+}
+
+// Import implements VerseRepository. The chapter's total_verses is updated in the same transaction.
+func (r *VerseRepository) Import(ctx context.Context, chapterID uint, verses []entity.VerseImport) error {
+	err := conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := lockChapters(tx, []uint{chapterID}); err != nil {
+			return err
+		}
+
+		var created []*entity.Verse
+		for i := range verses {
+			verse := &verses[i].Verse
+			verse.ChapterID = chapterID
+			if verse.ID == 0 {
+				created = append(created, verse)
+				continue
+			}
+			res := tx.Model(&entity.Verse{}).Where("id = ? AND chapter_id = ?", verse.ID, chapterID).Updates(map[string]any{
+				"arabic_text":       verse.ArabicText,
+				"arabic_normalized": verse.ArabicNormalized,
+				"transliteration":   verse.Transliteration,
+			})
+			if res.Error != nil {
+				return res.Error
+			}
+			if res.RowsAffected == 0 {
+				return repository.ErrConflict
+			}
+		}
+		if len(created) > 0 {
+			if err := tx.Omit(clause.Associations).Create(created).Error; err != nil {
+				return err
+			}
+		}
+
+		var translations []*entity.Translation
+		for i := range verses {
+			for j := range verses[i].Translations {
+				translation := &verses[i].Translations[j]
+				translation.VerseID = verses[i].Verse.ID
+				translations = append(translations, translation)
+			}
+		}
+		if len(translations) > 0 {
+			// the target matches the unique_translation partial index
+			upsert := clause.OnConflict{
+				Columns:     []clause.Column{{Name: "verse_id"}, {Name: "language_code"}},
+				TargetWhere: clause.Where{Exprs: []clause.Expression{clause.Expr{SQL: "deleted_at IS NULL"}}},
+				DoUpdates:   clause.AssignmentColumns([]string{"translation_text", "updated_at"}),
+			}
+			if err := tx.Omit(clause.Associations).Clauses(upsert).Create(translations).Error; err != nil {
+				return err
+			}
+		}
+
+		return refreshTotalVerses(tx, []uint{chapterID})
+	})
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return repository.ErrConflict
+	}
+	return err
+}
+
+// verseChapterIDs returns the distinct chapters of the given live verses
+func verseChapterIDs(tx *gorm.DB, verseIDs []uint) ([]uint, error) {
+	var chapterIDs []uint
+	err := tx.Model(&entity.Verse{}).Where("id IN ?", verseIDs).Distinct().Pluck("chapter_id", &chapterIDs).Error
+	return chapterIDs, err
+}
+
+// lockChapters takes row locks on the chapters, in id order to avoid deadlocks, so that
+// concurrent verse writes to the same chapter serialize and refreshTotalVerses sees
+// every committed verse
+func lockChapters(tx *gorm.DB, chapterIDs []uint) error {
+	if len(chapterIDs) == 0 {
+		return nil
+	}
+	var locked []uint
+	return tx.Model(&entity.Chapter{}).Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id IN ?", chapterIDs).Order("id").Pluck("id", &locked).Error
+}
+
+// refreshTotalVerses recomputes total_verses of the chapters from their live verses
+func refreshTotalVerses(tx *gorm.DB, chapterIDs []uint) error {
+	if len(chapterIDs) == 0 {
+		return nil
+	}
+	return tx.Exec(`UPDATE chapters SET total_verses = (
+			SELECT count(*) FROM verses v WHERE v.chapter_id = chapters.id AND v.deleted_at IS NULL
+		) WHERE id IN ?`, chapterIDs).Error
 }
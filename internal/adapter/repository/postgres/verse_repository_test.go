@@ -0,0 +1,34 @@
+package postgres
+
+import (
+	"context"
+	"database/sql/driver"
+	"strings"
+	"testing"
+
+	"ishari-backend/internal/core/entity"
+)
+
+func TestVerseRepository_Update_MovesVerseToAnotherChapter(t *testing.T) {
+	db, fake := newFakeDB(t, map[string]fakeTable{
+		"verses": {
+			Columns: []string{"chapter_id"},
+			Rows:    [][]driver.Value{{int64(1)}},
+		},
+	})
+
+	// the verse comes from GetByID with its current chapter preloaded
+	verse := &entity.Verse{ID: 5, ChapterID: 2, VerseNumber: 1, ArabicText: "a", Chapter: &entity.Chapter{ID: 1}}
+	if err := NewVerseRepository(db).Update(context.Background(), verse); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if verse.ChapterID != 2 {
+		t.Errorf("expected chapter_id 2, got %d", verse.ChapterID)
+	}
+	for _, s := range fake.Statements() {
+		if strings.HasPrefix(s.SQL, `INSERT INTO "chapters"`) {
+			t.Errorf("expected the preloaded chapter not to be saved, got %q", s.SQL)
+		}
+	}
+}
@@ -2,26 +2,42 @@ package bootstrap
 
 import (
 	"fmt"
+	"net/url"
 
 	"ishari-backend/internal/adapter/handler/http"
 	"ishari-backend/internal/adapter/handler/http/controller"
 	"ishari-backend/internal/adapter/handler/http/middleware"
 	"ishari-backend/internal/adapter/repository/postgres"
 	"ishari-backend/internal/core/usecase"
+	auditlogusecase "ishari-backend/internal/core/usecase/auditlog"
 	authusecase "ishari-backend/internal/core/usecase/auth"
 	bookusecase "ishari-backend/internal/core/usecase/book"
 	bookmarkusecase "ishari-backend/internal/core/usecase/bookmark"
+	categoryusecase "ishari-backend/internal/core/usecase/category"
 	chapterusecase "ishari-backend/internal/core/usecase/chapter"
 	dashboardusecase "ishari-backend/internal/core/usecase/dashboard"
+	exportusecase "ishari-backend/internal/core/usecase/export"
 	hadiusecase "ishari-backend/internal/core/usecase/hadi"
+	mediastreamusecase "ishari-backend/internal/core/usecase/mediastream"
+	readerusecase "ishari-backend/internal/core/usecase/reader"
+	revisionusecase "ishari-backend/internal/core/usecase/revision"
+	searchusecase "ishari-backend/internal/core/usecase/search"
+	searchhistoryusecase "ishari-backend/internal/core/usecase/searchhistory"
+	suggestusecase "ishari-backend/internal/core/usecase/suggest"
 	translationusecase "ishari-backend/internal/core/usecase/translation"
+	uploadusecase "ishari-backend/internal/core/usecase/upload"
 	userusecase "ishari-backend/internal/core/usecase/user"
 	verseusecase "ishari-backend/internal/core/usecase/verse"
+	versemediausecase "ishari-backend/internal/core/usecase/versemedia"
+	waveformusecase "ishari-backend/internal/core/usecase/waveform"
+	"ishari-backend/pkg/audio"
 	"ishari-backend/pkg/config"
 	"ishari-backend/pkg/database"
 	"ishari-backend/pkg/hasher"
+	"ishari-backend/pkg/imaging"
 	"ishari-backend/pkg/jwt"
 	"ishari-backend/pkg/logger"
+	"ishari-backend/pkg/storage"
 	"ishari-backend/pkg/validation"
 )
 
@@ -48,10 +64,16 @@ func Build(cfg config.Config) (*App, error) {
 	// Infrastructure
 	passwordHasher := hasher.NewBcryptHasher(12)
 	jwtService := jwt.NewJWTService(cfg.JWT.Secret, cfg.JWT.AccessTokenTTL, cfg.JWT.RefreshTokenTTL)
+	fileStorage, err := storage.New(cfg.Storage)
+	if err != nil {
+		_ = cleanup()
+		return nil, fmt.Errorf("storage: %w", err)
+	}
 
 	// Repositories
 	bookRepo := postgres.NewBookRepository(db)
 	chapterRepo := postgres.NewChapterRepository(db)
+	categoryRepo := postgres.NewCategoryRepository(db)
 	healthRepo := postgres.NewHealthRepository(db)
 	userRepo := postgres.NewUserRepository(db)
 	verseRepo := postgres.NewVerseRepository(db)
@@ -60,6 +82,14 @@ func Build(cfg config.Config) (*App, error) {
 	bookmarkRepo := postgres.NewBookmarkRepository(db)
 	hadiRepo := postgres.NewHadiRepository(db)
 	dashboardRepo := postgres.NewDashboardRepository(db)
+	verseMediaRepo := postgres.NewVerseMediaRepository(db)
+	searchRepo := postgres.NewSearchRepository(db)
+	searchHistoryRepo := postgres.NewSearchHistoryRepository(db)
+	suggestionRepo := postgres.NewSuggestionRepository(db)
+	readerRepo := postgres.NewReaderRepository(db)
+	auditLogRepo := postgres.NewAuditLogRepository(db)
+	exportRepo := postgres.NewExportRepository(db)
+	transactor := postgres.NewTransactor(db)
 
 	// Token blacklist (database-backed)
 	tokenBlacklist := jwt.NewDatabaseBlacklist(refreshTokenRepo)
@@ -67,43 +97,88 @@ func Build(cfg config.Config) (*App, error) {
 	// Use cases
 	healthUC := usecase.NewHealthUseCase(healthRepo)
 	bookUC := bookusecase.NewBookUseCase(bookRepo)
-	chapterUC := chapterusecase.NewChapterUsecase(chapterRepo, bookRepo, l)
+	chapterUC := chapterusecase.NewChapterUsecase(chapterRepo, bookRepo, categoryRepo, l)
+	categoryUC := categoryusecase.NewCategoryUsecase(categoryRepo, chapterRepo, l)
+	readerUC := readerusecase.NewReaderUsecase(readerRepo, l)
 	userUC := userusecase.NewUserUseCase(userRepo, passwordHasher)
 	verseUC := verseusecase.NewVerseUsecase(verseRepo, chapterRepo, l)
 	translationUC := translationusecase.NewTranslationUsecase(translationRepo, verseRepo, l)
 	authUC := authusecase.NewAuthUseCase(userRepo, jwtService, tokenBlacklist, passwordHasher)
 	bookmarkUC := bookmarkusecase.NewBookmarkUsecase(bookmarkRepo, verseRepo, l)
-	hadiUC := hadiusecase.NewHadiUseCase(hadiRepo)
+	hadiUC := hadiusecase.NewHadiUseCase(hadiRepo, verseRepo)
 	dashboardUC := dashboardusecase.NewDashboardUseCase(dashboardRepo)
+	verseMediaUC := versemediausecase.NewVerseMediaUsecase(verseMediaRepo, verseRepo, hadiRepo, l)
+	waveformUC := waveformusecase.NewWaveformUsecase(verseMediaRepo, fileStorage, audio.NewPeakExtractor(), l)
+	uploadUC := uploadusecase.NewUploadUsecase(fileStorage, audio.NewProber(), imaging.NewResizer(), verseMediaUC, waveformUC, bookRepo, hadiRepo, transactor, uploadusecase.Limits{
+		MaxAudioSize: cfg.Storage.MaxAudioSize,
+		MaxImageSize: cfg.Storage.MaxImageSize,
+	}, l)
+	searchUC := searchusecase.NewSearchUsecase(searchRepo, searchHistoryRepo, l)
+	searchHistoryUC := searchhistoryusecase.NewSearchHistoryUsecase(searchHistoryRepo, l)
+	suggestUC := suggestusecase.NewSuggestUsecase(suggestionRepo, suggestusecase.DefaultRefresh, l)
+	mediaStreamUC := mediastreamusecase.NewMediaStreamUsecase(verseMediaRepo, fileStorage, l)
+	auditLogUC := auditlogusecase.NewAuditLogUsecase(auditLogRepo, l)
+	revisionUC := revisionusecase.NewRevisionUsecase(auditLogRepo, verseUC, translationUC, l)
+	exportUC := exportusecase.NewExportUsecase(exportRepo, fileStorage, l)
 
 	// HTTP server
 	server := http.NewServer(cfg.Server, l)
 	middleware.Setup(server.App)
+	// Mutating requests share one transaction that records the acting user for audit_logs.
+	// Uploads open theirs after the file is stored (see http.IsUploadRequest).
+	server.App.Use(middleware.Transaction(transactor, http.IsUploadRequest))
+
+	// Serve locally stored files under the path of the public storage URL
+	if cfg.Storage.Driver == config.StorageDriverLocal {
+		prefix := "/uploads"
+		if u, err := url.Parse(cfg.Storage.PublicURL); err == nil && u.Path != "" && u.Path != "/" {
+			prefix = u.Path
+		}
+		server.App.Static(prefix, cfg.Storage.LocalDir)
+	}
 
 	// Controllers and routes
 	v := validation.New()
 	healthCtrl := controller.NewHealthController(healthUC)
-	bookCtrl := controller.NewBookController(bookUC, v, l)
-	chapterCtrl := controller.NewChapterController(chapterUC, v, l)
+	bookCtrl := controller.NewBookController(bookUC, readerUC, v, l)
+	chapterCtrl := controller.NewChapterController(chapterUC, readerUC, v, l)
+	categoryCtrl := controller.NewCategoryController(categoryUC, v, l)
 	userCtrl := controller.NewUserController(userUC, v, l)
 	authCtrl := controller.NewAuthController(authUC, v, l)
-	verseCtrl := controller.NewVerseController(verseUC, v, l)
-	translationCtrl := controller.NewTranslationController(translationUC, v, l)
+	verseCtrl := controller.NewVerseController(verseUC, revisionUC, v, l)
+	translationCtrl := controller.NewTranslationController(translationUC, revisionUC, v, l)
 	bookmarkCtrl := controller.NewBookmarkController(bookmarkUC, v, l)
 	hadiCtrl := controller.NewHadiController(hadiUC, v, l)
 	dashboardCtrl := controller.NewDashboardController(dashboardUC, l)
+	verseMediaCtrl := controller.NewVerseMediaController(verseMediaUC, v, l)
+	uploadCtrl := controller.NewUploadController(uploadUC, l)
+	mediaStreamCtrl := controller.NewMediaStreamController(mediaStreamUC, l)
+	waveformCtrl := controller.NewWaveformController(waveformUC, l)
+	searchCtrl := controller.NewSearchController(searchUC, suggestUC, l)
+	searchHistoryCtrl := controller.NewSearchHistoryController(searchHistoryUC, l)
+	auditLogCtrl := controller.NewAuditLogController(auditLogUC, l)
+	exportCtrl := controller.NewExportController(exportUC, v, l)
 
 	http.RegisterRoutes(server.App, http.Controllers{
-		Health:      healthCtrl,
-		Book:        bookCtrl,
-		Chapter:     chapterCtrl,
-		User:        userCtrl,
-		Auth:        authCtrl,
-		Verse:       verseCtrl,
-		Translation: translationCtrl,
-		Bookmark:    bookmarkCtrl,
-		Hadi:        hadiCtrl,
-		Dashboard:   dashboardCtrl,
+		Health:        healthCtrl,
+		Book:          bookCtrl,
+		Chapter:       chapterCtrl,
+		Category:      categoryCtrl,
+		User:          userCtrl,
+		Auth:          authCtrl,
+		Verse:         verseCtrl,
+		Translation:   translationCtrl,
+		Bookmark:      bookmarkCtrl,
+		Hadi:          hadiCtrl,
+		Dashboard:     dashboardCtrl,
+		VerseMedia:    verseMediaCtrl,
+		Upload:        uploadCtrl,
+		MediaStream:   mediaStreamCtrl,
+		Waveform:      waveformCtrl,
+		Search:        searchCtrl,
+		SearchHistory: searchHistoryCtrl,
+		AuditLog:      auditLogCtrl,
+		Export:        exportCtrl,
 	}, &http.AuthDeps{
 		AuthUC: authUC,
 	})
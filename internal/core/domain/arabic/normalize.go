@@ -0,0 +1,75 @@
+// Package arabic normalizes Arabic text for search so that vowelled and plain
+// spellings of the same word compare equal.
+package arabic
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Normalize folds Arabic text to its search form:
+//   - strips harakat, shadda, sukun, tanwin, dagger alef and Quranic annotation marks
+//   - strips tatweel and invisible direction/joiner marks
+//   - unifies alef variants (أ إ آ ٱ) to ا, alef maqsura and Farsi yeh to ي,
+//     ta marbuta to ه, hamza carriers (ؤ ئ) to و and ي, and Farsi kaf to ك
+//   - collapses whitespace
+//
+// Non-Arabic text is left unchanged apart from whitespace. The backfill in
+// migration 000017 mirrors these rules in SQL and must be kept in sync.
+func Normalize(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+
+	space := false
+	for _, r := range s {
+		if unicode.IsSpace(r) {
+			space = b.Len() > 0
+			continue
+		}
+		if isIgnorable(r) {
+			continue
+		}
+		if space {
+			b.WriteByte(' ')
+			space = false
+		}
+		b.WriteRune(fold(r))
+	}
+	return b.String()
+}
+
+// isIgnorable reports marks that carry no letter identity
+func isIgnorable(r rune) bool {
+	switch {
+	case r >= 0x0610 && r <= 0x061A: // Quranic honorifics and small letters above
+		return true
+	case r >= 0x064B && r <= 0x065F: // tanwin, harakat, shadda, sukun and extended vowel marks
+		return true
+	case r == 0x0670: // superscript (dagger) alef
+		return true
+	case r >= 0x06D6 && r <= 0x06DC, r >= 0x06DF && r <= 0x06E8, r >= 0x06EA && r <= 0x06ED: // Quranic annotation marks
+		return true
+	case r == 0x0640: // tatweel
+		return true
+	case r == 0x061C, r >= 0x200B && r <= 0x200F: // arabic letter mark, zero-width and direction marks
+		return true
+	}
+	return false
+}
+
+// fold maps letter variants to their base letter
+func fold(r rune) rune {
+	switch r {
+	case 'أ', 'إ', 'آ', 'ٱ', 'ٲ', 'ٳ':
+		return 'ا'
+	case 'ى', 'ی', 'ئ':
+		return 'ي'
+	case 'ة':
+		return 'ه'
+	case 'ؤ':
+		return 'و'
+	case 'ک':
+		return 'ك'
+	}
+	return r
+}
@@ -0,0 +1,205 @@
+package arabic
+
+import "testing"
+
+func TestNormalizeMarks(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{name: "fatha kasra damma", in: "كَتَبَ كِتَابُ", want: "كتب كتاب"},
+		{name: "tanwin", in: "كِتَابًا كِتَابٍ كِتَابٌ", want: "كتابا كتاب كتاب"},
+		{name: "shadda and sukun", in: "مُحَمَّدْ", want: "محمد"},
+		{name: "dagger alef", in: "هٰذَا", want: "هذا"},
+		{name: "allah with dagger alef", in: "اللّٰهُ", want: "الله"},
+		{name: "quranic small high marks", in: "رَبِّ ۖ الْعٰلَمِيْنَ ۚ", want: "رب العلمين"},
+		{name: "honorific sign", in: "مُحَمَّدٍ ﷺ ؐ", want: "محمد ﷺ"},
+		{name: "tatweel", in: "مُـحَـمَّـد", want: "محمد"},
+		{name: "zero width and direction marks", in: "سَلَام‌عَلَيْك‏", want: "سلامعليك"},
+		{name: "arabic letter mark", in: "؜نبي", want: "نبي"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Normalize(tt.in); got != tt.want {
+				t.Errorf("Normalize(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeLetterVariants(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{name: "alef hamza above", in: "أحمد", want: "احمد"},
+		{name: "alef hamza below", in: "إسلام", want: "اسلام"},
+		{name: "alef madda", in: "آمين", want: "امين"},
+		{name: "alef wasla", in: "ٱلْحَمْدُ", want: "الحمد"},
+		{name: "alef wavy hamza", in: "ٲ ٳ", want: "ا ا"},
+		{name: "alef maqsura", in: "عَلَى مُصْطَفَى", want: "علي مصطفي"},
+		{name: "farsi yeh", in: "نبی", want: "نبي"},
+		{name: "yeh hamza", in: "قَائِم", want: "قايم"},
+		{name: "waw hamza", in: "مُؤْمِن", want: "مومن"},
+		{name: "ta marbuta", in: "صَلَاةُ رَحْمَة", want: "صلاه رحمه"},
+		{name: "farsi kaf", in: "کتاب", want: "كتاب"},
+		{name: "bare hamza kept", in: "سَمَاءٌ", want: "سماء"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Normalize(tt.in); got != tt.want {
+				t.Errorf("Normalize(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeWhitespace(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{in: "", want: ""},
+		{in: "   ", want: ""},
+		{in: "  يَا  نَبِي \t سَلَامْ\nعَلَيْكَ  ", want: "يا نبي سلام عليك"},
+		{in: "ـ يَا ـ نَبِي ـ", want: "يا نبي"},
+		{in: " نبي ", want: "نبي"},
+	}
+	for _, tt := range tests {
+		if got := Normalize(tt.in); got != tt.want {
+			t.Errorf("Normalize(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestNormalizeLeavesLatinText(t *testing.T) {
+	for _, in := range []string{"Ya Nabi salam 'alaika", "sholawat 123", "Āl-Muṣṭafā"} {
+		if got := Normalize(in); got != in {
+			t.Errorf("Normalize(%q) = %q, want unchanged", in, got)
+		}
+	}
+}
+
+// Verses as printed in the Diwan and Maulid ad-Diba'i, fully vowelled, against the plain
+// spelling a member would type on a phone keyboard.
+func TestNormalizeDiwanVerses(t *testing.T) {
+	tests := []struct {
+		name     string
+		vowelled string
+		typed    string
+		want     string
+	}{
+		{
+			name:     "mahallul qiyam",
+			vowelled: "يَا نَبِيْ سَلَامْ عَلَيْكَ ۞ يَا رَسُوْلْ سَلَامْ عَلَيْكَ",
+			typed:    "يا نبي سلام عليك ۞ يا رسول سلام عليك",
+			want:     "يا نبي سلام عليك ۞ يا رسول سلام عليك",
+		},
+		{
+			name:     "mahallul qiyam salawat",
+			vowelled: "يَا حَبِيْبْ سَلَامْ عَلَيْكَ ۞ صَلَوَاتُ اللّٰهْ عَلَيْكَ",
+			typed:    "يا حبيب سلام عليك ۞ صلوات الله عليك",
+			want:     "يا حبيب سلام عليك ۞ صلوات الله عليك",
+		},
+		{
+			name:     "asyraqal",
+			vowelled: "أَشْرَقَ الْبَدْرُ عَلَيْنَا ۞ فَاخْتَفَتْ مِنْهُ الْبُدُوْرُ",
+			typed:    "اشرق البدر علينا ۞ فاختفت منه البدور",
+			want:     "اشرق البدر علينا ۞ فاختفت منه البدور",
+		},
+		{
+			name:     "thala'al badru",
+			vowelled: "طَلَعَ الْبَدْرُ عَلَيْنَا ۞ مِنْ ثَنِيَّاتِ الْوَدَاعْ",
+			typed:    "طلع البدر علينا ۞ من ثنيات الوداع",
+			want:     "طلع البدر علينا ۞ من ثنيات الوداع",
+		},
+		{
+			name:     "diba opening",
+			vowelled: "يَا رَبِّ صَلِّ عَلَى مُحَمَّدْ ۞ يَا رَبِّ صَلِّ عَلَيْهِ وَسَلِّمْ",
+			typed:    "يا رب صل علي محمد ۞ يا رب صل عليه وسلم",
+			want:     "يا رب صل علي محمد ۞ يا رب صل عليه وسلم",
+		},
+		{
+			name:     "diba first verse",
+			vowelled: "اَلْحَمْدُ لِلّٰهِ الْقَوِيِّ سُلْطَانُهْ",
+			typed:    "الحمد لله القوي سلطانه",
+			want:     "الحمد لله القوي سلطانه",
+		},
+		{
+			name:     "basmalah",
+			vowelled: "بِسْمِ اللّٰهِ الرَّحْمٰنِ الرَّحِيْمِ",
+			typed:    "بسم الله الرحمن الرحيم",
+			want:     "بسم الله الرحمن الرحيم",
+		},
+		{
+			name:     "marhaban",
+			vowelled: "مَرْحَبًا يَا نُوْرَ عَيْنِيْ ۞ مَرْحَبًا جَدَّ الْحُسَيْنِ",
+			typed:    "مرحبا يا نور عيني ۞ مرحبا جد الحسين",
+			want:     "مرحبا يا نور عيني ۞ مرحبا جد الحسين",
+		},
+		{
+			name:     "anta syamsun",
+			vowelled: "أَنْتَ شَمْسٌ أَنْتَ بَدْرٌ ۞ أَنْتَ نُوْرٌ فَوْقَ نُوْرٍ",
+			typed:    "انت شمس انت بدر ۞ انت نور فوق نور",
+			want:     "انت شمس انت بدر ۞ انت نور فوق نور",
+		},
+		{
+			name:     "ya rabbi bil musthofa",
+			vowelled: "يَا رَبِّ بِالْمُصْطَفٰى بَلِّغْ مَقَاصِدَنَا ۞ وَاغْفِرْ لَنَا مَا مَضٰى يَا وَاسِعَ الْكَرَمِ",
+			typed:    "يا رب بالمصطفي بلغ مقاصدنا ۞ واغفر لنا ما مضي يا واسع الكرم",
+			want:     "يا رب بالمصطفي بلغ مقاصدنا ۞ واغفر لنا ما مضي يا واسع الكرم",
+		},
+		{
+			name:     "sholatullah salamullah",
+			vowelled: "صَلَاةُ اللّٰهِ سَلَامُ اللّٰهِ ۞ عَلٰى طٰهٰ رَسُوْلِ اللّٰهِ",
+			typed:    "صلاة الله سلام الله ۞ علي طه رسول الله",
+			want:     "صلاه الله سلام الله ۞ علي طه رسول الله",
+		},
+		{
+			name:     "tatweel in printed headings",
+			vowelled: "مَـوْلِـدُ الـدِّيْـبَـعِـيِّ",
+			typed:    "مولد الديبعي",
+			want:     "مولد الديبعي",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Normalize(tt.vowelled)
+			if got != tt.want {
+				t.Errorf("Normalize(vowelled) = %q, want %q", got, tt.want)
+			}
+			if typed := Normalize(tt.typed); typed != got {
+				t.Errorf("typed form %q normalizes to %q, vowelled form to %q", tt.typed, typed, got)
+			}
+		})
+	}
+}
+
+func TestNormalizeIdempotent(t *testing.T) {
+	for _, in := range []string{
+		"أَشْرَقَ الْبَدْرُ عَلَيْنَا",
+		"صَلَاةُ اللّٰهِ سَلَامُ اللّٰهِ",
+		"  مُؤْمِنٌ  ـ  قَائِمٌ ",
+	} {
+		once := Normalize(in)
+		if twice := Normalize(once); twice != once {
+			t.Errorf("Normalize is not idempotent for %q: %q then %q", in, once, twice)
+		}
+	}
+}
+
+func TestIsIgnorableCoversCombiningMarks(t *testing.T) {
+	// harakat, tanwin, shadda, sukun and the hamza/madda combining marks
+	for r := rune(0x064B); r <= 0x065F; r++ {
+		if !isIgnorable(r) {
+			t.Errorf("expected U+%04X to be stripped", r)
+		}
+	}
+	for _, r := range "ابتثجحخدذرزسشصضطظعغفقكلمنهوي" {
+		if isIgnorable(r) {
+			t.Errorf("letter %c must not be stripped", r)
+		}
+	}
+}
@@ -0,0 +1,16 @@
+package domain
+
+import "unicode"
+
+// IsLanguageCode accepts codes like "ar", "id" or "en-US", matching translations.language_code (varchar(10))
+func IsLanguageCode(code string) bool {
+	if len(code) < 2 || len(code) > 10 {
+		return false
+	}
+	for _, r := range code {
+		if r != '-' && (r > unicode.MaxASCII || !unicode.IsLetter(r)) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,7 @@
+package domain
+
+// SameURL reports whether two optional URLs are equal, both being unset counting as equal.
+// Resized image variants are kept only while the URL they were generated from is unchanged.
+func SameURL(a, b *string) bool {
+	return a == b || (a != nil && b != nil && *a == *b)
+}
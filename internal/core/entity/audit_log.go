@@ -0,0 +1,45 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Audit log operations, as written by the log_table_changes trigger
+const (
+	AuditOperationInsert = "INSERT"
+	AuditOperationUpdate = "UPDATE"
+	AuditOperationDelete = "DELETE"
+)
+
+// AuditLog is a change to an audited table recorded by the log_table_changes trigger
+type AuditLog struct {
+	ID              uint            `json:"id" gorm:"primaryKey"`
+	Table           string          `json:"table_name" gorm:"column:table_name"`
+	RecordID        uint            `json:"record_id"`
+	Operation       string          `json:"operation"`
+	OldData         json.RawMessage `json:"old_data" gorm:"type:jsonb"`
+	NewData         json.RawMessage `json:"new_data" gorm:"type:jsonb"`
+	ChangedByUserID *uint           `json:"changed_by_user_id"`
+	// ChangedByUsername is read from users; nil for changes without a known user
+	ChangedByUsername *string   `json:"changed_by_username" gorm:"->"`
+	ChangedAt         time.Time `json:"changed_at"`
+}
+
+func (AuditLog) TableName() string {
+	return "audit_logs"
+}
+
+// FieldChange is one top-level column whose value differs between the old and new row.
+// Old is null for inserts and New is null for deletes.
+type FieldChange struct {
+	Field string
+	Old   json.RawMessage
+	New   json.RawMessage
+}
+
+// AuditLogDetail is an audit log entry with its field-level diff
+type AuditLogDetail struct {
+	AuditLog
+	Changes []FieldChange
+}
@@ -0,0 +1,62 @@
+package entity
+
+// BookExportFormat and BookExportVersion identify the schema of book exports. The version
+// changes with anything other than an added optional field.
+const (
+	BookExportFormat  = "ishari-book-export"
+	BookExportVersion = 1
+)
+
+// The export rows below carry chapter and verse numbers next to their IDs, so an export can
+// be imported into a database whose IDs differ.
+
+type ExportBook struct {
+	ID            int     `json:"id"`
+	Title         string  `json:"title"`
+	Author        *string `json:"author,omitempty"`
+	Description   *string `json:"description,omitempty"`
+	PublishedYear *int    `json:"published_year,omitempty"`
+	CoverImageURL *string `json:"cover_image_url,omitempty"`
+}
+
+type ExportChapter struct {
+	ID            uint    `json:"id"`
+	ChapterNumber uint    `json:"chapter_number"`
+	Title         string  `json:"title"`
+	CategorySlug  string  `json:"category_slug"`
+	CategoryName  string  `json:"category_name"`
+	Description   *string `json:"description,omitempty"`
+}
+
+type ExportVerse struct {
+	ID              uint    `json:"id"`
+	ChapterNumber   uint    `json:"chapter_number"`
+	VerseNumber     uint    `json:"verse_number"`
+	ArabicText      string  `json:"arabic_text"`
+	Transliteration *string `json:"transliteration,omitempty"`
+}
+
+type ExportTranslation struct {
+	ID              uint    `json:"id"`
+	VerseID         uint    `json:"verse_id"`
+	ChapterNumber   uint    `json:"chapter_number"`
+	VerseNumber     uint    `json:"verse_number"`
+	LanguageCode    string  `json:"language_code"`
+	TranslationText string  `json:"translation_text"`
+	TranslatorName  *string `json:"translator_name,omitempty"`
+}
+
+type ExportMedia struct {
+	ID            uint    `json:"id"`
+	VerseID       uint    `json:"verse_id"`
+	ChapterNumber uint    `json:"chapter_number"`
+	VerseNumber   uint    `json:"verse_number"`
+	MediaType     string  `json:"media_type"`
+	MediaURL      string  `json:"media_url"`
+	FileSize      *int    `json:"file_size,omitempty"`
+	Duration      *int    `json:"duration,omitempty"` // seconds
+	Codec         *string `json:"codec,omitempty"`
+	Bitrate       *int    `json:"bitrate,omitempty"` // kbit/s
+	Description   *string `json:"description,omitempty"`
+	HadiName      *string `json:"hadi_name,omitempty"`
+}
@@ -0,0 +1,19 @@
+package entity
+
+// BookletChapter is a chapter of an e-book export
+type BookletChapter struct {
+	ID            uint
+	BookID        uint
+	ChapterNumber uint
+	Title         string
+}
+
+// BookletVerse is a verse as printed in an e-book, with the one translation chosen for the
+// booklet when the verse has it
+type BookletVerse struct {
+	ChapterID       uint
+	VerseNumber     uint
+	ArabicText      string
+	Transliteration *string
+	Translation     *string
+}
@@ -0,0 +1,21 @@
+package entity
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Category is a chapter genre such as Diwan or Muhud. Chapters are listed in SortOrder.
+type Category struct {
+	ID          uint           `json:"id" gorm:"primaryKey"`
+	Slug        string         `json:"slug" gorm:"not null"`
+	Name        string         `json:"name" gorm:"not null"`
+	SortOrder   int            `json:"sort_order" gorm:"not null;default:0"`
+	Description *string        `json:"description,omitempty"`
+	CreatedAt   time.Time      `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt   time.Time      `json:"updated_at" gorm:"autoUpdateTime"`
+	DeletedAt   gorm.DeletedAt `json:"-" gorm:"index"`
+}
+
+func (Category) TableName() string { return "categories" }
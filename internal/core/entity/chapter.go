@@ -11,9 +11,10 @@ type Chapter struct {
 	BookID        uint           `json:"book_id" gorm:"not null"`
 	ChapterNumber uint           `json:"chapter_number" gorm:"not null"`
 	Title         string         `json:"title" gorm:"not null"`
-	Category      string         `json:"category" gorm:"not null"`
+	CategoryID    uint           `json:"category_id" gorm:"not null"`
+	Category      *Category      `json:"category,omitempty" gorm:"foreignKey:CategoryID"`
 	Description   *string        `json:"description,omitempty"`
-	TotalVerses   uint           `json:"total_verses" gorm:"default:0"`
+	TotalVerses   uint           `json:"total_verses" gorm:"default:0;->"` // maintained by the verse repository
 	Book          *Book          `json:"book,omitempty" gorm:"foreignKey:BookID"`
 	CreatedAt     time.Time      `json:"created_at" gorm:"autoCreateTime"`
 	UpdatedAt     time.Time      `json:"updated_at" gorm:"autoUpdateTime"`
@@ -21,3 +22,13 @@ type Chapter struct {
 }
 
 func (Chapter) TableName() string { return "chapters" }
+
+// VerseCountCorrection reports a chapter whose stored total_verses did not match its live verses
+type VerseCountCorrection struct {
+	ChapterID     uint
+	BookID        uint
+	ChapterNumber uint
+	Title         string
+	StoredTotal   uint
+	ActualTotal   uint
+}
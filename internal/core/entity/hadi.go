@@ -11,6 +11,7 @@ type Hadi struct {
 	Name        string         `json:"name"`
 	Description *string        `json:"description,omitempty"`
 	ImageURL    *string        `json:"image_url,omitempty"`
+	Images      *ImageVariants `json:"images,omitempty" gorm:"serializer:json"`
 	CreatedAt   time.Time      `json:"created_at" gorm:"autoCreateTime"`
 	UpdatedAt   time.Time      `json:"updated_at" gorm:"autoUpdateTime"`
 	DeletedAt   gorm.DeletedAt `json:"-" gorm:"index"`
@@ -0,0 +1,16 @@
+package entity
+
+// Widths in pixels of the generated image variants
+const (
+	ImageVariantThumbWidth  = 160
+	ImageVariantMediumWidth = 480
+	ImageVariantLargeWidth  = 1024
+)
+
+// ImageVariants holds the URLs of the resized copies generated for an uploaded image.
+// It is stored as a JSON column next to the original image URL.
+type ImageVariants struct {
+	Thumb  string `json:"thumb"`
+	Medium string `json:"medium"`
+	Large  string `json:"large"`
+}
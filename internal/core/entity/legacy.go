@@ -0,0 +1,29 @@
+package entity
+
+import "time"
+
+// LegacyRecord is one verse row of the legacy MySQL application, read from its database
+// or from a dump of one table. Columns holds every column as read, for the category rules
+// of the import mapping.
+type LegacyRecord struct {
+	ID              int64
+	MuhudID         int64
+	Position        uint // verse number within the muhud, 0 when the table has none
+	Text            string
+	Transliteration string
+	Translation     string
+	Columns         map[string]string
+}
+
+// LegacyIDMapping records the row a legacy row was imported as
+type LegacyIDMapping struct {
+	ID          uint      `gorm:"primaryKey"`
+	Source      string    `gorm:"not null"`
+	LegacyTable string    `gorm:"not null"`
+	LegacyID    int64     `gorm:"not null"`
+	EntityTable string    `gorm:"not null"`
+	EntityID    uint      `gorm:"not null"`
+	ImportedAt  time.Time `gorm:"autoCreateTime"`
+}
+
+func (LegacyIDMapping) TableName() string { return "legacy_id_map" }
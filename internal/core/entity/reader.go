@@ -0,0 +1,51 @@
+package entity
+
+// ChapterReader is a chapter with its book and everything needed to render its verses
+type ChapterReader struct {
+	Chapter Chapter
+	Verses  []ReaderVerse
+}
+
+// ReaderVerse is a verse with its selected translations and media
+type ReaderVerse struct {
+	Verse
+	Translations []Translation
+	Media        []VerseMedia
+}
+
+// BookTOC is a book's table of contents with its chapters grouped by category
+type BookTOC struct {
+	Book       Book
+	Categories []TOCCategory
+}
+
+// TOCCategory is a category of a book with its chapters in chapter_number order
+type TOCCategory struct {
+	ID        uint
+	Slug      string
+	Name      string
+	SortOrder int
+	Chapters  []TOCChapter
+}
+
+// TOCChapter summarizes a chapter for the table of contents
+type TOCChapter struct {
+	ID            uint
+	ChapterNumber uint
+	Title         string
+	CategoryID    uint
+	CategorySlug  string
+	Category      string
+	CategoryOrder int
+	VerseCount    uint
+	HasAudio      bool
+	Translations  []TranslationCoverage `gorm:"-"`
+}
+
+// TranslationCoverage is how many verses of a chapter are translated into a language
+type TranslationCoverage struct {
+	LanguageCode     string
+	TranslatedVerses uint
+	// Coverage is TranslatedVerses divided by the chapter's verse count, between 0 and 1
+	Coverage float64
+}
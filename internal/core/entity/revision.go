@@ -0,0 +1,18 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Revision is a version of a verse or translation row, taken from the audit log entry that
+// produced it
+type Revision struct {
+	// ID is the ID of the audit log entry
+	ID                uint
+	Operation         string
+	Data              json.RawMessage
+	ChangedByUserID   *uint
+	ChangedByUsername *string
+	ChangedAt         time.Time
+}
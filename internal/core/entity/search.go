@@ -0,0 +1,52 @@
+package entity
+
+// Search result sources
+const (
+	SearchSourceVerse       = "verse"
+	SearchSourceTranslation = "translation"
+)
+
+// Search modes
+const (
+	// SearchModeFullText matches Arabic text and translations with the tsvector indexes
+	SearchModeFullText = "fulltext"
+	// SearchModeFuzzy matches transliterations by trigram similarity, tolerating spelling variants
+	SearchModeFuzzy = "fuzzy"
+)
+
+// SearchResult is a ranked verse or translation match with its book and chapter context
+type SearchResult struct {
+	Source          string
+	VerseID         uint
+	VerseNumber     uint
+	ArabicText      string
+	Transliteration *string
+	TranslationID   *uint
+	LanguageCode    string
+	Snippet         string
+	Rank            float64
+	ChapterID       uint
+	ChapterNumber   uint
+	ChapterTitle    string
+	Category        string
+	BookID          uint
+	BookTitle       string
+}
+
+// Suggestion types
+const (
+	SuggestionTypeBook    = "book"
+	SuggestionTypeChapter = "chapter"
+	SuggestionTypeHadi    = "hadi"
+	SuggestionTypeVerse   = "verse"
+	SuggestionTypeQuery   = "query"
+)
+
+// Suggestion is an autocomplete entry. TargetID points to the book, chapter, hadi or verse
+// it was taken from and is nil for popular queries, which carry their popularity as Weight.
+type Suggestion struct {
+	Type     string
+	TargetID *uint
+	Text     string
+	Weight   int
+}
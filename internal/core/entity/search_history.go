@@ -0,0 +1,22 @@
+package entity
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// SearchHistory is a search run by an authenticated user
+type SearchHistory struct {
+	ID           uint           `json:"id" gorm:"primaryKey"`
+	UserID       uint           `json:"user_id" gorm:"not null"`
+	SearchQuery  string         `json:"search_query" gorm:"type:text;not null"`
+	SearchType   string         `json:"search_type" gorm:"type:varchar(20)"`
+	ResultsCount int            `json:"results_count"`
+	SearchedAt   time.Time      `json:"searched_at" gorm:"autoCreateTime"`
+	DeletedAt    gorm.DeletedAt `json:"-" gorm:"index"`
+}
+
+func (SearchHistory) TableName() string {
+	return "search_history"
+}
@@ -7,15 +7,16 @@ import (
 )
 
 type Verse struct {
-	ID              uint           `json:"id" gorm:"primaryKey"`
-	ChapterID       uint           `json:"chapter_id" gorm:"not null"`
-	Chapter         *Chapter       `json:"chapter,omitempty" gorm:"foreignKey:ChapterID"`
-	VerseNumber     uint           `json:"verse_number" gorm:"not null"`
-	ArabicText      string         `json:"arabic_text" gorm:"type:text;not null"`
-	Transliteration *string        `json:"transliteration,omitempty" gorm:"type:text"`
-	CreatedAt       time.Time      `json:"created_at" gorm:"autoCreateTime"`
-	UpdatedAt       time.Time      `json:"updated_at" gorm:"autoUpdateTime"`
-	DeletedAt       gorm.DeletedAt `json:"-" gorm:"index"`
+	ID               uint           `json:"id" gorm:"primaryKey"`
+	ChapterID        uint           `json:"chapter_id" gorm:"not null"`
+	Chapter          *Chapter       `json:"chapter,omitempty" gorm:"foreignKey:ChapterID"`
+	VerseNumber      uint           `json:"verse_number" gorm:"not null"`
+	ArabicText       string         `json:"arabic_text" gorm:"type:text;not null"`
+	ArabicNormalized string         `json:"-" gorm:"type:text;not null"` // search form of ArabicText, see arabic.Normalize
+	Transliteration  *string        `json:"transliteration,omitempty" gorm:"type:text"`
+	CreatedAt        time.Time      `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt        time.Time      `json:"updated_at" gorm:"autoUpdateTime"`
+	DeletedAt        gorm.DeletedAt `json:"-" gorm:"index"`
 }
 
 func (Verse) TableName() string { return "verses" }
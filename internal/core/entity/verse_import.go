@@ -0,0 +1,8 @@
+package entity
+
+// VerseImport is one verse of a bulk import together with the translations to write for it.
+// A Verse with an ID replaces the text of that existing verse instead of creating one.
+type VerseImport struct {
+	Verse        Verse
+	Translations []Translation
+}
@@ -0,0 +1,32 @@
+package entity
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Supported verse media types, mirrored by verse_media_media_type_check
+const (
+	MediaTypeAudio = "audio"
+	MediaTypeImage = "image"
+)
+
+type VerseMedia struct {
+	ID          uint           `json:"id" gorm:"primaryKey"`
+	VerseID     uint           `json:"verse_id" gorm:"not null"`
+	Verse       *Verse         `json:"verse,omitempty" gorm:"foreignKey:VerseID"`
+	MediaType   string         `json:"media_type" gorm:"type:varchar(20);not null"`
+	MediaURL    string         `json:"media_url" gorm:"type:text;not null"`
+	FileSize    *int           `json:"file_size,omitempty"`
+	Duration    *int           `json:"duration,omitempty"` // seconds
+	Codec       *string        `json:"codec,omitempty" gorm:"type:varchar(20)"`
+	Bitrate     *int           `json:"bitrate,omitempty"` // kbit/s
+	Description *string        `json:"description,omitempty" gorm:"type:text"`
+	HadiID      *int           `json:"hadi_id,omitempty"`
+	Hadi        *Hadi          `json:"hadi,omitempty" gorm:"foreignKey:HadiID"`
+	CreatedAt   time.Time      `json:"created_at" gorm:"autoCreateTime"`
+	DeletedAt   gorm.DeletedAt `json:"-" gorm:"index"`
+}
+
+func (VerseMedia) TableName() string { return "verse_media" }
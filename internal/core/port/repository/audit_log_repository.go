@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"ishari-backend/internal/core/entity"
+)
+
+type AuditLogFilter struct {
+	// BeforeID restricts the page to entries older than this ID (the cursor); 0 starts at the newest
+	BeforeID uint
+	// Limit of 0 returns every matching entry
+	Limit           int
+	Table           string
+	RecordID        *uint
+	Operation       string
+	ChangedByUserID *uint
+	// From is inclusive and To exclusive
+	From *time.Time
+	To   *time.Time
+}
+
+type AuditLogRepository interface {
+	// List returns matching entries newest first, with the username of whoever made the change
+	List(ctx context.Context, filter AuditLogFilter) ([]entity.AuditLog, error)
+	// GetByID returns nil without error when there is no entry with the ID
+	GetByID(ctx context.Context, id uint) (*entity.AuditLog, error)
+}
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+
+	"ishari-backend/internal/core/entity"
+)
+
+type CategoryRepository interface {
+	Create(ctx context.Context, category *entity.Category) error
+	// List returns every live category ordered by sort_order, then name
+	List(ctx context.Context) ([]entity.Category, error)
+	// GetByID returns nil without error when no live category has the ID
+	GetByID(ctx context.Context, id uint) (*entity.Category, error)
+	Update(ctx context.Context, category *entity.Category) error
+	Delete(ctx context.Context, id uint) error
+	// CountChapters returns the number of live chapters in the category
+	CountChapters(ctx context.Context, id uint) (int64, error)
+}
@@ -10,8 +10,22 @@ type ChapterRepository interface {
 	CreateChapter(ctx context.Context, chapter *entity.Chapter) error
 	ListChapters(ctx context.Context, offset, limit int, search string, bookID *uint, title string, category string) ([]entity.Chapter, int64, error)
 	GetChaptersByBookID(ctx context.Context, bookID uint) ([]entity.Chapter, int64, error)
+	// ListChaptersByCategory retrieves paginated chapters of a category across all books,
+	// ordered by book and chapter number
+	ListChaptersByCategory(ctx context.Context, categoryID uint, offset, limit int) ([]entity.Chapter, int64, error)
 	GetChapterByID(ctx context.Context, id uint) (*entity.Chapter, error)
 	UpdateChapter(ctx context.Context, chapter *entity.Chapter) error
 	DeleteChapter(ctx context.Context, id uint) error
 	DeleteChapters(ctx context.Context, ids []uint) error
+
+	// ReconcileVerseCounts sets total_verses of every live chapter to its number of live verses
+	// and returns the chapters that were changed, ordered by book and chapter number
+	ReconcileVerseCounts(ctx context.Context) ([]entity.VerseCountCorrection, error)
+
+	// ReorderChapters renumbers the live chapters of a book 1..n in the order of chapterIDs,
+	// which must list every live chapter of the book exactly once (ErrOrderMismatch otherwise)
+	ReorderChapters(ctx context.Context, bookID uint, chapterIDs []uint) error
+	// MoveChapter moves a chapter to position (1-based, 0 for last) within bookID and closes
+	// the gap it leaves behind
+	MoveChapter(ctx context.Context, id, bookID, position uint) error
 }
@@ -0,0 +1,11 @@
+package repository
+
+import "errors"
+
+// ErrOrderMismatch is returned by the reorder methods when the ordered IDs are not
+// exactly the live rows of the chapter or book being reordered
+var ErrOrderMismatch = errors.New("repository: ordered ids do not match the live rows")
+
+// ErrConflict is returned by bulk writes when a row collides with a unique constraint or
+// was changed by a concurrent writer; nothing is written
+var ErrConflict = errors.New("repository: rows conflict with existing data")
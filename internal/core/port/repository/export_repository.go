@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"context"
+
+	"ishari-backend/internal/core/entity"
+)
+
+// BookExportSink receives the rows of a book one at a time: every chapter, then every
+// verse, translation and media, each in chapter and verse order
+type BookExportSink interface {
+	Chapter(chapter *entity.ExportChapter) error
+	Verse(verse *entity.ExportVerse) error
+	Translation(translation *entity.ExportTranslation) error
+	Media(media *entity.ExportMedia) error
+}
+
+type ExportRepository interface {
+	// GetBook returns the book to export, or nil when it does not exist
+	GetBook(ctx context.Context, id int) (*entity.ExportBook, error)
+
+	// StreamBook reads the live rows of a book from one snapshot and hands them to sink
+	// without holding more than one row in memory. It stops at the first error of sink.
+	StreamBook(ctx context.Context, bookID int, sink BookExportSink) error
+
+	// ListBookletChapters returns the live chapters with the given IDs in no particular
+	// order, or every live chapter of bookID in chapter order when chapterIDs is empty
+	ListBookletChapters(ctx context.Context, bookID int, chapterIDs []uint) ([]entity.BookletChapter, error)
+
+	// StreamBookletVerses hands the live verses of the chapters to fn one at a time, in the
+	// order of chapterIDs and then of verse numbers, each with its translation in language
+	StreamBookletVerses(ctx context.Context, chapterIDs []uint, language string, fn func(*entity.BookletVerse) error) error
+}
@@ -12,4 +12,37 @@ type HadiRepository interface {
 	List(ctx context.Context, limit, offset int) ([]entity.Hadi, int64, error)
 	Update(ctx context.Context, hadi *entity.Hadi) error
 	Delete(ctx context.Context, id int) error
+
+	// ListRepertoire returns the audio recordings led by a hadi with their verse,
+	// chapter and book, ordered by book, category, chapter and verse
+	ListRepertoire(ctx context.Context, hadiID int) ([]HadiRepertoireRow, error)
+
+	// ListByVerse returns the audio recordings of a verse that have a lead hadi, ordered by hadi name
+	ListByVerse(ctx context.Context, verseID uint) ([]VerseHadiRow, error)
+}
+
+// HadiRepertoireRow is one audio recording of a hadi joined with its verse, chapter and book
+type HadiRepertoireRow struct {
+	BookID        uint
+	BookTitle     string
+	Category      string
+	ChapterID     uint
+	ChapterNumber uint
+	ChapterTitle  string
+	VerseID       uint
+	VerseNumber   uint
+	MediaID       uint
+	MediaURL      string
+	Duration      *int
+}
+
+// VerseHadiRow is one audio recording of a verse joined with the hadi leading it
+type VerseHadiRow struct {
+	HadiID       int
+	HadiName     string
+	HadiImageURL *string
+	HadiImages   *entity.ImageVariants `gorm:"serializer:json"`
+	MediaID      uint
+	MediaURL     string
+	Duration     *int
 }
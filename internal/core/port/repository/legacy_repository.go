@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	"ishari-backend/internal/core/entity"
+)
+
+// LegacyRepository supports imports from the legacy MySQL application
+type LegacyRepository interface {
+	// FindChapterIDs returns the live chapters numbered chapterNumber in the category with
+	// the given slug, only those of bookID when it is not 0
+	FindChapterIDs(ctx context.Context, categorySlug string, chapterNumber, bookID uint) ([]uint, error)
+
+	// SaveIDMappings records the rows legacy rows were imported as, replacing the earlier
+	// mapping of a legacy row to the same entity table
+	SaveIDMappings(ctx context.Context, mappings []entity.LegacyIDMapping) error
+}
@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+
+	"ishari-backend/internal/core/entity"
+)
+
+// ChapterReaderFilter selects the chapter and which translations and media to load with it
+type ChapterReaderFilter struct {
+	ChapterID uint
+	// Languages limits translations to these language codes; empty loads all of them
+	Languages []string
+	// MediaTypes limits media to these types; empty loads all of them
+	MediaTypes []string
+}
+
+type ReaderRepository interface {
+	// GetChapterReader loads a chapter with its book and verses in verse_number order,
+	// each with its translations and media. It returns nil when the chapter does not exist.
+	GetChapterReader(ctx context.Context, filter ChapterReaderFilter) (*entity.ChapterReader, error)
+
+	// GetBookTOC loads a book and its chapters in chapter_number order with their live verse
+	// count, audio availability and translated verse count per language. Coverage is not set.
+	// It returns a nil book when the book does not exist.
+	GetBookTOC(ctx context.Context, bookID int) (*entity.Book, []entity.TOCChapter, error)
+}
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+
+	"ishari-backend/internal/core/entity"
+)
+
+type SearchHistoryRepository interface {
+	Create(ctx context.Context, history *entity.SearchHistory) error
+
+	// ListByUserID returns the user's searches, newest first, and their total count
+	ListByUserID(ctx context.Context, userID uint, offset, limit int) ([]entity.SearchHistory, int64, error)
+
+	// Delete removes one of the user's searches. It reports false when the user has no such entry.
+	Delete(ctx context.Context, id, userID uint) (bool, error)
+
+	// DeleteByUserID removes all of the user's searches and returns how many were removed
+	DeleteByUserID(ctx context.Context, userID uint) (int64, error)
+}
@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+
+	"ishari-backend/internal/core/entity"
+)
+
+// SearchFilter holds the full-text query and the optional result filters
+type SearchFilter struct {
+	Offset   uint
+	Limit    uint
+	Query    string
+	BookID   *uint
+	Category string
+	// Language restricts matches to translations in that language, or to the Arabic verse text for "ar"
+	Language string
+	// Threshold is the minimum word similarity (0-1] of a transliteration match
+	Threshold float64
+}
+
+type SearchRepository interface {
+	// Search returns matches ordered by rank, best first, and the total number of matches
+	Search(ctx context.Context, filter SearchFilter) ([]entity.SearchResult, uint, error)
+
+	// SearchTransliteration returns verses whose transliteration is similar to the query,
+	// most similar first, and the total number of matches. Language is ignored.
+	SearchTransliteration(ctx context.Context, filter SearchFilter) ([]entity.SearchResult, uint, error)
+}
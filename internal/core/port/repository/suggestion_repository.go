@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+
+	"ishari-backend/internal/core/entity"
+)
+
+// PopularQueryFilter selects the past searches offered as suggestions
+type PopularQueryFilter struct {
+	Limit int
+	// MinUsers is the number of distinct users that must have run a query before it is suggested to others
+	MinUsers int
+}
+
+type SuggestionRepository interface {
+	// ListSuggestions returns every book title, chapter title, hadi name and verse transliteration,
+	// followed by the most popular past queries that found results
+	ListSuggestions(ctx context.Context, popular PopularQueryFilter) ([]entity.Suggestion, error)
+
+	// ContentVersion returns a fingerprint of the suggested content that changes when
+	// books, chapters, hadis or verses are created, updated or deleted
+	ContentVersion(ctx context.Context) (string, error)
+}
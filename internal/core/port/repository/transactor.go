@@ -0,0 +1,11 @@
+package repository
+
+import "context"
+
+// Transactor groups the repository calls of one unit of work into a single transaction
+type Transactor interface {
+	// WithinTransaction calls fn with a context whose repository calls share one transaction,
+	// committed when fn returns nil and rolled back otherwise. Writes are attributed in the
+	// audit log to the user carried by the context (see usecase.NewContextWithUser).
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
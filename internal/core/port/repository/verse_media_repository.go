@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+
+	"ishari-backend/internal/core/entity"
+)
+
+type VerseMediaFilter struct {
+	Offset    uint
+	Limit     uint
+	VerseID   *uint
+	HadiID    *int
+	MediaType string
+}
+
+type VerseMediaRepository interface {
+	Create(ctx context.Context, media *entity.VerseMedia) error
+	List(ctx context.Context, filter VerseMediaFilter) ([]entity.VerseMedia, uint, error)
+	GetById(ctx context.Context, id uint) (*entity.VerseMedia, error)
+	Update(ctx context.Context, media *entity.VerseMedia) error
+	Delete(ctx context.Context, id uint) error
+}
@@ -21,4 +21,19 @@ type VerseRepository interface {
 	Delete(ctx context.Context, id uint) error
 	BulkDelete(ctx context.Context, ids []uint) error
 	GetById(ctx context.Context, id uint) (*entity.Verse, error)
+
+	// Reorder renumbers the live verses of a chapter 1..n in the order of verseIDs, which
+	// must list every live verse of the chapter exactly once (ErrOrderMismatch otherwise)
+	Reorder(ctx context.Context, chapterID uint, verseIDs []uint) error
+	// Move moves a verse to position (1-based, 0 for last) within chapterID and closes the
+	// gap it leaves behind
+	Move(ctx context.Context, id, chapterID, position uint) error
+
+	// VerseNumbers maps the verse numbers of a chapter's live verses to their IDs
+	VerseNumbers(ctx context.Context, chapterID uint) (map[uint]uint, error)
+	// Import writes verses into chapterID in one transaction: verses with an ID get their
+	// text replaced, the others are created, and each translation replaces the live one of
+	// its verse and language. ErrConflict is returned when a verse number was taken or an
+	// updated verse disappeared in the meantime.
+	Import(ctx context.Context, chapterID uint, verses []entity.VerseImport) error
 }
@@ -0,0 +1,49 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+)
+
+// ErrObjectNotFound is returned when the requested key does not exist in the backend
+var ErrObjectNotFound = errors.New("storage: object not found")
+
+// ObjectInfo describes a stored object
+type ObjectInfo struct {
+	Key          string
+	Size         int64
+	ContentType  string
+	ETag         string
+	LastModified time.Time
+}
+
+// Storage defines the interface for binary file storage (uploads, recordings, images).
+// Implementations live outside the core (local filesystem, S3-compatible buckets).
+type Storage interface {
+	// Put stores the content under key, replacing any existing object
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) (*ObjectInfo, error)
+
+	// Get opens the object for reading. The caller must close the returned reader.
+	Get(ctx context.Context, key string) (io.ReadCloser, *ObjectInfo, error)
+
+	// Stat returns the object metadata without reading its content
+	Stat(ctx context.Context, key string) (*ObjectInfo, error)
+
+	// GetRange opens length bytes of the object starting at offset.
+	// The caller must close the returned reader.
+	GetRange(ctx context.Context, key string, offset, length int64) (io.ReadCloser, error)
+
+	// Delete removes the object. Deleting a missing key is not an error.
+	Delete(ctx context.Context, key string) error
+
+	// Presign returns a time-limited URL that grants read access to the object
+	Presign(ctx context.Context, key string, ttl time.Duration) (string, error)
+
+	// URL returns the public URL stored on entities for the given key
+	URL(key string) string
+
+	// KeyFromURL reverses URL. It reports false for URLs not served by this storage.
+	KeyFromURL(url string) (string, bool)
+}
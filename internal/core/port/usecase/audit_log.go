@@ -0,0 +1,37 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"ishari-backend/internal/core/entity"
+)
+
+// ListAuditLogInput contains the filters and cursor of an audit log listing
+type ListAuditLogInput struct {
+	Table           string
+	RecordID        *uint
+	Operation       string
+	ChangedByUserID *uint
+	// From is inclusive and To exclusive
+	From *time.Time
+	To   *time.Time
+	// Cursor is the NextCursor of the previous page, 0 for the first page
+	Cursor uint
+	Limit  int
+}
+
+// CursorResult is a page of a listing that is walked with an opaque cursor instead of page numbers
+type CursorResult[T any] struct {
+	Data  []T
+	Limit int
+	// NextCursor fetches the following page; nil on the last page
+	NextCursor *uint
+}
+
+// AuditLogUseCase reads the changes recorded in the audit log
+type AuditLogUseCase interface {
+	List(ctx context.Context, input ListAuditLogInput) (*CursorResult[entity.AuditLog], error)
+	// GetByID returns an entry with the field-level diff between its old and new data
+	GetByID(ctx context.Context, id uint) (*entity.AuditLogDetail, error)
+}
@@ -0,0 +1,33 @@
+package usecase
+
+import (
+	"context"
+
+	"ishari-backend/internal/core/entity"
+)
+
+type CategoryUseCase interface {
+	Create(ctx context.Context, input CreateCategoryInput) (*entity.Category, error)
+	List(ctx context.Context) ([]entity.Category, error)
+	GetByID(ctx context.Context, id uint) (*entity.Category, error)
+	Update(ctx context.Context, id uint, input UpdateCategoryInput) (*entity.Category, error)
+	Delete(ctx context.Context, id uint) error
+	ListChapters(ctx context.Context, id uint, page, limit int) (*PaginatedResult[entity.Chapter], error)
+}
+
+// CreateCategoryInput contains data required to create a chapter category.
+// An empty Slug is derived from Name.
+type CreateCategoryInput struct {
+	Slug        string
+	Name        string
+	SortOrder   int
+	Description *string
+}
+
+// UpdateCategoryInput contains data required to update a chapter category.
+type UpdateCategoryInput struct {
+	Slug        *string
+	Name        *string
+	SortOrder   *int
+	Description *string
+}
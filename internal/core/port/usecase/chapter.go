@@ -14,6 +14,9 @@ type ChapterUsecase interface {
 	Update(ctx context.Context, id uint, input UpdateChapterInput) (*entity.Chapter, error)
 	Delete(ctx context.Context, id uint) error
 	BulkDelete(ctx context.Context, ids []uint) error
+	ReconcileVerseCounts(ctx context.Context) ([]entity.VerseCountCorrection, error)
+	Reorder(ctx context.Context, bookID uint, chapterIDs []uint) error
+	Move(ctx context.Context, id uint, input MoveChapterInput) (*entity.Chapter, error)
 }
 
 // CreateChapterInput contains data required to create a new chapter.
@@ -21,9 +24,8 @@ type CreateChapterInput struct {
 	BookID        uint
 	ChapterNumber uint
 	Title         string
-	Category      string
+	CategoryID    uint
 	Description   *string
-	TotalVerses   uint
 }
 
 // UpdateChapterInput contains data required to update a chapter.
@@ -31,12 +33,19 @@ type UpdateChapterInput struct {
 	BookID        *uint
 	ChapterNumber *uint
 	Title         *string
-	Category      *string
+	CategoryID    *uint
 	Description   *string
-	TotalVerses   *uint
 }
 
-// ListChapterInput contains data required to list chapters.
+// MoveChapterInput contains data required to move a chapter. Position is 1-based and nil
+// moves the chapter to the end; a nil BookID keeps the chapter in its current book.
+type MoveChapterInput struct {
+	BookID   *uint
+	Position *uint
+}
+
+// ListChapterInput contains data required to list chapters. Category matches a category
+// slug or name.
 type ListChapterInput struct {
 	Page     int
 	Limit    int
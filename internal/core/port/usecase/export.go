@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"context"
+	"io"
+
+	"ishari-backend/internal/core/entity"
+)
+
+// Book export formats
+const (
+	ExportFormatJSON = "json" // one document in the versioned entity.BookExportFormat schema
+	ExportFormatCSV  = "csv"  // a zip with one CSV per entity
+	ExportFormatEPUB = "epub" // an EPUB 3 e-book for reading offline
+)
+
+// ExportUseCase exports the content of books. Exports are checked before anything is
+// written, so an error response is still possible, and then streamed.
+type ExportUseCase interface {
+	// PrepareBook checks that the book exists and the format is supported
+	PrepareBook(ctx context.Context, bookID int, format string) (*BookExport, error)
+	// WriteBook streams a prepared export to w
+	WriteBook(ctx context.Context, export *BookExport, w io.Writer) error
+
+	// PrepareEPUB resolves the book or chapters of an e-book and checks its options
+	PrepareEPUB(ctx context.Context, input EPUBExportInput) (*EPUBExport, error)
+	// WriteEPUB streams a prepared e-book to w
+	WriteEPUB(ctx context.Context, export *EPUBExport, w io.Writer) error
+}
+
+// BookExport is a book export ready to be written as a file named Filename
+type BookExport struct {
+	Book        *entity.ExportBook
+	Format      string
+	Filename    string
+	ContentType string
+}
+
+// EPUBExportInput selects the content of an e-book: a whole book, or ChapterIDs in the
+// order given when BookID is 0. Language picks the translation printed under each verse
+// (none when empty) and Title overrides the book title.
+type EPUBExportInput struct {
+	BookID     int
+	ChapterIDs []uint
+	Language   string
+	Title      string
+}
+
+// EPUBExport is an e-book ready to be written as a file named Filename. Book is set when
+// every chapter belongs to the same book, whose author and cover the e-book then uses.
+type EPUBExport struct {
+	Book        *entity.ExportBook
+	Chapters    []entity.BookletChapter
+	Title       string
+	Language    string
+	Filename    string
+	ContentType string
+}
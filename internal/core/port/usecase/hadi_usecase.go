@@ -13,4 +13,6 @@ type HadiUseCase interface {
 	List(ctx context.Context, page, limit int) ([]response.HadiResponse, int64, error)
 	Update(ctx context.Context, id int, req dto.UpdateHadiRequest) (*response.HadiResponse, error)
 	Delete(ctx context.Context, id int) error
+	GetRepertoire(ctx context.Context, id int) (*response.HadiRepertoireResponse, error)
+	ListByVerse(ctx context.Context, verseID uint) ([]response.VerseHadiResponse, error)
 }
@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"context"
+
+	"ishari-backend/internal/core/entity"
+)
+
+// LegacyImportUseCase imports the verses of the legacy MySQL application. Imports are
+// idempotent: verses are upserted on their chapter and verse number, so a rerun updates
+// the verses an earlier run created instead of duplicating them.
+type LegacyImportUseCase interface {
+	Import(ctx context.Context, input LegacyImportInput) (*LegacyImportReport, error)
+}
+
+// LegacyImportInput imports Records read from the legacy table Table of the database
+// named Source. With DryRun nothing is written and the report tells what would be.
+type LegacyImportInput struct {
+	Source  string
+	Table   string
+	Records []entity.LegacyRecord
+	Mapping LegacyMapping
+	DryRun  bool
+}
+
+// LegacyMapping tells which chapter each legacy record belongs to: Muhuds maps legacy
+// muhud IDs to chapter numbers and the category is that of the first matching rule, or
+// DefaultCategory. Records matching neither are skipped.
+type LegacyMapping struct {
+	BookID              uint // restricts chapters to one book when not 0
+	Muhuds              map[int64]uint
+	Categories          []LegacyCategoryRule
+	DefaultCategory     string
+	TranslationLanguage string // language of LegacyRecord.Translation, "id" when empty
+}
+
+// LegacyCategoryRule puts a record in the category with slug Category when its Column
+// equals Value; a NULL column reads as ""
+type LegacyCategoryRule struct {
+	Column   string
+	Value    string
+	Category string
+}
+
+// LegacyImportReport summarizes an import. Created and Updated count the verses that were
+// (or, on a dry run, would be) written; a chapter with errors is not written at all and
+// counts in Failed.
+type LegacyImportReport struct {
+	DryRun   bool
+	Records  int
+	Created  int
+	Updated  int
+	Failed   int
+	Skipped  []LegacySkippedRecord
+	Chapters []LegacyChapterReport
+}
+
+// LegacySkippedRecord is a record that belongs to no chapter
+type LegacySkippedRecord struct {
+	LegacyID int64
+	Reason   string
+}
+
+// LegacyChapterReport is the outcome of the records of one chapter
+type LegacyChapterReport struct {
+	ChapterID     uint
+	Category      string
+	ChapterNumber uint
+	Records       int
+	Created       int
+	Updated       int
+	Errors        []string
+}
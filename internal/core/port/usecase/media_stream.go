@@ -0,0 +1,26 @@
+package usecase
+
+import (
+	"context"
+	"io"
+
+	"ishari-backend/internal/core/entity"
+	"ishari-backend/internal/core/port/storage"
+)
+
+// MediaStreamUseCase serves the stored file of verse media, optionally in byte ranges
+type MediaStreamUseCase interface {
+	// Resolve looks up the media and the metadata of its stored file
+	Resolve(ctx context.Context, id uint) (*MediaObject, error)
+	// Open reads length bytes of the resolved file starting at offset
+	Open(ctx context.Context, obj *MediaObject, offset, length int64) (io.ReadCloser, error)
+}
+
+// MediaObject is verse media resolved to its stored file.
+// ExternalURL is set instead of Key/Info when the file is hosted outside our storage.
+type MediaObject struct {
+	Media       *entity.VerseMedia
+	Key         string
+	Info        *storage.ObjectInfo
+	ExternalURL string
+}
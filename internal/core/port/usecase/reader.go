@@ -0,0 +1,21 @@
+package usecase
+
+import (
+	"context"
+
+	"ishari-backend/internal/core/entity"
+)
+
+// ReaderUseCase assembles content for reading in a single call
+type ReaderUseCase interface {
+	GetChapter(ctx context.Context, params ChapterReaderParams) (*entity.ChapterReader, error)
+	GetBookTOC(ctx context.Context, bookID int) (*entity.BookTOC, error)
+}
+
+// ChapterReaderParams selects a chapter and the translations and media to include.
+// Empty Languages or MediaTypes include everything.
+type ChapterReaderParams struct {
+	ChapterID  uint
+	Languages  []string
+	MediaTypes []string
+}
@@ -0,0 +1,23 @@
+package usecase
+
+import (
+	"context"
+
+	"ishari-backend/internal/core/entity"
+)
+
+// RevisionUseCase lists the recorded versions of verses and translations and restores them.
+// Reverts go through the verse and translation usecases, so they are validated and audited
+// like any other edit.
+type RevisionUseCase interface {
+	// ListVerseRevisions returns the versions of a verse, newest first
+	ListVerseRevisions(ctx context.Context, verseID uint) ([]entity.Revision, error)
+	// RevertVerse restores the text and transliteration of a verse revision. The chapter and
+	// verse number are left as they are; use Move and Reorder for those.
+	RevertVerse(ctx context.Context, verseID, revisionID uint) (*entity.Verse, error)
+
+	// ListTranslationRevisions returns the versions of a translation, newest first
+	ListTranslationRevisions(ctx context.Context, translationID uint) ([]entity.Revision, error)
+	// RevertTranslation restores the language, text and translator of a translation revision
+	RevertTranslation(ctx context.Context, translationID, revisionID uint) (*entity.Translation, error)
+}
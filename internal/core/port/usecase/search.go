@@ -0,0 +1,36 @@
+package usecase
+
+import (
+	"context"
+
+	"ishari-backend/internal/core/entity"
+)
+
+type SearchUseCase interface {
+	Search(ctx context.Context, params SearchParams) (*PaginatedResult[entity.SearchResult], error)
+}
+
+// SearchParams contains the query, filters and pagination of a search request.
+type SearchParams struct {
+	Query    string
+	BookID   *uint
+	Category string
+	Language string
+	// Mode is entity.SearchModeFullText (default) or entity.SearchModeFuzzy
+	Mode string
+	// Threshold is the minimum similarity for fuzzy matches; zero uses the default
+	Threshold float64
+	Page      uint
+	Limit     uint
+}
+
+// SuggestUseCase answers autocomplete requests from an in-memory prefix index
+type SuggestUseCase interface {
+	Suggest(ctx context.Context, params SuggestParams) ([]entity.Suggestion, error)
+}
+
+// SuggestParams contains the typed prefix and the maximum number of suggestions
+type SuggestParams struct {
+	Query string
+	Limit int
+}
@@ -0,0 +1,22 @@
+package usecase
+
+import (
+	"context"
+
+	"ishari-backend/internal/core/entity"
+)
+
+// ListSearchHistoryInput contains the owner and pagination of a history listing
+type ListSearchHistoryInput struct {
+	UserID uint
+	Page   int
+	Limit  int
+}
+
+// SearchHistoryUseCase manages the searches recorded for a user
+type SearchHistoryUseCase interface {
+	List(ctx context.Context, input ListSearchHistoryInput) (*PaginatedResult[entity.SearchHistory], error)
+	Delete(ctx context.Context, id uint, userID uint) error
+	// Clear removes all of the user's searches and returns how many were removed
+	Clear(ctx context.Context, userID uint) (int64, error)
+}
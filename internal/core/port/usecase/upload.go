@@ -0,0 +1,36 @@
+package usecase
+
+import (
+	"context"
+	"io"
+
+	"ishari-backend/internal/core/entity"
+)
+
+// UploadUseCase stores uploaded files and links them to their owning records
+type UploadUseCase interface {
+	UploadVerseMedia(ctx context.Context, input UploadVerseMediaInput) (*entity.VerseMedia, error)
+	ReplaceVerseMediaFile(ctx context.Context, id uint, file UploadFile) (*entity.VerseMedia, error)
+	UploadBookCover(ctx context.Context, bookID int64, file UploadFile) (*entity.Book, error)
+	UploadHadiImage(ctx context.Context, hadiID int, file UploadFile) (*entity.Hadi, error)
+}
+
+// UploadFile is a file received from a client.
+// Size is the size in bytes; ContentType is only a hint, the content is sniffed.
+// Content needs random access so container headers and trailers can be parsed.
+type UploadFile struct {
+	Filename    string
+	ContentType string
+	Size        int64
+	Content     io.ReaderAt
+}
+
+// UploadVerseMediaInput contains data required to upload a media file for a verse.
+// MediaType is optional and inferred from the file content when empty.
+type UploadVerseMediaInput struct {
+	VerseID     uint
+	MediaType   string
+	Description *string
+	HadiID      *int
+	File        UploadFile
+}
@@ -12,6 +12,9 @@ type VerseUseCase interface {
 	Delete(ctx context.Context, id uint) error
 	BulkDelete(ctx context.Context, ids []uint) error
 	GetById(ctx context.Context, id uint) (*entity.Verse, error)
+	Reorder(ctx context.Context, chapterID uint, verseIDs []uint) error
+	Move(ctx context.Context, id uint, input MoveVerseInput) (*entity.Verse, error)
+	Import(ctx context.Context, input ImportVersesInput) (*ImportVersesResult, error)
 }
 
 type CreateVerseInput struct {
@@ -28,6 +31,55 @@ type UpdateVerseInput struct {
 	Transliteration *string `json:"transliteration,omitempty" gorm:"type:text"`
 }
 
+// MoveVerseInput moves a verse to Position (1-based, nil for last) of ChapterID, or of its
+// current chapter when ChapterID is nil
+type MoveVerseInput struct {
+	ChapterID *uint
+	Position  *uint
+}
+
+// Conflict policies of ImportVersesInput.OnConflict
+const (
+	ImportOnConflictFail   = "fail"   // reject the import when a verse number already exists
+	ImportOnConflictUpdate = "update" // replace the text of the existing verse
+)
+
+// ImportVersesInput imports Rows into ChapterID. With DryRun nothing is written and the
+// result only reports what would happen to each row.
+type ImportVersesInput struct {
+	ChapterID  uint
+	Rows       []ImportVerseRow
+	DryRun     bool
+	OnConflict string // ImportOnConflictFail when empty
+}
+
+// ImportVerseRow is one verse of an import; Translations maps language codes to text
+type ImportVerseRow struct {
+	VerseNumber     uint
+	ArabicText      string
+	Transliteration *string
+	Translations    map[string]string
+}
+
+// ImportVersesResult reports an import row by row. Created and Updated count the rows that
+// were (or, on a dry run, would be) written; nothing is written unless every row is valid.
+type ImportVersesResult struct {
+	DryRun  bool
+	Valid   bool
+	Created int
+	Updated int
+	Rows    []ImportRowReport
+}
+
+// ImportRowReport is the outcome of one import row. Row is 1-based in input order and
+// Action is "create" or "update".
+type ImportRowReport struct {
+	Row         int
+	VerseNumber uint
+	Action      string
+	Errors      []string
+}
+
 type ListParams struct {
 	Page            uint
 	Limit           uint
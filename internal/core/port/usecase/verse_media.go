@@ -0,0 +1,50 @@
+package usecase
+
+import (
+	"context"
+
+	"ishari-backend/internal/core/entity"
+)
+
+type VerseMediaUseCase interface {
+	Create(ctx context.Context, input CreateVerseMediaInput) (*entity.VerseMedia, error)
+	List(ctx context.Context, params ListVerseMediaParams) (*PaginatedResult[entity.VerseMedia], error)
+	GetById(ctx context.Context, id uint) (*entity.VerseMedia, error)
+	Update(ctx context.Context, id uint, input UpdateVerseMediaInput) (*entity.VerseMedia, error)
+	Delete(ctx context.Context, id uint) error
+}
+
+// CreateVerseMediaInput contains data required to attach media to a verse.
+type CreateVerseMediaInput struct {
+	VerseID     uint
+	MediaType   string
+	MediaURL    string
+	FileSize    *int
+	Duration    *int
+	Codec       *string
+	Bitrate     *int
+	Description *string
+	HadiID      *int
+}
+
+// UpdateVerseMediaInput contains data required to update verse media.
+type UpdateVerseMediaInput struct {
+	VerseID     *uint
+	MediaType   *string
+	MediaURL    *string
+	FileSize    *int
+	Duration    *int
+	Codec       *string
+	Bitrate     *int
+	Description *string
+	HadiID      *int
+}
+
+// ListVerseMediaParams contains pagination and filter parameters for verse media.
+type ListVerseMediaParams struct {
+	Page      uint
+	Limit     uint
+	VerseID   *uint
+	HadiID    *int
+	MediaType string
+}
@@ -0,0 +1,24 @@
+package usecase
+
+import (
+	"context"
+	"io"
+)
+
+// WaveformUseCase computes, caches and serves audio peaks for the verse player
+type WaveformUseCase interface {
+	// Get returns the peaks of an audio verse media downsampled to points
+	Get(ctx context.Context, mediaID uint, points int) (*Waveform, error)
+	// Generate computes peaks for the stored object key and caches them next to it
+	Generate(ctx context.Context, key string, content io.ReaderAt, size int64) error
+	// Remove deletes the cached peaks of the stored object key
+	Remove(ctx context.Context, key string)
+}
+
+// Waveform is a peaks array normalised to [0, 1]
+type Waveform struct {
+	MediaID  uint
+	Points   int
+	Duration *int
+	Peaks    []float64
+}
@@ -0,0 +1,213 @@
+package auditlog
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"reflect"
+	"slices"
+	"strings"
+
+	"ishari-backend/internal/core/domain"
+	"ishari-backend/internal/core/entity"
+	"ishari-backend/internal/core/port/logger"
+	"ishari-backend/internal/core/port/repository"
+	portuc "ishari-backend/internal/core/port/usecase"
+)
+
+const (
+	defaultLimit = 50
+	maxLimit     = 200
+)
+
+var (
+	// redactedFields are columns whose values are replaced before an entry leaves the usecase;
+	// that they changed is still reported
+	redactedFields = map[string][]string{
+		"users": {"password_hash"},
+	}
+	redactedValue = json.RawMessage(`"[REDACTED]"`)
+	jsonNull      = json.RawMessage(`null`)
+)
+
+type auditLogUsecase struct {
+	auditRepo repository.AuditLogRepository
+	log       logger.Logger
+}
+
+func NewAuditLogUsecase(auditRepo repository.AuditLogRepository, log logger.Logger) portuc.AuditLogUseCase {
+	return &auditLogUsecase{
+		auditRepo: auditRepo,
+		log:       log,
+	}
+}
+
+// List returns matching audit log entries, newest first
+func (u *auditLogUsecase) List(ctx context.Context, input portuc.ListAuditLogInput) (*portuc.CursorResult[entity.AuditLog], error) {
+	operation := strings.ToUpper(strings.TrimSpace(input.Operation))
+	switch operation {
+	case "", entity.AuditOperationInsert, entity.AuditOperationUpdate, entity.AuditOperationDelete:
+	default:
+		return nil, ErrInvalidOperation
+	}
+	if input.From != nil && input.To != nil && input.From.After(*input.To) {
+		return nil, ErrInvalidDateRange
+	}
+	if input.Limit <= 0 {
+		input.Limit = defaultLimit
+	}
+	input.Limit = min(input.Limit, maxLimit)
+
+	// One extra row tells whether there is a next page
+	logs, err := u.auditRepo.List(ctx, repository.AuditLogFilter{
+		BeforeID:        input.Cursor,
+		Limit:           input.Limit + 1,
+		Table:           strings.TrimSpace(input.Table),
+		RecordID:        input.RecordID,
+		Operation:       operation,
+		ChangedByUserID: input.ChangedByUserID,
+		From:            input.From,
+		To:              input.To,
+	})
+	if err != nil {
+		u.log.Error("failed to list audit logs", "error", err)
+		return nil, domain.NewInternalError("failed to list audit logs", err)
+	}
+
+	result := &portuc.CursorResult[entity.AuditLog]{Limit: input.Limit}
+	if len(logs) > input.Limit {
+		logs = logs[:input.Limit]
+		next := logs[len(logs)-1].ID
+		result.NextCursor = &next
+	}
+	for i := range logs {
+		logs[i].OldData = redact(logs[i].Table, logs[i].OldData)
+		logs[i].NewData = redact(logs[i].Table, logs[i].NewData)
+	}
+	result.Data = logs
+	return result, nil
+}
+
+// GetByID returns an audit log entry with the columns that changed
+func (u *auditLogUsecase) GetByID(ctx context.Context, id uint) (*entity.AuditLogDetail, error) {
+	entry, err := u.auditRepo.GetByID(ctx, id)
+	if err != nil {
+		u.log.Error("failed to get audit log", "error", err, "id", id)
+		return nil, domain.NewInternalError("failed to get audit log", err)
+	}
+	if entry == nil {
+		return nil, ErrAuditLogNotFound
+	}
+
+	changes, err := diff(entry.OldData, entry.NewData)
+	if err != nil {
+		u.log.Error("failed to diff audit log", "error", err, "id", id)
+		return nil, domain.NewInternalError("failed to diff audit log", err)
+	}
+	for i := range changes {
+		if slices.Contains(redactedFields[entry.Table], changes[i].Field) {
+			changes[i].Old, changes[i].New = redactedValue, redactedValue
+		}
+	}
+
+	entry.OldData = redact(entry.Table, entry.OldData)
+	entry.NewData = redact(entry.Table, entry.NewData)
+	return &entity.AuditLogDetail{AuditLog: *entry, Changes: changes}, nil
+}
+
+// diff compares the top-level columns of two row snapshots, treating a missing snapshot or
+// column as null, and returns the columns that differ ordered by name. Values are compared
+// semantically, so key order and whitespace inside JSON columns do not count as changes.
+func diff(oldData, newData json.RawMessage) ([]entity.FieldChange, error) {
+	oldFields, err := columns(oldData)
+	if err != nil {
+		return nil, err
+	}
+	newFields, err := columns(newData)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(oldFields)+len(newFields))
+	for name := range oldFields {
+		names = append(names, name)
+	}
+	for name := range newFields {
+		if _, ok := oldFields[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+
+	changes := make([]entity.FieldChange, 0)
+	for _, name := range names {
+		oldValue, newValue := orNull(oldFields[name]), orNull(newFields[name])
+		equal, err := equalJSON(oldValue, newValue)
+		if err != nil {
+			return nil, err
+		}
+		if !equal {
+			changes = append(changes, entity.FieldChange{Field: name, Old: oldValue, New: newValue})
+		}
+	}
+	return changes, nil
+}
+
+// columns decodes a row snapshot; a missing snapshot has no columns
+func columns(data json.RawMessage) (map[string]json.RawMessage, error) {
+	if len(data) == 0 || bytes.Equal(bytes.TrimSpace(data), jsonNull) {
+		return nil, nil
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+func orNull(value json.RawMessage) json.RawMessage {
+	if len(value) == 0 {
+		return jsonNull
+	}
+	return value
+}
+
+func equalJSON(a, b json.RawMessage) (bool, error) {
+	if bytes.Equal(a, b) {
+		return true, nil
+	}
+	var x, y any
+	if err := json.Unmarshal(a, &x); err != nil {
+		return false, err
+	}
+	if err := json.Unmarshal(b, &y); err != nil {
+		return false, err
+	}
+	return reflect.DeepEqual(x, y), nil
+}
+
+// redact replaces the values of the table's redacted columns in a row snapshot
+func redact(table string, data json.RawMessage) json.RawMessage {
+	fields := redactedFields[table]
+	if len(fields) == 0 {
+		return data
+	}
+	values, err := columns(data)
+	if err != nil {
+		// Never pass on a snapshot that could not be checked
+		return nil
+	}
+	if values == nil {
+		return data
+	}
+	for _, field := range fields {
+		if _, ok := values[field]; ok {
+			values[field] = redactedValue
+		}
+	}
+	out, err := json.Marshal(values)
+	if err != nil {
+		return nil
+	}
+	return out
+}
@@ -0,0 +1,221 @@
+package auditlog_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"ishari-backend/internal/core/entity"
+	"ishari-backend/internal/core/port/repository"
+	portuc "ishari-backend/internal/core/port/usecase"
+	"ishari-backend/internal/core/usecase/auditlog"
+)
+
+// MockAuditLogRepository is a manual mock for AuditLogRepository
+type MockAuditLogRepository struct {
+	ListFunc    func(ctx context.Context, filter repository.AuditLogFilter) ([]entity.AuditLog, error)
+	GetByIDFunc func(ctx context.Context, id uint) (*entity.AuditLog, error)
+}
+
+func (m *MockAuditLogRepository) List(ctx context.Context, filter repository.AuditLogFilter) ([]entity.AuditLog, error) {
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, filter)
+	}
+	return nil, nil
+}
+
+func (m *MockAuditLogRepository) GetByID(ctx context.Context, id uint) (*entity.AuditLog, error) {
+	if m.GetByIDFunc != nil {
+		return m.GetByIDFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+// MockLogger is a manual mock for Logger
+type MockLogger struct{}
+
+func (m *MockLogger) Info(msg string, fields ...any)  {}
+func (m *MockLogger) Error(msg string, fields ...any) {}
+
+func logsFrom(id uint, n int) []entity.AuditLog {
+	logs := make([]entity.AuditLog, 0, n)
+	for i := range n {
+		logs = append(logs, entity.AuditLog{ID: id - uint(i), Table: "verses", Operation: entity.AuditOperationUpdate})
+	}
+	return logs
+}
+
+// ==================== List Tests ====================
+
+func TestAuditLogUsecase_List_NextCursor(t *testing.T) {
+	var got repository.AuditLogFilter
+	repo := &MockAuditLogRepository{
+		ListFunc: func(ctx context.Context, filter repository.AuditLogFilter) ([]entity.AuditLog, error) {
+			got = filter
+			return logsFrom(90, filter.Limit), nil
+		},
+	}
+	recordID := uint(7)
+
+	uc := auditlog.NewAuditLogUsecase(repo, &MockLogger{})
+	result, err := uc.List(context.Background(), portuc.ListAuditLogInput{
+		Table:     "verses",
+		RecordID:  &recordID,
+		Operation: "update",
+		Cursor:    91,
+		Limit:     2,
+	})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got.BeforeID != 91 || got.Limit != 3 || got.Operation != "UPDATE" || got.Table != "verses" {
+		t.Errorf("unexpected filter %+v", got)
+	}
+	if len(result.Data) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(result.Data))
+	}
+	if result.NextCursor == nil || *result.NextCursor != 89 {
+		t.Errorf("expected next cursor 89, got %v", result.NextCursor)
+	}
+}
+
+func TestAuditLogUsecase_List_LastPage(t *testing.T) {
+	repo := &MockAuditLogRepository{
+		ListFunc: func(ctx context.Context, filter repository.AuditLogFilter) ([]entity.AuditLog, error) {
+			return logsFrom(3, 3), nil
+		},
+	}
+
+	uc := auditlog.NewAuditLogUsecase(repo, &MockLogger{})
+	result, err := uc.List(context.Background(), portuc.ListAuditLogInput{})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result.Limit != 50 || len(result.Data) != 3 || result.NextCursor != nil {
+		t.Errorf("unexpected result %+v", result)
+	}
+}
+
+func TestAuditLogUsecase_List_Validation(t *testing.T) {
+	from := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, -1)
+
+	tests := []struct {
+		name  string
+		input portuc.ListAuditLogInput
+		want  error
+	}{
+		{"invalid operation", portuc.ListAuditLogInput{Operation: "TRUNCATE"}, auditlog.ErrInvalidOperation},
+		{"inverted range", portuc.ListAuditLogInput{From: &from, To: &to}, auditlog.ErrInvalidDateRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := auditlog.NewAuditLogUsecase(&MockAuditLogRepository{}, &MockLogger{})
+			_, err := uc.List(context.Background(), tt.input)
+			if !errors.Is(err, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestAuditLogUsecase_List_RedactsPasswordHash(t *testing.T) {
+	repo := &MockAuditLogRepository{
+		ListFunc: func(ctx context.Context, filter repository.AuditLogFilter) ([]entity.AuditLog, error) {
+			return []entity.AuditLog{{
+				ID:        1,
+				Table:     "users",
+				Operation: entity.AuditOperationInsert,
+				NewData:   json.RawMessage(`{"id":1,"username":"admin","password_hash":"$2a$10$secret"}`),
+			}}, nil
+		},
+	}
+
+	uc := auditlog.NewAuditLogUsecase(repo, &MockLogger{})
+	result, err := uc.List(context.Background(), portuc.ListAuditLogInput{})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	var data map[string]any
+	if err := json.Unmarshal(result.Data[0].NewData, &data); err != nil {
+		t.Fatalf("invalid new_data: %v", err)
+	}
+	if data["password_hash"] != "[REDACTED]" || data["username"] != "admin" {
+		t.Errorf("expected only the password hash to be redacted, got %v", data)
+	}
+}
+
+// ==================== GetByID Tests ====================
+
+func TestAuditLogUsecase_GetByID_Diff(t *testing.T) {
+	repo := &MockAuditLogRepository{
+		GetByIDFunc: func(ctx context.Context, id uint) (*entity.AuditLog, error) {
+			return &entity.AuditLog{
+				ID:        id,
+				Table:     "verses",
+				Operation: entity.AuditOperationUpdate,
+				OldData:   json.RawMessage(`{"id":5,"arabic_text":"old","transliteration":null,"meta":{"a":1,"b":2}}`),
+				NewData:   json.RawMessage(`{"id":5,"arabic_text":"new","transliteration":"ya","meta":{"b":2, "a":1}}`),
+			}, nil
+		},
+	}
+
+	uc := auditlog.NewAuditLogUsecase(repo, &MockLogger{})
+	detail, err := uc.GetByID(context.Background(), 12)
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(detail.Changes) != 2 {
+		t.Fatalf("expected 2 changes, got %+v", detail.Changes)
+	}
+	first, second := detail.Changes[0], detail.Changes[1]
+	if first.Field != "arabic_text" || string(first.Old) != `"old"` || string(first.New) != `"new"` {
+		t.Errorf("unexpected change %s: %s -> %s", first.Field, first.Old, first.New)
+	}
+	if second.Field != "transliteration" || string(second.Old) != "null" || string(second.New) != `"ya"` {
+		t.Errorf("unexpected change %s: %s -> %s", second.Field, second.Old, second.New)
+	}
+}
+
+func TestAuditLogUsecase_GetByID_InsertAndRedaction(t *testing.T) {
+	repo := &MockAuditLogRepository{
+		GetByIDFunc: func(ctx context.Context, id uint) (*entity.AuditLog, error) {
+			return &entity.AuditLog{
+				ID:        id,
+				Table:     "users",
+				Operation: entity.AuditOperationInsert,
+				NewData:   json.RawMessage(`{"id":3,"password_hash":"hash","last_login_at":null}`),
+			}, nil
+		},
+	}
+
+	uc := auditlog.NewAuditLogUsecase(repo, &MockLogger{})
+	detail, err := uc.GetByID(context.Background(), 1)
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	// last_login_at stays null, so only id and password_hash are reported
+	if len(detail.Changes) != 2 {
+		t.Fatalf("expected 2 changes, got %+v", detail.Changes)
+	}
+	if detail.Changes[1].Field != "password_hash" || string(detail.Changes[1].New) != `"[REDACTED]"` {
+		t.Errorf("expected redacted password hash, got %s", detail.Changes[1].New)
+	}
+}
+
+func TestAuditLogUsecase_GetByID_NotFound(t *testing.T) {
+	uc := auditlog.NewAuditLogUsecase(&MockAuditLogRepository{}, &MockLogger{})
+	_, err := uc.GetByID(context.Background(), 99)
+
+	if !errors.Is(err, auditlog.ErrAuditLogNotFound) {
+		t.Errorf("expected ErrAuditLogNotFound, got %v", err)
+	}
+}
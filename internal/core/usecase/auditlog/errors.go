@@ -0,0 +1,15 @@
+package auditlog
+
+import "ishari-backend/internal/core/domain"
+
+// Audit log domain errors
+var (
+	// ErrAuditLogNotFound indicates the audit log entry does not exist
+	ErrAuditLogNotFound = domain.NewNotFoundError("audit log entry not found", nil)
+
+	// ErrInvalidOperation indicates the operation filter is not INSERT, UPDATE or DELETE
+	ErrInvalidOperation = domain.NewInvalidInputError("operation must be INSERT, UPDATE or DELETE", nil)
+
+	// ErrInvalidDateRange indicates the range ends before it starts
+	ErrInvalidDateRange = domain.NewInvalidInputError("from must not be after to", nil)
+)
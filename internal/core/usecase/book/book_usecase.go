@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 
+	"ishari-backend/internal/core/domain"
 	"ishari-backend/internal/core/entity"
 	"ishari-backend/internal/core/port/repository"
 	portusecase "ishari-backend/internal/core/port/usecase"
@@ -62,6 +63,11 @@ func (uc *bookUseCase) EditBook(ctx context.Context, id int64, in portusecase.Cr
 		CoverImageURL: in.CoverImageURL,
 	}
 
+	// resized cover copies stay valid as long as the cover itself is unchanged
+	if existing, err := uc.repo.GetById(ctx, id); err == nil && existing != nil && domain.SameURL(existing.CoverImageURL, in.CoverImageURL) {
+		book.CoverImages = existing.CoverImages
+	}
+
 	if err := uc.repo.Edit(ctx, book); err != nil {
 		return nil, err
 	}
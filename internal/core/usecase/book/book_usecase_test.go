@@ -151,6 +151,35 @@ func TestBookUseCase_EditBook(t *testing.T) {
 	}
 }
 
+func TestBookUseCase_EditBook_KeepsCoverVariants(t *testing.T) {
+	cover := "http://files.test/books/1/cover.png"
+	variants := &entity.ImageVariants{Thumb: "thumb", Medium: "medium", Large: "large"}
+	mockRepo := &MockBookRepository{
+		GetByIdFunc: func(ctx context.Context, id int64) (*entity.Book, error) {
+			return &entity.Book{ID: 1, Title: "Book", CoverImageURL: &cover, CoverImages: variants}, nil
+		},
+	}
+	uc := bookusecase.NewBookUseCase(mockRepo)
+
+	sameCover := cover
+	book, err := uc.EditBook(context.Background(), 1, portusecase.CreateBookInput{Title: "Renamed", CoverImageURL: &sameCover})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if book.CoverImages != variants {
+		t.Error("expected cover variants to be kept when the cover is unchanged")
+	}
+
+	otherCover := "https://cdn.example.com/cover.jpg"
+	book, err = uc.EditBook(context.Background(), 1, portusecase.CreateBookInput{Title: "Renamed", CoverImageURL: &otherCover})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if book.CoverImages != nil {
+		t.Error("expected cover variants to be dropped when the cover changes")
+	}
+}
+
 func TestBookUseCase_DeleteBook(t *testing.T) {
 	mockRepo := &MockBookRepository{
 		DeleteFunc: func(ctx context.Context, id int64) error {
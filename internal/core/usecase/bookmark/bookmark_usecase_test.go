@@ -83,6 +83,22 @@ func (m *MockVerseRepository) GetById(ctx context.Context, id uint) (*entity.Ver
 	return nil, nil
 }
 
+func (m *MockVerseRepository) Reorder(ctx context.Context, chapterID uint, verseIDs []uint) error {
+	return nil
+}
+
+func (m *MockVerseRepository) Move(ctx context.Context, id, chapterID, position uint) error {
+	return nil
+}
+
+func (m *MockVerseRepository) VerseNumbers(ctx context.Context, chapterID uint) (map[uint]uint, error) {
+	return nil, nil
+}
+
+func (m *MockVerseRepository) Import(ctx context.Context, chapterID uint, verses []entity.VerseImport) error {
+	return nil
+}
+
 // MockLogger is a manual mock
 type MockLogger struct{}
 
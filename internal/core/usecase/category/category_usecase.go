@@ -0,0 +1,195 @@
+package category
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"ishari-backend/internal/core/domain"
+	"ishari-backend/internal/core/entity"
+	"ishari-backend/internal/core/port/logger"
+	"ishari-backend/internal/core/port/repository"
+	portuc "ishari-backend/internal/core/port/usecase"
+)
+
+var (
+	slugPattern   = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+	slugSeparator = regexp.MustCompile(`[^a-z0-9]+`)
+)
+
+type categoryUsecase struct {
+	categoryRepo repository.CategoryRepository
+	chapterRepo  repository.ChapterRepository
+	log          logger.Logger
+}
+
+func NewCategoryUsecase(categoryRepo repository.CategoryRepository, chapterRepo repository.ChapterRepository, log logger.Logger) portuc.CategoryUseCase {
+	return &categoryUsecase{
+		categoryRepo: categoryRepo,
+		chapterRepo:  chapterRepo,
+		log:          log,
+	}
+}
+
+// Create creates a new chapter category
+func (u *categoryUsecase) Create(ctx context.Context, input portuc.CreateCategoryInput) (*entity.Category, error) {
+	category := &entity.Category{
+		Slug:        strings.TrimSpace(input.Slug),
+		Name:        strings.TrimSpace(input.Name),
+		SortOrder:   input.SortOrder,
+		Description: input.Description,
+	}
+	if category.Slug == "" {
+		category.Slug = slugify(category.Name)
+	}
+
+	if err := u.validate(ctx, category); err != nil {
+		return nil, err
+	}
+
+	if err := u.categoryRepo.Create(ctx, category); err != nil {
+		u.log.Error("failed to create category", "error", err, "slug", category.Slug)
+		return nil, domain.NewInternalError("failed to create category", err)
+	}
+	return category, nil
+}
+
+// List returns every category in reading order
+func (u *categoryUsecase) List(ctx context.Context) ([]entity.Category, error) {
+	categories, err := u.categoryRepo.List(ctx)
+	if err != nil {
+		u.log.Error("failed to list categories", "error", err)
+		return nil, domain.NewInternalError("failed to list categories", err)
+	}
+	return categories, nil
+}
+
+// GetByID returns a category by ID
+func (u *categoryUsecase) GetByID(ctx context.Context, id uint) (*entity.Category, error) {
+	category, err := u.categoryRepo.GetByID(ctx, id)
+	if err != nil {
+		u.log.Error("failed to get category", "error", err, "category_id", id)
+		return nil, domain.NewInternalError("failed to get category", err)
+	}
+	if category == nil {
+		return nil, ErrCategoryNotFound
+	}
+	return category, nil
+}
+
+// Update modifies a category; chapters follow the change through their foreign key
+func (u *categoryUsecase) Update(ctx context.Context, id uint, input portuc.UpdateCategoryInput) (*entity.Category, error) {
+	category, err := u.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Slug != nil {
+		category.Slug = strings.TrimSpace(*input.Slug)
+	}
+	if input.Name != nil {
+		category.Name = strings.TrimSpace(*input.Name)
+	}
+	if input.SortOrder != nil {
+		category.SortOrder = *input.SortOrder
+	}
+	if input.Description != nil {
+		category.Description = input.Description
+	}
+
+	if err := u.validate(ctx, category); err != nil {
+		return nil, err
+	}
+
+	if err := u.categoryRepo.Update(ctx, category); err != nil {
+		u.log.Error("failed to update category", "error", err, "category_id", id)
+		return nil, domain.NewInternalError("failed to update category", err)
+	}
+	return category, nil
+}
+
+// Delete removes a category that no chapter belongs to anymore
+func (u *categoryUsecase) Delete(ctx context.Context, id uint) error {
+	if _, err := u.GetByID(ctx, id); err != nil {
+		return err
+	}
+
+	chapters, err := u.categoryRepo.CountChapters(ctx, id)
+	if err != nil {
+		u.log.Error("failed to count category chapters", "error", err, "category_id", id)
+		return domain.NewInternalError("failed to delete category", err)
+	}
+	if chapters > 0 {
+		return ErrCategoryInUse
+	}
+
+	if err := u.categoryRepo.Delete(ctx, id); err != nil {
+		u.log.Error("failed to delete category", "error", err, "category_id", id)
+		return domain.NewInternalError("failed to delete category", err)
+	}
+	return nil
+}
+
+// ListChapters returns the paginated chapters of a category across all books
+func (u *categoryUsecase) ListChapters(ctx context.Context, id uint, page, limit int) (*portuc.PaginatedResult[entity.Chapter], error) {
+	if _, err := u.GetByID(ctx, id); err != nil {
+		return nil, err
+	}
+
+	if page <= 0 {
+		page = 1
+	}
+	if limit <= 0 {
+		limit = 20
+	}
+	offset := (page - 1) * limit
+
+	chapters, total, err := u.chapterRepo.ListChaptersByCategory(ctx, id, offset, limit)
+	if err != nil {
+		u.log.Error("failed to list category chapters", "error", err, "category_id", id)
+		return nil, domain.NewInternalError("failed to list chapters", err)
+	}
+
+	totalPages := int(total) / limit
+	if int(total)%limit > 0 {
+		totalPages++
+	}
+	return &portuc.PaginatedResult[entity.Chapter]{
+		Data:       chapters,
+		Total:      total,
+		Page:       page,
+		Limit:      limit,
+		TotalPages: totalPages,
+	}, nil
+}
+
+// validate checks the name and slug and that no other category uses them. Categories
+// are few, so the check scans the full list.
+func (u *categoryUsecase) validate(ctx context.Context, category *entity.Category) error {
+	if category.Name == "" {
+		return ErrInvalidName
+	}
+	if !slugPattern.MatchString(category.Slug) {
+		return ErrInvalidSlug
+	}
+
+	existing, err := u.categoryRepo.List(ctx)
+	if err != nil {
+		u.log.Error("failed to list categories", "error", err)
+		return domain.NewInternalError("failed to validate category", err)
+	}
+	for _, other := range existing {
+		if other.ID == category.ID {
+			continue
+		}
+		if other.Slug == category.Slug || strings.EqualFold(other.Name, category.Name) {
+			return ErrDuplicateCategory
+		}
+	}
+	return nil
+}
+
+// slugify turns a display name such as "Syaraful Anam" into "syaraful-anam"
+func slugify(name string) string {
+	return strings.Trim(slugSeparator.ReplaceAllString(strings.ToLower(name), "-"), "-")
+}
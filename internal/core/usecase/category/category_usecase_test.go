@@ -0,0 +1,300 @@
+package category_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"ishari-backend/internal/core/entity"
+	portuc "ishari-backend/internal/core/port/usecase"
+	"ishari-backend/internal/core/usecase/category"
+)
+
+// MockCategoryRepository is a manual mock for CategoryRepository
+type MockCategoryRepository struct {
+	CreateFunc        func(ctx context.Context, category *entity.Category) error
+	ListFunc          func(ctx context.Context) ([]entity.Category, error)
+	GetByIDFunc       func(ctx context.Context, id uint) (*entity.Category, error)
+	UpdateFunc        func(ctx context.Context, category *entity.Category) error
+	DeleteFunc        func(ctx context.Context, id uint) error
+	CountChaptersFunc func(ctx context.Context, id uint) (int64, error)
+}
+
+func (m *MockCategoryRepository) Create(ctx context.Context, category *entity.Category) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, category)
+	}
+	return nil
+}
+
+func (m *MockCategoryRepository) List(ctx context.Context) ([]entity.Category, error) {
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx)
+	}
+	return nil, nil
+}
+
+func (m *MockCategoryRepository) GetByID(ctx context.Context, id uint) (*entity.Category, error) {
+	if m.GetByIDFunc != nil {
+		return m.GetByIDFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *MockCategoryRepository) Update(ctx context.Context, category *entity.Category) error {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, category)
+	}
+	return nil
+}
+
+func (m *MockCategoryRepository) Delete(ctx context.Context, id uint) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *MockCategoryRepository) CountChapters(ctx context.Context, id uint) (int64, error) {
+	if m.CountChaptersFunc != nil {
+		return m.CountChaptersFunc(ctx, id)
+	}
+	return 0, nil
+}
+
+// MockChapterRepository is a manual mock for ChapterRepository
+type MockChapterRepository struct {
+	ListChaptersByCategoryFunc func(ctx context.Context, categoryID uint, offset, limit int) ([]entity.Chapter, int64, error)
+}
+
+func (m *MockChapterRepository) CreateChapter(ctx context.Context, ch *entity.Chapter) error {
+	return nil
+}
+
+func (m *MockChapterRepository) ListChapters(ctx context.Context, offset, limit int, search string, bookID *uint, title string, category string) ([]entity.Chapter, int64, error) {
+	return nil, 0, nil
+}
+
+func (m *MockChapterRepository) GetChaptersByBookID(ctx context.Context, bookID uint) ([]entity.Chapter, int64, error) {
+	return nil, 0, nil
+}
+
+func (m *MockChapterRepository) ListChaptersByCategory(ctx context.Context, categoryID uint, offset, limit int) ([]entity.Chapter, int64, error) {
+	if m.ListChaptersByCategoryFunc != nil {
+		return m.ListChaptersByCategoryFunc(ctx, categoryID, offset, limit)
+	}
+	return nil, 0, nil
+}
+
+func (m *MockChapterRepository) GetChapterByID(ctx context.Context, id uint) (*entity.Chapter, error) {
+	return nil, nil
+}
+
+func (m *MockChapterRepository) UpdateChapter(ctx context.Context, ch *entity.Chapter) error {
+	return nil
+}
+
+func (m *MockChapterRepository) DeleteChapter(ctx context.Context, id uint) error {
+	return nil
+}
+
+func (m *MockChapterRepository) DeleteChapters(ctx context.Context, ids []uint) error {
+	return nil
+}
+
+func (m *MockChapterRepository) ReconcileVerseCounts(ctx context.Context) ([]entity.VerseCountCorrection, error) {
+	return nil, nil
+}
+
+func (m *MockChapterRepository) ReorderChapters(ctx context.Context, bookID uint, chapterIDs []uint) error {
+	return nil
+}
+
+func (m *MockChapterRepository) MoveChapter(ctx context.Context, id, bookID, position uint) error {
+	return nil
+}
+
+// MockLogger is a manual mock for Logger
+type MockLogger struct{}
+
+func (m *MockLogger) Info(msg string, fields ...any)  {}
+func (m *MockLogger) Error(msg string, fields ...any) {}
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+func existingCategories(ctx context.Context) ([]entity.Category, error) {
+	return []entity.Category{
+		{ID: 1, Slug: "diwan", Name: "Diwan", SortOrder: 1},
+		{ID: 2, Slug: "syaraful-anam", Name: "Syaraful Anam", SortOrder: 2},
+	}, nil
+}
+
+// ==================== Create Tests ====================
+
+func TestCategoryUsecase_Create_DerivesSlug(t *testing.T) {
+	var created *entity.Category
+	repo := &MockCategoryRepository{
+		ListFunc: existingCategories,
+		CreateFunc: func(ctx context.Context, c *entity.Category) error {
+			c.ID = 3
+			created = c
+			return nil
+		},
+	}
+
+	uc := category.NewCategoryUsecase(repo, &MockChapterRepository{}, &MockLogger{})
+	result, err := uc.Create(context.Background(), portuc.CreateCategoryInput{Name: " Qasidah Banjar ", SortOrder: 7})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if created == nil || result.ID != 3 {
+		t.Fatalf("expected category to be created, got %+v", result)
+	}
+	if result.Slug != "qasidah-banjar" || result.Name != "Qasidah Banjar" {
+		t.Errorf("expected slug 'qasidah-banjar' and trimmed name, got %q / %q", result.Slug, result.Name)
+	}
+}
+
+func TestCategoryUsecase_Create_Validation(t *testing.T) {
+	tests := []struct {
+		name  string
+		input portuc.CreateCategoryInput
+		want  error
+	}{
+		{"empty name", portuc.CreateCategoryInput{Slug: "x"}, category.ErrInvalidName},
+		{"invalid slug", portuc.CreateCategoryInput{Slug: "Bad Slug", Name: "Bad"}, category.ErrInvalidSlug},
+		{"duplicate slug", portuc.CreateCategoryInput{Slug: "diwan", Name: "Other"}, category.ErrDuplicateCategory},
+		{"duplicate name", portuc.CreateCategoryInput{Name: "syaraful anam", Slug: "sa"}, category.ErrDuplicateCategory},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &MockCategoryRepository{
+				ListFunc: existingCategories,
+				CreateFunc: func(ctx context.Context, c *entity.Category) error {
+					t.Fatal("Create should not be called")
+					return nil
+				},
+			}
+
+			uc := category.NewCategoryUsecase(repo, &MockChapterRepository{}, &MockLogger{})
+			_, err := uc.Create(context.Background(), tt.input)
+
+			if !errors.Is(err, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+// ==================== Update Tests ====================
+
+func TestCategoryUsecase_Update_KeepsOwnSlug(t *testing.T) {
+	repo := &MockCategoryRepository{
+		ListFunc: existingCategories,
+		GetByIDFunc: func(ctx context.Context, id uint) (*entity.Category, error) {
+			return &entity.Category{ID: 1, Slug: "diwan", Name: "Diwan", SortOrder: 1}, nil
+		},
+	}
+
+	uc := category.NewCategoryUsecase(repo, &MockChapterRepository{}, &MockLogger{})
+	result, err := uc.Update(context.Background(), 1, portuc.UpdateCategoryInput{
+		Name:        stringPtr("Diwan Ishari"),
+		Description: stringPtr("Opening poems"),
+	})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result.Name != "Diwan Ishari" || result.Slug != "diwan" {
+		t.Errorf("unexpected category %+v", result)
+	}
+}
+
+func TestCategoryUsecase_Update_NotFound(t *testing.T) {
+	uc := category.NewCategoryUsecase(&MockCategoryRepository{}, &MockChapterRepository{}, &MockLogger{})
+	_, err := uc.Update(context.Background(), 99, portuc.UpdateCategoryInput{})
+
+	if !errors.Is(err, category.ErrCategoryNotFound) {
+		t.Errorf("expected ErrCategoryNotFound, got %v", err)
+	}
+}
+
+// ==================== Delete Tests ====================
+
+func TestCategoryUsecase_Delete_InUse(t *testing.T) {
+	repo := &MockCategoryRepository{
+		GetByIDFunc: func(ctx context.Context, id uint) (*entity.Category, error) {
+			return &entity.Category{ID: id}, nil
+		},
+		CountChaptersFunc: func(ctx context.Context, id uint) (int64, error) {
+			return 4, nil
+		},
+		DeleteFunc: func(ctx context.Context, id uint) error {
+			t.Fatal("Delete should not be called")
+			return nil
+		},
+	}
+
+	uc := category.NewCategoryUsecase(repo, &MockChapterRepository{}, &MockLogger{})
+	err := uc.Delete(context.Background(), 1)
+
+	if !errors.Is(err, category.ErrCategoryInUse) {
+		t.Errorf("expected ErrCategoryInUse, got %v", err)
+	}
+}
+
+func TestCategoryUsecase_Delete_Success(t *testing.T) {
+	deleted := false
+	repo := &MockCategoryRepository{
+		GetByIDFunc: func(ctx context.Context, id uint) (*entity.Category, error) {
+			return &entity.Category{ID: id}, nil
+		},
+		DeleteFunc: func(ctx context.Context, id uint) error {
+			deleted = true
+			return nil
+		},
+	}
+
+	uc := category.NewCategoryUsecase(repo, &MockChapterRepository{}, &MockLogger{})
+	if err := uc.Delete(context.Background(), 1); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !deleted {
+		t.Error("expected category to be deleted")
+	}
+}
+
+// ==================== ListChapters Tests ====================
+
+func TestCategoryUsecase_ListChapters_FiltersByCategoryID(t *testing.T) {
+	repo := &MockCategoryRepository{
+		GetByIDFunc: func(ctx context.Context, id uint) (*entity.Category, error) {
+			return &entity.Category{ID: id, Slug: "muhud", Name: "Muhud"}, nil
+		},
+	}
+	chapterRepo := &MockChapterRepository{
+		ListChaptersByCategoryFunc: func(ctx context.Context, categoryID uint, offset, limit int) ([]entity.Chapter, int64, error) {
+			if categoryID != 3 {
+				t.Errorf("expected category 3, got %d", categoryID)
+			}
+			if offset != 10 || limit != 10 {
+				t.Errorf("expected offset 10 and limit 10, got %d and %d", offset, limit)
+			}
+			return []entity.Chapter{{ID: 5}}, 11, nil
+		},
+	}
+
+	uc := category.NewCategoryUsecase(repo, chapterRepo, &MockLogger{})
+	result, err := uc.ListChapters(context.Background(), 3, 2, 10)
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result.Total != 11 || result.TotalPages != 2 || len(result.Data) != 1 {
+		t.Errorf("unexpected result %+v", result)
+	}
+}
@@ -0,0 +1,21 @@
+package category
+
+import "ishari-backend/internal/core/domain"
+
+// Category-specific domain errors
+var (
+	// ErrCategoryNotFound indicates the category does not exist
+	ErrCategoryNotFound = domain.NewNotFoundError("category not found", nil)
+
+	// ErrInvalidName indicates the name is empty
+	ErrInvalidName = domain.NewInvalidInputError("name is required", nil)
+
+	// ErrInvalidSlug indicates the slug is not lowercase words joined by hyphens
+	ErrInvalidSlug = domain.NewInvalidInputError("slug must be lowercase letters and digits separated by hyphens", nil)
+
+	// ErrDuplicateCategory indicates another category already uses the slug or name
+	ErrDuplicateCategory = domain.NewConflictError("a category with this slug or name already exists", nil)
+
+	// ErrCategoryInUse indicates chapters still belong to the category
+	ErrCategoryInUse = domain.NewConflictError("category still has chapters", nil)
+)
@@ -2,6 +2,7 @@ package chapter
 
 import (
 	"context"
+	"errors"
 
 	"ishari-backend/internal/core/domain"
 	"ishari-backend/internal/core/entity"
@@ -11,16 +12,18 @@ import (
 )
 
 type chapterUsecase struct {
-	chapterRepo repository.ChapterRepository
-	bookRepo    repository.BookRepository
-	log         logger.Logger
+	chapterRepo  repository.ChapterRepository
+	bookRepo     repository.BookRepository
+	categoryRepo repository.CategoryRepository
+	log          logger.Logger
 }
 
-func NewChapterUsecase(chapterRepo repository.ChapterRepository, bookRepo repository.BookRepository, log logger.Logger) portuc.ChapterUsecase {
+func NewChapterUsecase(chapterRepo repository.ChapterRepository, bookRepo repository.BookRepository, categoryRepo repository.CategoryRepository, log logger.Logger) portuc.ChapterUsecase {
 	return &chapterUsecase{
-		chapterRepo: chapterRepo,
-		bookRepo:    bookRepo,
-		log:         log,
+		chapterRepo:  chapterRepo,
+		bookRepo:     bookRepo,
+		categoryRepo: categoryRepo,
+		log:          log,
 	}
 }
 
@@ -31,15 +34,19 @@ func (u *chapterUsecase) Create(ctx context.Context, input portuc.CreateChapterI
 	if err := u.validateCreateChapter(ctx, input); err != nil {
 		return nil, err
 	}
+	category, err := u.getCategory(ctx, input.CategoryID)
+	if err != nil {
+		return nil, err
+	}
 
 	// create chapter entity
 	chapter := &entity.Chapter{
 		BookID:        input.BookID,
 		ChapterNumber: input.ChapterNumber,
 		Title:         input.Title,
-		Category:      input.Category,
+		CategoryID:    category.ID,
+		Category:      category,
 		Description:   input.Description,
-		TotalVerses:   input.TotalVerses,
 	}
 
 	// Persist chapter
@@ -61,12 +68,6 @@ func (u *chapterUsecase) validateCreateChapter(ctx context.Context, input portuc
 	if err := u.validateTitle(input.Title); err != nil {
 		return err
 	}
-	if err := u.validateCategory(input.Category); err != nil {
-		return err
-	}
-	if err := u.validateTotalVerses(input.TotalVerses); err != nil {
-		return err
-	}
 	return nil
 }
 
@@ -99,20 +100,20 @@ func (u *chapterUsecase) validateTitle(title string) error {
 	return nil
 }
 
-// validateCategory checks if the category is valid
-func (u *chapterUsecase) validateCategory(category string) error {
-	if category == "" {
-		return ErrInvalidCategory
+// getCategory returns the category a chapter is assigned to, checking that it exists
+func (u *chapterUsecase) getCategory(ctx context.Context, categoryID uint) (*entity.Category, error) {
+	if categoryID == 0 {
+		return nil, ErrInvalidCategory
 	}
-	return nil
-}
-
-// validateTotalVerses checks if the total verses is valid
-func (u *chapterUsecase) validateTotalVerses(totalVerses uint) error {
-	if totalVerses < 1 {
-		return ErrInvalidTotalVerses
+	category, err := u.categoryRepo.GetByID(ctx, categoryID)
+	if err != nil {
+		u.log.Error("failed to get category", "error", err, "category_id", categoryID)
+		return nil, domain.NewInternalError("failed to validate category", err)
 	}
-	return nil
+	if category == nil {
+		return nil, ErrCategoryNotFound
+	}
+	return category, nil
 }
 
 // List returns paginated chapters with optional search
@@ -203,18 +204,13 @@ func (u *chapterUsecase) Update(ctx context.Context, id uint, input portuc.Updat
 		chapter.Title = *input.Title
 	}
 
-	if input.Category != nil {
-		if err := u.validateCategory(*input.Category); err != nil {
-			return nil, err
-		}
-		chapter.Category = *input.Category
-	}
-
-	if input.TotalVerses != nil {
-		if err := u.validateTotalVerses(*input.TotalVerses); err != nil {
+	if input.CategoryID != nil {
+		category, err := u.getCategory(ctx, *input.CategoryID)
+		if err != nil {
 			return nil, err
 		}
-		chapter.TotalVerses = *input.TotalVerses
+		chapter.CategoryID = category.ID
+		chapter.Category = category
 	}
 
 	if input.Description != nil {
@@ -259,3 +255,68 @@ func (u *chapterUsecase) BulkDelete(ctx context.Context, ids []uint) error {
 
 	return nil
 }
+
+// ReconcileVerseCounts recomputes total_verses of every chapter from its live verses and
+// returns the chapters whose stored count was wrong
+func (u *chapterUsecase) ReconcileVerseCounts(ctx context.Context) ([]entity.VerseCountCorrection, error) {
+	corrections, err := u.chapterRepo.ReconcileVerseCounts(ctx)
+	if err != nil {
+		u.log.Error("failed to reconcile chapter verse counts", "error", err)
+		return nil, domain.NewInternalError("failed to reconcile verse counts", err)
+	}
+	if len(corrections) > 0 {
+		u.log.Info("corrected chapter verse counts", "chapters", len(corrections))
+	}
+	return corrections, nil
+}
+
+// Reorder renumbers the chapters of a book 1..n in the given order
+func (u *chapterUsecase) Reorder(ctx context.Context, bookID uint, chapterIDs []uint) error {
+	if len(chapterIDs) == 0 {
+		return ErrEmptyOrder
+	}
+	if err := u.validateBookId(ctx, bookID); err != nil {
+		return err
+	}
+
+	if err := u.chapterRepo.ReorderChapters(ctx, bookID, chapterIDs); err != nil {
+		if errors.Is(err, repository.ErrOrderMismatch) {
+			return ErrOrderMismatch
+		}
+		u.log.Error("failed to reorder chapters", "error", err, "book_id", bookID)
+		return domain.NewInternalError("failed to reorder chapters", err)
+	}
+	return nil
+}
+
+// Move moves a chapter to a new position in its book or in another book, shifting the
+// chapters around it
+func (u *chapterUsecase) Move(ctx context.Context, id uint, input portuc.MoveChapterInput) (*entity.Chapter, error) {
+	chapter, err := u.chapterRepo.GetChapterByID(ctx, id)
+	if err != nil {
+		u.log.Error("failed to get chapter for move", "error", err, "chapter_id", id)
+		return nil, ErrChapterNotFound
+	}
+
+	bookID := chapter.BookID
+	if input.BookID != nil {
+		if err := u.validateBookId(ctx, *input.BookID); err != nil {
+			return nil, err
+		}
+		bookID = *input.BookID
+	}
+	var position uint
+	if input.Position != nil {
+		if *input.Position == 0 {
+			return nil, ErrInvalidPosition
+		}
+		position = *input.Position
+	}
+
+	if err := u.chapterRepo.MoveChapter(ctx, id, bookID, position); err != nil {
+		u.log.Error("failed to move chapter", "error", err, "chapter_id", id, "book_id", bookID)
+		return nil, domain.NewInternalError("failed to move chapter", err)
+	}
+
+	return u.GetByID(ctx, id)
+}
@@ -7,19 +7,23 @@ import (
 	"time"
 
 	"ishari-backend/internal/core/entity"
+	"ishari-backend/internal/core/port/repository"
 	portuc "ishari-backend/internal/core/port/usecase"
 	"ishari-backend/internal/core/usecase/chapter"
 )
 
 // MockChapterRepository is a manual mock for ChapterRepository
 type MockChapterRepository struct {
-	CreateChapterFunc       func(ctx context.Context, ch *entity.Chapter) error
-	ListChaptersFunc        func(ctx context.Context, offset, limit int, search string, bookID *uint, title string, category string) ([]entity.Chapter, int64, error)
-	GetChaptersByBookIDFunc func(ctx context.Context, bookID uint) ([]entity.Chapter, int64, error)
-	GetChapterByIDFunc      func(ctx context.Context, id uint) (*entity.Chapter, error)
-	UpdateChapterFunc       func(ctx context.Context, ch *entity.Chapter) error
-	DeleteChapterFunc       func(ctx context.Context, id uint) error
-	DeleteChaptersFunc      func(ctx context.Context, ids []uint) error
+	CreateChapterFunc        func(ctx context.Context, ch *entity.Chapter) error
+	ListChaptersFunc         func(ctx context.Context, offset, limit int, search string, bookID *uint, title string, category string) ([]entity.Chapter, int64, error)
+	GetChaptersByBookIDFunc  func(ctx context.Context, bookID uint) ([]entity.Chapter, int64, error)
+	GetChapterByIDFunc       func(ctx context.Context, id uint) (*entity.Chapter, error)
+	UpdateChapterFunc        func(ctx context.Context, ch *entity.Chapter) error
+	DeleteChapterFunc        func(ctx context.Context, id uint) error
+	DeleteChaptersFunc       func(ctx context.Context, ids []uint) error
+	ReconcileVerseCountsFunc func(ctx context.Context) ([]entity.VerseCountCorrection, error)
+	ReorderChaptersFunc      func(ctx context.Context, bookID uint, chapterIDs []uint) error
+	MoveChapterFunc          func(ctx context.Context, id, bookID, position uint) error
 }
 
 func (m *MockChapterRepository) CreateChapter(ctx context.Context, ch *entity.Chapter) error {
@@ -43,6 +47,10 @@ func (m *MockChapterRepository) GetChaptersByBookID(ctx context.Context, bookID
 	return nil, 0, nil
 }
 
+func (m *MockChapterRepository) ListChaptersByCategory(ctx context.Context, categoryID uint, offset, limit int) ([]entity.Chapter, int64, error) {
+	return nil, 0, nil
+}
+
 func (m *MockChapterRepository) GetChapterByID(ctx context.Context, id uint) (*entity.Chapter, error) {
 	if m.GetChapterByIDFunc != nil {
 		return m.GetChapterByIDFunc(ctx, id)
@@ -71,6 +79,27 @@ func (m *MockChapterRepository) DeleteChapters(ctx context.Context, ids []uint)
 	return nil
 }
 
+func (m *MockChapterRepository) ReconcileVerseCounts(ctx context.Context) ([]entity.VerseCountCorrection, error) {
+	if m.ReconcileVerseCountsFunc != nil {
+		return m.ReconcileVerseCountsFunc(ctx)
+	}
+	return nil, nil
+}
+
+func (m *MockChapterRepository) ReorderChapters(ctx context.Context, bookID uint, chapterIDs []uint) error {
+	if m.ReorderChaptersFunc != nil {
+		return m.ReorderChaptersFunc(ctx, bookID, chapterIDs)
+	}
+	return nil
+}
+
+func (m *MockChapterRepository) MoveChapter(ctx context.Context, id, bookID, position uint) error {
+	if m.MoveChapterFunc != nil {
+		return m.MoveChapterFunc(ctx, id, bookID, position)
+	}
+	return nil
+}
+
 // MockBookRepository is a manual mock for BookRepository
 type MockBookRepository struct {
 	GetByIdFunc func(ctx context.Context, id int64) (*entity.Book, error)
@@ -115,6 +144,67 @@ func (m *MockBookRepository) Delete(ctx context.Context, id int64) error {
 	return nil
 }
 
+// MockCategoryRepository is a manual mock for CategoryRepository
+type MockCategoryRepository struct {
+	CreateFunc        func(ctx context.Context, category *entity.Category) error
+	ListFunc          func(ctx context.Context) ([]entity.Category, error)
+	GetByIDFunc       func(ctx context.Context, id uint) (*entity.Category, error)
+	UpdateFunc        func(ctx context.Context, category *entity.Category) error
+	DeleteFunc        func(ctx context.Context, id uint) error
+	CountChaptersFunc func(ctx context.Context, id uint) (int64, error)
+}
+
+// newMockCategoryRepository returns a category repository in which every ID exists
+func newMockCategoryRepository() *MockCategoryRepository {
+	return &MockCategoryRepository{
+		GetByIDFunc: func(ctx context.Context, id uint) (*entity.Category, error) {
+			return &entity.Category{ID: id, Slug: "diwan", Name: "Diwan"}, nil
+		},
+	}
+}
+
+func (m *MockCategoryRepository) Create(ctx context.Context, category *entity.Category) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, category)
+	}
+	return nil
+}
+
+func (m *MockCategoryRepository) List(ctx context.Context) ([]entity.Category, error) {
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx)
+	}
+	return nil, nil
+}
+
+func (m *MockCategoryRepository) GetByID(ctx context.Context, id uint) (*entity.Category, error) {
+	if m.GetByIDFunc != nil {
+		return m.GetByIDFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *MockCategoryRepository) Update(ctx context.Context, category *entity.Category) error {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, category)
+	}
+	return nil
+}
+
+func (m *MockCategoryRepository) Delete(ctx context.Context, id uint) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *MockCategoryRepository) CountChapters(ctx context.Context, id uint) (int64, error) {
+	if m.CountChaptersFunc != nil {
+		return m.CountChaptersFunc(ctx, id)
+	}
+	return 0, nil
+}
+
 // MockLogger is a manual mock for Logger
 type MockLogger struct{}
 
@@ -148,13 +238,12 @@ func TestChapterUsecase_Create_Success(t *testing.T) {
 	}
 	mockLogger := &MockLogger{}
 
-	uc := chapter.NewChapterUsecase(mockChapterRepo, mockBookRepo, mockLogger)
+	uc := chapter.NewChapterUsecase(mockChapterRepo, mockBookRepo, newMockCategoryRepository(), mockLogger)
 	input := portuc.CreateChapterInput{
 		BookID:        1,
 		ChapterNumber: 1,
 		Title:         "Genesis",
-		Category:      "Old Testament",
-		TotalVerses:   31,
+		CategoryID:    1,
 		Description:   stringPtr("First chapter"),
 	}
 
@@ -180,13 +269,12 @@ func TestChapterUsecase_Create_BookNotFound(t *testing.T) {
 	}
 	mockLogger := &MockLogger{}
 
-	uc := chapter.NewChapterUsecase(mockChapterRepo, mockBookRepo, mockLogger)
+	uc := chapter.NewChapterUsecase(mockChapterRepo, mockBookRepo, newMockCategoryRepository(), mockLogger)
 	input := portuc.CreateChapterInput{
 		BookID:        999,
 		ChapterNumber: 1,
 		Title:         "Test",
-		Category:      "Test",
-		TotalVerses:   1,
+		CategoryID:    1,
 	}
 
 	_, err := uc.Create(context.Background(), input)
@@ -205,13 +293,12 @@ func TestChapterUsecase_Create_InvalidChapterNumber(t *testing.T) {
 	}
 	mockLogger := &MockLogger{}
 
-	uc := chapter.NewChapterUsecase(mockChapterRepo, mockBookRepo, mockLogger)
+	uc := chapter.NewChapterUsecase(mockChapterRepo, mockBookRepo, newMockCategoryRepository(), mockLogger)
 	input := portuc.CreateChapterInput{
 		BookID:        1,
 		ChapterNumber: 0, // Invalid: must be > 0
 		Title:         "Test",
-		Category:      "Test",
-		TotalVerses:   1,
+		CategoryID:    1,
 	}
 
 	_, err := uc.Create(context.Background(), input)
@@ -230,13 +317,12 @@ func TestChapterUsecase_Create_EmptyTitle(t *testing.T) {
 	}
 	mockLogger := &MockLogger{}
 
-	uc := chapter.NewChapterUsecase(mockChapterRepo, mockBookRepo, mockLogger)
+	uc := chapter.NewChapterUsecase(mockChapterRepo, mockBookRepo, newMockCategoryRepository(), mockLogger)
 	input := portuc.CreateChapterInput{
 		BookID:        1,
 		ChapterNumber: 1,
 		Title:         "", // Invalid: empty
-		Category:      "Test",
-		TotalVerses:   1,
+		CategoryID:    1,
 	}
 
 	_, err := uc.Create(context.Background(), input)
@@ -255,13 +341,12 @@ func TestChapterUsecase_Create_EmptyCategory(t *testing.T) {
 	}
 	mockLogger := &MockLogger{}
 
-	uc := chapter.NewChapterUsecase(mockChapterRepo, mockBookRepo, mockLogger)
+	uc := chapter.NewChapterUsecase(mockChapterRepo, mockBookRepo, newMockCategoryRepository(), mockLogger)
 	input := portuc.CreateChapterInput{
 		BookID:        1,
 		ChapterNumber: 1,
 		Title:         "Test",
-		Category:      "", // Invalid: empty
-		TotalVerses:   1,
+		CategoryID:    0, // Invalid: missing
 	}
 
 	_, err := uc.Create(context.Background(), input)
@@ -271,28 +356,49 @@ func TestChapterUsecase_Create_EmptyCategory(t *testing.T) {
 	}
 }
 
-func TestChapterUsecase_Create_InvalidTotalVerses(t *testing.T) {
-	mockChapterRepo := &MockChapterRepository{}
+func TestChapterUsecase_Create_CategoryNotFound(t *testing.T) {
 	mockBookRepo := &MockBookRepository{
 		GetByIdFunc: func(ctx context.Context, id int64) (*entity.Book, error) {
 			return &entity.Book{ID: 1}, nil
 		},
 	}
-	mockLogger := &MockLogger{}
 
-	uc := chapter.NewChapterUsecase(mockChapterRepo, mockBookRepo, mockLogger)
-	input := portuc.CreateChapterInput{
+	uc := chapter.NewChapterUsecase(&MockChapterRepository{}, mockBookRepo, &MockCategoryRepository{}, &MockLogger{})
+	_, err := uc.Create(context.Background(), portuc.CreateChapterInput{
 		BookID:        1,
 		ChapterNumber: 1,
 		Title:         "Test",
-		Category:      "Test",
-		TotalVerses:   0, // Invalid: must be > 0
+		CategoryID:    99,
+	})
+
+	if !errors.Is(err, chapter.ErrCategoryNotFound) {
+		t.Errorf("expected ErrCategoryNotFound, got %v", err)
 	}
+}
 
-	_, err := uc.Create(context.Background(), input)
+func TestChapterUsecase_Update_Category(t *testing.T) {
+	var saved *entity.Chapter
+	mockChapterRepo := &MockChapterRepository{
+		GetChapterByIDFunc: func(ctx context.Context, id uint) (*entity.Chapter, error) {
+			return &entity.Chapter{ID: 1, BookID: 1, Title: "Test", CategoryID: 1}, nil
+		},
+		UpdateChapterFunc: func(ctx context.Context, ch *entity.Chapter) error {
+			saved = ch
+			return nil
+		},
+	}
 
-	if err == nil {
-		t.Error("expected error for invalid total verses, got nil")
+	uc := chapter.NewChapterUsecase(mockChapterRepo, &MockBookRepository{}, newMockCategoryRepository(), &MockLogger{})
+	result, err := uc.Update(context.Background(), 1, portuc.UpdateChapterInput{CategoryID: uintPtr(3)})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if saved == nil || saved.CategoryID != 3 {
+		t.Errorf("expected category 3 to be saved, got %+v", saved)
+	}
+	if result.Category == nil || result.Category.ID != 3 {
+		t.Errorf("expected the category to be attached, got %+v", result.Category)
 	}
 }
 
@@ -309,13 +415,12 @@ func TestChapterUsecase_Create_RepositoryError(t *testing.T) {
 	}
 	mockLogger := &MockLogger{}
 
-	uc := chapter.NewChapterUsecase(mockChapterRepo, mockBookRepo, mockLogger)
+	uc := chapter.NewChapterUsecase(mockChapterRepo, mockBookRepo, newMockCategoryRepository(), mockLogger)
 	input := portuc.CreateChapterInput{
 		BookID:        1,
 		ChapterNumber: 1,
 		Title:         "Test",
-		Category:      "Test",
-		TotalVerses:   1,
+		CategoryID:    1,
 	}
 
 	_, err := uc.Create(context.Background(), input)
@@ -339,7 +444,7 @@ func TestChapterUsecase_List_Success(t *testing.T) {
 	mockBookRepo := &MockBookRepository{}
 	mockLogger := &MockLogger{}
 
-	uc := chapter.NewChapterUsecase(mockChapterRepo, mockBookRepo, mockLogger)
+	uc := chapter.NewChapterUsecase(mockChapterRepo, mockBookRepo, newMockCategoryRepository(), mockLogger)
 	params := portuc.ListChapterInput{
 		Page:  1,
 		Limit: 20,
@@ -378,7 +483,7 @@ func TestChapterUsecase_List_WithFilters(t *testing.T) {
 	mockBookRepo := &MockBookRepository{}
 	mockLogger := &MockLogger{}
 
-	uc := chapter.NewChapterUsecase(mockChapterRepo, mockBookRepo, mockLogger)
+	uc := chapter.NewChapterUsecase(mockChapterRepo, mockBookRepo, newMockCategoryRepository(), mockLogger)
 	id := uint(1)
 	params := portuc.ListChapterInput{
 		Page:     1,
@@ -407,7 +512,7 @@ func TestChapterUsecase_List_DefaultValues(t *testing.T) {
 	mockBookRepo := &MockBookRepository{}
 	mockLogger := &MockLogger{}
 
-	uc := chapter.NewChapterUsecase(mockChapterRepo, mockBookRepo, mockLogger)
+	uc := chapter.NewChapterUsecase(mockChapterRepo, mockBookRepo, newMockCategoryRepository(), mockLogger)
 	params := portuc.ListChapterInput{
 		Page:  0,  // Should default to 1
 		Limit: -1, // Should default to 20
@@ -435,7 +540,7 @@ func TestChapterUsecase_List_RepositoryError(t *testing.T) {
 	mockBookRepo := &MockBookRepository{}
 	mockLogger := &MockLogger{}
 
-	uc := chapter.NewChapterUsecase(mockChapterRepo, mockBookRepo, mockLogger)
+	uc := chapter.NewChapterUsecase(mockChapterRepo, mockBookRepo, newMockCategoryRepository(), mockLogger)
 	params := portuc.ListChapterInput{Page: 1, Limit: 20}
 
 	_, err := uc.List(context.Background(), params)
@@ -456,7 +561,7 @@ func TestChapterUsecase_GetByID_Success(t *testing.T) {
 	mockBookRepo := &MockBookRepository{}
 	mockLogger := &MockLogger{}
 
-	uc := chapter.NewChapterUsecase(mockChapterRepo, mockBookRepo, mockLogger)
+	uc := chapter.NewChapterUsecase(mockChapterRepo, mockBookRepo, newMockCategoryRepository(), mockLogger)
 
 	result, err := uc.GetByID(context.Background(), 1)
 
@@ -477,7 +582,7 @@ func TestChapterUsecase_GetByID_NotFound(t *testing.T) {
 	mockBookRepo := &MockBookRepository{}
 	mockLogger := &MockLogger{}
 
-	uc := chapter.NewChapterUsecase(mockChapterRepo, mockBookRepo, mockLogger)
+	uc := chapter.NewChapterUsecase(mockChapterRepo, mockBookRepo, newMockCategoryRepository(), mockLogger)
 
 	_, err := uc.GetByID(context.Background(), 999)
 
@@ -500,7 +605,7 @@ func TestChapterUsecase_GetByBookID_Success(t *testing.T) {
 	mockBookRepo := &MockBookRepository{}
 	mockLogger := &MockLogger{}
 
-	uc := chapter.NewChapterUsecase(mockChapterRepo, mockBookRepo, mockLogger)
+	uc := chapter.NewChapterUsecase(mockChapterRepo, mockBookRepo, newMockCategoryRepository(), mockLogger)
 
 	result, err := uc.GetByBookID(context.Background(), 1)
 
@@ -521,7 +626,7 @@ func TestChapterUsecase_GetByBookID_RepositoryError(t *testing.T) {
 	mockBookRepo := &MockBookRepository{}
 	mockLogger := &MockLogger{}
 
-	uc := chapter.NewChapterUsecase(mockChapterRepo, mockBookRepo, mockLogger)
+	uc := chapter.NewChapterUsecase(mockChapterRepo, mockBookRepo, newMockCategoryRepository(), mockLogger)
 
 	_, err := uc.GetByBookID(context.Background(), 1)
 
@@ -540,7 +645,7 @@ func TestChapterUsecase_Update_Success(t *testing.T) {
 				BookID:        1,
 				ChapterNumber: 1,
 				Title:         "Original Title",
-				Category:      "Test",
+				CategoryID:    1,
 				TotalVerses:   10,
 			}, nil
 		},
@@ -552,7 +657,7 @@ func TestChapterUsecase_Update_Success(t *testing.T) {
 	mockBookRepo := &MockBookRepository{}
 	mockLogger := &MockLogger{}
 
-	uc := chapter.NewChapterUsecase(mockChapterRepo, mockBookRepo, mockLogger)
+	uc := chapter.NewChapterUsecase(mockChapterRepo, mockBookRepo, newMockCategoryRepository(), mockLogger)
 	newTitle := "Updated Title"
 	input := portuc.UpdateChapterInput{
 		Title: &newTitle,
@@ -577,7 +682,7 @@ func TestChapterUsecase_Update_ChapterNotFound(t *testing.T) {
 	mockBookRepo := &MockBookRepository{}
 	mockLogger := &MockLogger{}
 
-	uc := chapter.NewChapterUsecase(mockChapterRepo, mockBookRepo, mockLogger)
+	uc := chapter.NewChapterUsecase(mockChapterRepo, mockBookRepo, newMockCategoryRepository(), mockLogger)
 	input := portuc.UpdateChapterInput{}
 
 	_, err := uc.Update(context.Background(), 999, input)
@@ -600,7 +705,7 @@ func TestChapterUsecase_Update_BookNotFound(t *testing.T) {
 	}
 	mockLogger := &MockLogger{}
 
-	uc := chapter.NewChapterUsecase(mockChapterRepo, mockBookRepo, mockLogger)
+	uc := chapter.NewChapterUsecase(mockChapterRepo, mockBookRepo, newMockCategoryRepository(), mockLogger)
 	newBookID := uint(999)
 	input := portuc.UpdateChapterInput{
 		BookID: &newBookID,
@@ -616,13 +721,13 @@ func TestChapterUsecase_Update_BookNotFound(t *testing.T) {
 func TestChapterUsecase_Update_ValidationError(t *testing.T) {
 	mockChapterRepo := &MockChapterRepository{
 		GetChapterByIDFunc: func(ctx context.Context, id uint) (*entity.Chapter, error) {
-			return &entity.Chapter{ID: 1, BookID: 1, Title: "Test", Category: "Test", TotalVerses: 1}, nil
+			return &entity.Chapter{ID: 1, BookID: 1, Title: "Test", CategoryID: 1, TotalVerses: 1}, nil
 		},
 	}
 	mockBookRepo := &MockBookRepository{}
 	mockLogger := &MockLogger{}
 
-	uc := chapter.NewChapterUsecase(mockChapterRepo, mockBookRepo, mockLogger)
+	uc := chapter.NewChapterUsecase(mockChapterRepo, mockBookRepo, newMockCategoryRepository(), mockLogger)
 	emptyTitle := ""
 	input := portuc.UpdateChapterInput{
 		Title: &emptyTitle, // Invalid: empty
@@ -649,7 +754,7 @@ func TestChapterUsecase_Delete_Success(t *testing.T) {
 	mockBookRepo := &MockBookRepository{}
 	mockLogger := &MockLogger{}
 
-	uc := chapter.NewChapterUsecase(mockChapterRepo, mockBookRepo, mockLogger)
+	uc := chapter.NewChapterUsecase(mockChapterRepo, mockBookRepo, newMockCategoryRepository(), mockLogger)
 
 	err := uc.Delete(context.Background(), 1)
 
@@ -667,7 +772,7 @@ func TestChapterUsecase_Delete_NotFound(t *testing.T) {
 	mockBookRepo := &MockBookRepository{}
 	mockLogger := &MockLogger{}
 
-	uc := chapter.NewChapterUsecase(mockChapterRepo, mockBookRepo, mockLogger)
+	uc := chapter.NewChapterUsecase(mockChapterRepo, mockBookRepo, newMockCategoryRepository(), mockLogger)
 
 	err := uc.Delete(context.Background(), 999)
 
@@ -688,7 +793,7 @@ func TestChapterUsecase_Delete_RepositoryError(t *testing.T) {
 	mockBookRepo := &MockBookRepository{}
 	mockLogger := &MockLogger{}
 
-	uc := chapter.NewChapterUsecase(mockChapterRepo, mockBookRepo, mockLogger)
+	uc := chapter.NewChapterUsecase(mockChapterRepo, mockBookRepo, newMockCategoryRepository(), mockLogger)
 
 	err := uc.Delete(context.Background(), 1)
 
@@ -709,7 +814,7 @@ func TestChapterUsecase_BulkDelete_Success(t *testing.T) {
 	mockBookRepo := &MockBookRepository{}
 	mockLogger := &MockLogger{}
 
-	uc := chapter.NewChapterUsecase(mockChapterRepo, mockBookRepo, mockLogger)
+	uc := chapter.NewChapterUsecase(mockChapterRepo, mockBookRepo, newMockCategoryRepository(), mockLogger)
 
 	err := uc.BulkDelete(context.Background(), []uint{1, 2})
 
@@ -728,7 +833,7 @@ func TestChapterUsecase_BulkDelete_EmptyIDs(t *testing.T) {
 	mockBookRepo := &MockBookRepository{}
 	mockLogger := &MockLogger{}
 
-	uc := chapter.NewChapterUsecase(mockChapterRepo, mockBookRepo, mockLogger)
+	uc := chapter.NewChapterUsecase(mockChapterRepo, mockBookRepo, newMockCategoryRepository(), mockLogger)
 
 	err := uc.BulkDelete(context.Background(), []uint{})
 
@@ -746,7 +851,7 @@ func TestChapterUsecase_BulkDelete_RepositoryError(t *testing.T) {
 	mockBookRepo := &MockBookRepository{}
 	mockLogger := &MockLogger{}
 
-	uc := chapter.NewChapterUsecase(mockChapterRepo, mockBookRepo, mockLogger)
+	uc := chapter.NewChapterUsecase(mockChapterRepo, mockBookRepo, newMockCategoryRepository(), mockLogger)
 
 	err := uc.BulkDelete(context.Background(), []uint{1, 2})
 
@@ -754,3 +859,133 @@ func TestChapterUsecase_BulkDelete_RepositoryError(t *testing.T) {
 		t.Error("expected error from repository, got nil")
 	}
 }
+
+// ==================== Reconcile Verse Counts Tests ====================
+
+func TestChapterUsecase_ReconcileVerseCounts_Success(t *testing.T) {
+	mockChapterRepo := &MockChapterRepository{
+		ReconcileVerseCountsFunc: func(ctx context.Context) ([]entity.VerseCountCorrection, error) {
+			return []entity.VerseCountCorrection{
+				{ChapterID: 4, BookID: 1, ChapterNumber: 2, Title: "Asyraqal", StoredTotal: 12, ActualTotal: 10},
+			}, nil
+		},
+	}
+	uc := chapter.NewChapterUsecase(mockChapterRepo, &MockBookRepository{}, newMockCategoryRepository(), &MockLogger{})
+
+	result, err := uc.ReconcileVerseCounts(context.Background())
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(result) != 1 || result[0].ChapterID != 4 || result[0].ActualTotal != 10 {
+		t.Errorf("expected correction for chapter 4, got %+v", result)
+	}
+}
+
+func TestChapterUsecase_ReconcileVerseCounts_RepositoryError(t *testing.T) {
+	mockChapterRepo := &MockChapterRepository{
+		ReconcileVerseCountsFunc: func(ctx context.Context) ([]entity.VerseCountCorrection, error) {
+			return nil, errors.New("database error")
+		},
+	}
+	uc := chapter.NewChapterUsecase(mockChapterRepo, &MockBookRepository{}, newMockCategoryRepository(), &MockLogger{})
+
+	if _, err := uc.ReconcileVerseCounts(context.Background()); err == nil {
+		t.Error("expected error from repository, got nil")
+	}
+}
+
+// ==================== Reorder / Move Tests ====================
+
+func TestChapterUsecase_Reorder_Success(t *testing.T) {
+	var gotBook uint
+	var gotIDs []uint
+	mockChapterRepo := &MockChapterRepository{
+		ReorderChaptersFunc: func(ctx context.Context, bookID uint, chapterIDs []uint) error {
+			gotBook, gotIDs = bookID, chapterIDs
+			return nil
+		},
+	}
+	mockBookRepo := &MockBookRepository{
+		GetByIdFunc: func(ctx context.Context, id int64) (*entity.Book, error) {
+			return &entity.Book{ID: int(id)}, nil
+		},
+	}
+	uc := chapter.NewChapterUsecase(mockChapterRepo, mockBookRepo, newMockCategoryRepository(), &MockLogger{})
+
+	if err := uc.Reorder(context.Background(), 1, []uint{3, 1, 2}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if gotBook != 1 || len(gotIDs) != 3 || gotIDs[0] != 3 {
+		t.Errorf("expected book 1 reordered as [3 1 2], got %d %v", gotBook, gotIDs)
+	}
+}
+
+func TestChapterUsecase_Reorder_Mismatch(t *testing.T) {
+	mockChapterRepo := &MockChapterRepository{
+		ReorderChaptersFunc: func(ctx context.Context, bookID uint, chapterIDs []uint) error {
+			return repository.ErrOrderMismatch
+		},
+	}
+	mockBookRepo := &MockBookRepository{
+		GetByIdFunc: func(ctx context.Context, id int64) (*entity.Book, error) {
+			return &entity.Book{ID: int(id)}, nil
+		},
+	}
+	uc := chapter.NewChapterUsecase(mockChapterRepo, mockBookRepo, newMockCategoryRepository(), &MockLogger{})
+
+	if err := uc.Reorder(context.Background(), 1, []uint{3, 1}); !errors.Is(err, chapter.ErrOrderMismatch) {
+		t.Errorf("expected ErrOrderMismatch, got %v", err)
+	}
+}
+
+func TestChapterUsecase_Reorder_BookNotFound(t *testing.T) {
+	mockChapterRepo := &MockChapterRepository{
+		ReorderChaptersFunc: func(ctx context.Context, bookID uint, chapterIDs []uint) error {
+			t.Error("ReorderChapters should not be called")
+			return nil
+		},
+	}
+	uc := chapter.NewChapterUsecase(mockChapterRepo, &MockBookRepository{}, newMockCategoryRepository(), &MockLogger{})
+
+	if err := uc.Reorder(context.Background(), 99, []uint{1}); !errors.Is(err, chapter.ErrBookNotFound) {
+		t.Errorf("expected ErrBookNotFound, got %v", err)
+	}
+}
+
+func TestChapterUsecase_Move_WithinBook(t *testing.T) {
+	var gotBook, gotPosition uint
+	mockChapterRepo := &MockChapterRepository{
+		GetChapterByIDFunc: func(ctx context.Context, id uint) (*entity.Chapter, error) {
+			return &entity.Chapter{ID: id, BookID: 4, ChapterNumber: 6}, nil
+		},
+		MoveChapterFunc: func(ctx context.Context, id, bookID, position uint) error {
+			gotBook, gotPosition = bookID, position
+			return nil
+		},
+	}
+	uc := chapter.NewChapterUsecase(mockChapterRepo, &MockBookRepository{}, newMockCategoryRepository(), &MockLogger{})
+
+	if _, err := uc.Move(context.Background(), 9, portuc.MoveChapterInput{Position: uintPtr(2)}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if gotBook != 4 || gotPosition != 2 {
+		t.Errorf("expected move to book 4 position 2, got book %d position %d", gotBook, gotPosition)
+	}
+}
+
+func TestChapterUsecase_Move_RepositoryError(t *testing.T) {
+	mockChapterRepo := &MockChapterRepository{
+		GetChapterByIDFunc: func(ctx context.Context, id uint) (*entity.Chapter, error) {
+			return &entity.Chapter{ID: id, BookID: 4}, nil
+		},
+		MoveChapterFunc: func(ctx context.Context, id, bookID, position uint) error {
+			return errors.New("database error")
+		},
+	}
+	uc := chapter.NewChapterUsecase(mockChapterRepo, &MockBookRepository{}, newMockCategoryRepository(), &MockLogger{})
+
+	if _, err := uc.Move(context.Background(), 9, portuc.MoveChapterInput{}); err == nil {
+		t.Error("expected error from repository, got nil")
+	}
+}
@@ -19,6 +19,15 @@ var (
 	// ErrInvalidCategory indicates category is invalid
 	ErrInvalidCategory = domain.NewInvalidInputError("category is required", nil)
 
-	// ErrInvalidTotalVerses indicates total verses is invalid
-	ErrInvalidTotalVerses = domain.NewInvalidInputError("total verses must be greater than 0", nil)
+	// ErrCategoryNotFound indicates the category does not exist
+	ErrCategoryNotFound = domain.NewNotFoundError("category not found", nil)
+
+	// ErrEmptyOrder indicates a reorder request without chapter IDs
+	ErrEmptyOrder = domain.NewInvalidInputError("chapter ids are required", nil)
+
+	// ErrOrderMismatch indicates the reorder list is not exactly the chapters of the book
+	ErrOrderMismatch = domain.NewConflictError("chapter ids must list every chapter of the book exactly once", nil)
+
+	// ErrInvalidPosition indicates a move position of zero
+	ErrInvalidPosition = domain.NewInvalidInputError("position must be greater than 0", nil)
 )
@@ -0,0 +1,162 @@
+package export
+
+import (
+	"archive/zip"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"time"
+
+	"ishari-backend/internal/core/entity"
+)
+
+// csvHeaders are the columns of each section's CSV, in the order of exportSections
+var csvHeaders = [][]string{
+	{"id", "chapter_number", "title", "category_slug", "category_name", "description"},
+	{"id", "chapter_number", "verse_number", "arabic_text", "transliteration"},
+	{"id", "verse_id", "chapter_number", "verse_number", "language_code", "translation_text", "translator_name"},
+	{"id", "verse_id", "chapter_number", "verse_number", "media_type", "media_url", "file_size", "duration", "codec", "bitrate", "description", "hadi_name"},
+}
+
+// csvManifest identifies the schema of the CSVs in a zip export
+type csvManifest struct {
+	Format     string    `json:"format"`
+	Version    int       `json:"version"`
+	ExportedAt time.Time `json:"exported_at"`
+	Files      []string  `json:"files"`
+}
+
+// csvBookWriter writes a book export as a zip of manifest.json, book.csv and one CSV per
+// section. Zip entries are written one after another, so each section's file is opened when
+// its first row arrives and sections without rows get a file with just the header.
+type csvBookWriter struct {
+	zw      *zip.Writer
+	csv     *csv.Writer
+	section int // index of the open section, -1 before the first
+}
+
+func newCSVBookWriter(w io.Writer, book *entity.ExportBook, exportedAt time.Time) (*csvBookWriter, error) {
+	c := &csvBookWriter{zw: zip.NewWriter(w), section: -1}
+
+	files := []string{"book.csv"}
+	for _, name := range exportSections {
+		files = append(files, name+".csv")
+	}
+	manifest, err := json.MarshalIndent(csvManifest{
+		Format:     entity.BookExportFormat,
+		Version:    entity.BookExportVersion,
+		ExportedAt: exportedAt,
+		Files:      files,
+	}, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	f, err := c.zw.Create("manifest.json")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := f.Write(manifest); err != nil {
+		return nil, err
+	}
+
+	if err := c.open("book.csv", []string{"id", "title", "author", "description", "published_year", "cover_image_url"}); err != nil {
+		return nil, err
+	}
+	err = c.csv.Write([]string{
+		strconv.Itoa(book.ID), book.Title, orEmpty(book.Author), orEmpty(book.Description), formatInt(book.PublishedYear), orEmpty(book.CoverImageURL),
+	})
+	return c, err
+}
+
+func (c *csvBookWriter) Chapter(ch *entity.ExportChapter) error {
+	return c.row(sectionChapters, []string{
+		formatUint(ch.ID), formatUint(ch.ChapterNumber), ch.Title, ch.CategorySlug, ch.CategoryName, orEmpty(ch.Description),
+	})
+}
+
+func (c *csvBookWriter) Verse(v *entity.ExportVerse) error {
+	return c.row(sectionVerses, []string{
+		formatUint(v.ID), formatUint(v.ChapterNumber), formatUint(v.VerseNumber), v.ArabicText, orEmpty(v.Transliteration),
+	})
+}
+
+func (c *csvBookWriter) Translation(t *entity.ExportTranslation) error {
+	return c.row(sectionTranslations, []string{
+		formatUint(t.ID), formatUint(t.VerseID), formatUint(t.ChapterNumber), formatUint(t.VerseNumber), t.LanguageCode, t.TranslationText, orEmpty(t.TranslatorName),
+	})
+}
+
+func (c *csvBookWriter) Media(m *entity.ExportMedia) error {
+	return c.row(sectionMedia, []string{
+		formatUint(m.ID), formatUint(m.VerseID), formatUint(m.ChapterNumber), formatUint(m.VerseNumber), m.MediaType, m.MediaURL,
+		formatInt(m.FileSize), formatInt(m.Duration), orEmpty(m.Codec), formatInt(m.Bitrate), orEmpty(m.Description), orEmpty(m.HadiName),
+	})
+}
+
+func (c *csvBookWriter) row(section int, record []string) error {
+	if err := c.enter(section); err != nil {
+		return err
+	}
+	return c.csv.Write(record)
+}
+
+// enter opens the files of every section up to and including section
+func (c *csvBookWriter) enter(section int) error {
+	for c.section < section {
+		c.section++
+		if err := c.open(exportSections[c.section]+".csv", csvHeaders[c.section]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// open flushes the current file and starts the next one with its header
+func (c *csvBookWriter) open(name string, header []string) error {
+	if err := c.flush(); err != nil {
+		return err
+	}
+	f, err := c.zw.Create(name)
+	if err != nil {
+		return err
+	}
+	c.csv = csv.NewWriter(f)
+	return c.csv.Write(header)
+}
+
+func (c *csvBookWriter) flush() error {
+	if c.csv == nil {
+		return nil
+	}
+	c.csv.Flush()
+	return c.csv.Error()
+}
+
+func (c *csvBookWriter) close() error {
+	if err := c.enter(len(exportSections) - 1); err != nil {
+		return err
+	}
+	if err := c.flush(); err != nil {
+		return err
+	}
+	return c.zw.Close()
+}
+
+func formatUint(v uint) string {
+	return strconv.FormatUint(uint64(v), 10)
+}
+
+func orEmpty(v *string) string {
+	if v == nil {
+		return ""
+	}
+	return *v
+}
+
+func formatInt(v *int) string {
+	if v == nil {
+		return ""
+	}
+	return strconv.Itoa(*v)
+}
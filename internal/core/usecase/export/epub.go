@@ -0,0 +1,257 @@
+package export
+
+import (
+	"archive/zip"
+	"fmt"
+	"html"
+	"io"
+	"strings"
+	"time"
+
+	"ishari-backend/internal/core/entity"
+)
+
+const (
+	epubMimetype = "application/epub+zip"
+	epubDir      = "OEBPS/"
+)
+
+const epubContainer = `<?xml version="1.0" encoding="UTF-8"?>
+<container version="1.0" xmlns="urn:oasis:names:tc:opendocument:xmlns:container">
+  <rootfiles>
+    <rootfile full-path="OEBPS/content.opf" media-type="application/oebps-package+xml"/>
+  </rootfiles>
+</container>
+`
+
+const epubStylesheet = `body { margin: 0 1em; }
+h1 { text-align: center; }
+.verse { margin: 0 0 1.2em; page-break-inside: avoid; }
+.verse-number { font-size: 0.8em; color: #666; }
+.arabic { direction: rtl; text-align: right; font-size: 1.5em; line-height: 2; margin: 0; }
+.transliteration { direction: ltr; text-align: left; font-style: italic; margin: 0.3em 0 0; }
+.translation { direction: ltr; text-align: left; margin: 0.3em 0 0; }
+`
+
+// epubCover is the cover image of an e-book; Name is its file name inside the package
+type epubCover struct {
+	Name        string
+	ContentType string
+	Content     io.Reader
+}
+
+// epubMeta describes an e-book for its package document
+type epubMeta struct {
+	Identifier string
+	Title      string
+	Author     *string
+	Language   string // translation language, empty for none
+	Modified   time.Time
+}
+
+// epubWriter writes an EPUB 3 package. Zip entries are written one after another, so each
+// chapter's file is opened when its first verse arrives; the navigation and package
+// documents, which list every file, are written last.
+type epubWriter struct {
+	zw       *zip.Writer
+	meta     epubMeta
+	chapters []entity.BookletChapter
+	cover    *epubCover
+	current  io.Writer
+	chapter  int // index of the open chapter, -1 before the first
+}
+
+func newEPUBWriter(w io.Writer, meta epubMeta, chapters []entity.BookletChapter, cover *epubCover) (*epubWriter, error) {
+	e := &epubWriter{zw: zip.NewWriter(w), meta: meta, chapters: chapters, cover: cover, chapter: -1}
+
+	// The mimetype must be the first entry and stored uncompressed
+	f, err := e.zw.CreateHeader(&zip.FileHeader{Name: "mimetype", Method: zip.Store})
+	if err != nil {
+		return nil, err
+	}
+	if _, err := io.WriteString(f, epubMimetype); err != nil {
+		return nil, err
+	}
+	if err := e.writeFile("META-INF/container.xml", epubContainer); err != nil {
+		return nil, err
+	}
+	if err := e.writeFile(epubDir+"style.css", epubStylesheet); err != nil {
+		return nil, err
+	}
+	if cover != nil {
+		f, err := e.zw.Create(epubDir + cover.Name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := io.Copy(f, cover.Content); err != nil {
+			return nil, err
+		}
+	}
+	return e, nil
+}
+
+// Verse writes a verse into the file of its chapter. Verses arrive in chapter order, so
+// chapters before it that had no verses are written empty.
+func (e *epubWriter) Verse(v *entity.BookletVerse) error {
+	for e.chapter < 0 || e.chapters[e.chapter].ID != v.ChapterID {
+		if e.chapter == len(e.chapters)-1 {
+			return fmt.Errorf("verse of chapter %d is not in the e-book", v.ChapterID)
+		}
+		if err := e.nextChapter(); err != nil {
+			return err
+		}
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, `<div class="verse" id="v%d">
+<p class="arabic" dir="rtl" lang="ar"><span class="verse-number">%s</span> %s</p>
+`, v.VerseNumber, arabicDigits(v.VerseNumber), text(v.ArabicText))
+	if v.Transliteration != nil && *v.Transliteration != "" {
+		fmt.Fprintf(&b, "<p class=\"transliteration\" dir=\"ltr\" lang=\"ar-Latn\">%s</p>\n", text(*v.Transliteration))
+	}
+	if v.Translation != nil && *v.Translation != "" {
+		fmt.Fprintf(&b, "<p class=\"translation\" dir=\"auto\" lang=\"%s\">%s</p>\n", text(e.meta.Language), text(*v.Translation))
+	}
+	b.WriteString("</div>\n")
+	_, err := io.WriteString(e.current, b.String())
+	return err
+}
+
+// nextChapter closes the open chapter file and opens the next one
+func (e *epubWriter) nextChapter() error {
+	if err := e.closeChapter(); err != nil {
+		return err
+	}
+	e.chapter++
+	chapter := e.chapters[e.chapter]
+
+	f, err := e.zw.Create(epubDir + chapterFile(e.chapter))
+	if err != nil {
+		return err
+	}
+	e.current = f
+	_, err = fmt.Fprintf(f, `%s<head>
+<meta charset="utf-8"/>
+<title>%s</title>
+<link rel="stylesheet" type="text/css" href="style.css"/>
+</head>
+<body>
+<section epub:type="chapter">
+<h1 dir="auto">%s</h1>
+`, xhtmlStart("ar", "rtl"), text(chapter.Title), text(chapter.Title))
+	return err
+}
+
+func (e *epubWriter) closeChapter() error {
+	if e.current == nil {
+		return nil
+	}
+	_, err := io.WriteString(e.current, "</section>\n</body>\n</html>\n")
+	e.current = nil
+	return err
+}
+
+func (e *epubWriter) close() error {
+	for e.chapter < len(e.chapters)-1 {
+		if err := e.nextChapter(); err != nil {
+			return err
+		}
+	}
+	if err := e.closeChapter(); err != nil {
+		return err
+	}
+	if err := e.writeFile(epubDir+"nav.xhtml", e.nav()); err != nil {
+		return err
+	}
+	if err := e.writeFile(epubDir+"content.opf", e.packageDocument()); err != nil {
+		return err
+	}
+	return e.zw.Close()
+}
+
+// nav builds the navigation document from the chapter titles
+func (e *epubWriter) nav() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, `%s<head>
+<meta charset="utf-8"/>
+<title>%s</title>
+</head>
+<body>
+<nav epub:type="toc" id="toc">
+<h1>%s</h1>
+<ol>
+`, xhtmlStart("ar", "rtl"), text(e.meta.Title), text(e.meta.Title))
+	for i, chapter := range e.chapters {
+		fmt.Fprintf(&b, "<li><a href=\"%s\" dir=\"auto\">%s</a></li>\n", chapterFile(i), text(chapter.Title))
+	}
+	b.WriteString("</ol>\n</nav>\n</body>\n</html>\n")
+	return b.String()
+}
+
+func (e *epubWriter) packageDocument() string {
+	var b strings.Builder
+	b.WriteString(`<?xml version="1.0" encoding="UTF-8"?>
+<package xmlns="http://www.idpf.org/2007/opf" version="3.0" unique-identifier="book-id" xml:lang="ar" dir="rtl">
+<metadata xmlns:dc="http://purl.org/dc/elements/1.1/">
+`)
+	fmt.Fprintf(&b, "<dc:identifier id=\"book-id\">%s</dc:identifier>\n", text(e.meta.Identifier))
+	fmt.Fprintf(&b, "<dc:title>%s</dc:title>\n", text(e.meta.Title))
+	if e.meta.Author != nil && *e.meta.Author != "" {
+		fmt.Fprintf(&b, "<dc:creator>%s</dc:creator>\n", text(*e.meta.Author))
+	}
+	b.WriteString("<dc:language>ar</dc:language>\n")
+	if e.meta.Language != "" && e.meta.Language != "ar" {
+		fmt.Fprintf(&b, "<dc:language>%s</dc:language>\n", text(e.meta.Language))
+	}
+	fmt.Fprintf(&b, "<meta property=\"dcterms:modified\">%s</meta>\n", e.meta.Modified.UTC().Format("2006-01-02T15:04:05Z"))
+	b.WriteString("</metadata>\n<manifest>\n")
+	b.WriteString("<item id=\"nav\" href=\"nav.xhtml\" media-type=\"application/xhtml+xml\" properties=\"nav\"/>\n")
+	b.WriteString("<item id=\"style\" href=\"style.css\" media-type=\"text/css\"/>\n")
+	if e.cover != nil {
+		fmt.Fprintf(&b, "<item id=\"cover\" href=\"%s\" media-type=\"%s\" properties=\"cover-image\"/>\n", text(e.cover.Name), text(e.cover.ContentType))
+	}
+	for i := range e.chapters {
+		fmt.Fprintf(&b, "<item id=\"chapter-%d\" href=\"%s\" media-type=\"application/xhtml+xml\"/>\n", i+1, chapterFile(i))
+	}
+	b.WriteString("</manifest>\n<spine page-progression-direction=\"rtl\">\n")
+	for i := range e.chapters {
+		fmt.Fprintf(&b, "<itemref idref=\"chapter-%d\"/>\n", i+1)
+	}
+	b.WriteString("</spine>\n</package>\n")
+	return b.String()
+}
+
+func (e *epubWriter) writeFile(name, content string) error {
+	f, err := e.zw.Create(name)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(f, content)
+	return err
+}
+
+func chapterFile(i int) string {
+	return fmt.Sprintf("chapter-%03d.xhtml", i+1)
+}
+
+// xhtmlStart opens an XHTML content document up to its head
+func xhtmlStart(lang, dir string) string {
+	return fmt.Sprintf(`<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE html>
+<html xmlns="http://www.w3.org/1999/xhtml" xmlns:epub="http://www.idpf.org/2007/ops" xml:lang="%[1]s" lang="%[1]s" dir="%[2]s">
+`, lang, dir)
+}
+
+// text escapes s for XHTML text and attribute values
+func text(s string) string {
+	return html.EscapeString(s)
+}
+
+// arabicDigits writes n in Arabic-Indic digits to match the verse text it numbers
+func arabicDigits(n uint) string {
+	digits := []rune(fmt.Sprint(n))
+	for i, d := range digits {
+		digits[i] = '٠' + (d - '0')
+	}
+	return string(digits)
+}
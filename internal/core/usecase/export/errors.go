@@ -0,0 +1,13 @@
+package export
+
+import "ishari-backend/internal/core/domain"
+
+var (
+	ErrBookNotFound  = domain.NewNotFoundError("book not found", nil)
+	ErrInvalidFormat = domain.NewInvalidInputError("format must be json or csv", nil)
+
+	ErrChapterNotFound = domain.NewNotFoundError("chapter not found", nil)
+	ErrNoChapters      = domain.NewInvalidInputError("a book id or chapter ids are required", nil)
+	ErrEmptyBook       = domain.NewInvalidInputError("book has no chapters to export", nil)
+	ErrInvalidLanguage = domain.NewInvalidInputError("language must be a language code such as ar, id or en", nil)
+)
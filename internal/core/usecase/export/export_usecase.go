@@ -0,0 +1,296 @@
+package export
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"ishari-backend/internal/core/domain"
+	"ishari-backend/internal/core/entity"
+	"ishari-backend/internal/core/port/logger"
+	"ishari-backend/internal/core/port/repository"
+	"ishari-backend/internal/core/port/storage"
+	portuc "ishari-backend/internal/core/port/usecase"
+)
+
+// defaultEPUBTitle names e-books of chapters from several books when no title is given
+const defaultEPUBTitle = "Ishari"
+
+// epubCoverTypes are the image types EPUB readers must support, with their file extension
+var epubCoverTypes = map[string]string{
+	"image/jpeg":    ".jpg",
+	"image/png":     ".png",
+	"image/gif":     ".gif",
+	"image/webp":    ".webp",
+	"image/svg+xml": ".svg",
+}
+
+type exportUsecase struct {
+	exportRepo repository.ExportRepository
+	storage    storage.Storage
+	log        logger.Logger
+}
+
+// NewExportUsecase creates a new export usecase. Storage provides the cover images of e-books.
+func NewExportUsecase(exportRepo repository.ExportRepository, store storage.Storage, log logger.Logger) portuc.ExportUseCase {
+	return &exportUsecase{
+		exportRepo: exportRepo,
+		storage:    store,
+		log:        log,
+	}
+}
+
+// bookWriter encodes the rows of a book export; close completes the file
+type bookWriter interface {
+	repository.BookExportSink
+	close() error
+}
+
+// PrepareBook checks that the book exists and the format is supported
+func (u *exportUsecase) PrepareBook(ctx context.Context, bookID int, format string) (*portuc.BookExport, error) {
+	format = strings.ToLower(strings.TrimSpace(format))
+	if format == "" {
+		format = portuc.ExportFormatJSON
+	}
+
+	export := &portuc.BookExport{Format: format}
+	switch format {
+	case portuc.ExportFormatJSON:
+		export.Filename = fmt.Sprintf("book-%d.json", bookID)
+		export.ContentType = "application/json"
+	case portuc.ExportFormatCSV:
+		export.Filename = fmt.Sprintf("book-%d-csv.zip", bookID)
+		export.ContentType = "application/zip"
+	default:
+		return nil, ErrInvalidFormat
+	}
+
+	if bookID <= 0 {
+		return nil, ErrBookNotFound
+	}
+	book, err := u.exportRepo.GetBook(ctx, bookID)
+	if err != nil {
+		u.log.Error("failed to get book for export", "error", err, "book_id", bookID)
+		return nil, domain.NewInternalError("failed to export book", err)
+	}
+	if book == nil {
+		return nil, ErrBookNotFound
+	}
+	export.Book = book
+	return export, nil
+}
+
+// WriteBook streams the export to w row by row
+func (u *exportUsecase) WriteBook(ctx context.Context, export *portuc.BookExport, w io.Writer) error {
+	var (
+		bw  bookWriter
+		err error
+	)
+	exportedAt := time.Now().UTC()
+	switch export.Format {
+	case portuc.ExportFormatJSON:
+		bw, err = newJSONBookWriter(w, export.Book, exportedAt)
+	case portuc.ExportFormatCSV:
+		bw, err = newCSVBookWriter(w, export.Book, exportedAt)
+	default:
+		return ErrInvalidFormat
+	}
+	if err != nil {
+		return domain.NewInternalError("failed to write book export", err)
+	}
+
+	if err := u.exportRepo.StreamBook(ctx, export.Book.ID, bw); err != nil {
+		u.log.Error("failed to export book", "error", err, "book_id", export.Book.ID)
+		return domain.NewInternalError("failed to export book", err)
+	}
+	if err := bw.close(); err != nil {
+		return domain.NewInternalError("failed to write book export", err)
+	}
+	return nil
+}
+
+// PrepareEPUB resolves the chapters of the e-book, in book order for a whole book and in the
+// given order otherwise, and the book they share
+func (u *exportUsecase) PrepareEPUB(ctx context.Context, input portuc.EPUBExportInput) (*portuc.EPUBExport, error) {
+	language := strings.TrimSpace(input.Language)
+	if language != "" && !domain.IsLanguageCode(language) {
+		return nil, ErrInvalidLanguage
+	}
+
+	export := &portuc.EPUBExport{Language: language, ContentType: "application/epub+zip"}
+	if input.BookID != 0 {
+		book, chapters, err := u.epubBook(ctx, input.BookID)
+		if err != nil {
+			return nil, err
+		}
+		export.Book, export.Chapters = book, chapters
+		export.Filename = fmt.Sprintf("book-%d.epub", input.BookID)
+	} else {
+		book, chapters, err := u.epubChapters(ctx, input.ChapterIDs)
+		if err != nil {
+			return nil, err
+		}
+		export.Book, export.Chapters = book, chapters
+		export.Filename = "chapters.epub"
+	}
+
+	export.Title = strings.TrimSpace(input.Title)
+	if export.Title == "" && export.Book != nil {
+		export.Title = export.Book.Title
+	}
+	if export.Title == "" {
+		export.Title = defaultEPUBTitle
+	}
+	return export, nil
+}
+
+func (u *exportUsecase) epubBook(ctx context.Context, bookID int) (*entity.ExportBook, []entity.BookletChapter, error) {
+	if bookID < 0 {
+		return nil, nil, ErrBookNotFound
+	}
+	book, err := u.exportRepo.GetBook(ctx, bookID)
+	if err != nil {
+		u.log.Error("failed to get book for e-book export", "error", err, "book_id", bookID)
+		return nil, nil, domain.NewInternalError("failed to export e-book", err)
+	}
+	if book == nil {
+		return nil, nil, ErrBookNotFound
+	}
+	chapters, err := u.exportRepo.ListBookletChapters(ctx, bookID, nil)
+	if err != nil {
+		u.log.Error("failed to list chapters for e-book export", "error", err, "book_id", bookID)
+		return nil, nil, domain.NewInternalError("failed to export e-book", err)
+	}
+	if len(chapters) == 0 {
+		return nil, nil, ErrEmptyBook
+	}
+	return book, chapters, nil
+}
+
+// epubChapters loads the chapters in the requested order, ignoring repeated IDs, and their
+// book when they all share one
+func (u *exportUsecase) epubChapters(ctx context.Context, chapterIDs []uint) (*entity.ExportBook, []entity.BookletChapter, error) {
+	var ids []uint
+	for _, id := range chapterIDs {
+		if !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return nil, nil, ErrNoChapters
+	}
+
+	found, err := u.exportRepo.ListBookletChapters(ctx, 0, ids)
+	if err != nil {
+		u.log.Error("failed to list chapters for e-book export", "error", err, "chapter_ids", ids)
+		return nil, nil, domain.NewInternalError("failed to export e-book", err)
+	}
+	byID := make(map[uint]entity.BookletChapter, len(found))
+	for _, chapter := range found {
+		byID[chapter.ID] = chapter
+	}
+
+	chapters := make([]entity.BookletChapter, 0, len(ids))
+	for _, id := range ids {
+		chapter, ok := byID[id]
+		if !ok {
+			return nil, nil, ErrChapterNotFound
+		}
+		chapters = append(chapters, chapter)
+	}
+
+	bookID := chapters[0].BookID
+	for _, chapter := range chapters {
+		if chapter.BookID != bookID {
+			return nil, chapters, nil
+		}
+	}
+	book, err := u.exportRepo.GetBook(ctx, int(bookID))
+	if err != nil {
+		u.log.Error("failed to get book for e-book export", "error", err, "book_id", bookID)
+		return nil, nil, domain.NewInternalError("failed to export e-book", err)
+	}
+	return book, chapters, nil
+}
+
+// WriteEPUB streams the e-book to w. A cover that cannot be read is left out rather than
+// failing the export.
+func (u *exportUsecase) WriteEPUB(ctx context.Context, export *portuc.EPUBExport, w io.Writer) error {
+	meta := epubMeta{
+		Title:    export.Title,
+		Language: export.Language,
+		Modified: time.Now(),
+	}
+	ids := make([]uint, 0, len(export.Chapters))
+	for _, chapter := range export.Chapters {
+		ids = append(ids, chapter.ID)
+	}
+	if export.Book != nil {
+		meta.Author = export.Book.Author
+		meta.Identifier = fmt.Sprintf("urn:ishari:book:%d", export.Book.ID)
+	} else {
+		parts := make([]string, 0, len(ids))
+		for _, id := range ids {
+			parts = append(parts, strconv.FormatUint(uint64(id), 10))
+		}
+		meta.Identifier = "urn:ishari:chapters:" + strings.Join(parts, ",")
+	}
+	if export.Language != "" {
+		meta.Identifier += ":" + export.Language
+	}
+
+	cover, closeCover := u.epubCover(ctx, export.Book)
+	defer closeCover()
+
+	ew, err := newEPUBWriter(w, meta, export.Chapters, cover)
+	if err != nil {
+		return domain.NewInternalError("failed to write e-book", err)
+	}
+	if err := u.exportRepo.StreamBookletVerses(ctx, ids, export.Language, ew.Verse); err != nil {
+		u.log.Error("failed to export e-book", "error", err, "chapter_ids", ids)
+		return domain.NewInternalError("failed to export e-book", err)
+	}
+	if err := ew.close(); err != nil {
+		return domain.NewInternalError("failed to write e-book", err)
+	}
+	return nil
+}
+
+// epubCover opens the book cover when it is an image in our storage of a type e-book readers
+// support; the returned func closes it
+func (u *exportUsecase) epubCover(ctx context.Context, book *entity.ExportBook) (*epubCover, func()) {
+	noCover := func() {}
+	if book == nil || book.CoverImageURL == nil {
+		return nil, noCover
+	}
+	key, ok := u.storage.KeyFromURL(*book.CoverImageURL)
+	if !ok {
+		return nil, noCover
+	}
+
+	rc, info, err := u.storage.Get(ctx, key)
+	if err != nil {
+		if !errors.Is(err, storage.ErrObjectNotFound) {
+			u.log.Error("failed to open book cover for e-book", "error", err, "book_id", book.ID, "key", key)
+		}
+		return nil, noCover
+	}
+	contentType := info.ContentType
+	if contentType == "" {
+		contentType = mime.TypeByExtension(path.Ext(key))
+	}
+	contentType, _, _ = mime.ParseMediaType(contentType)
+	ext, ok := epubCoverTypes[contentType]
+	if !ok {
+		_ = rc.Close()
+		return nil, noCover
+	}
+	return &epubCover{Name: "cover" + ext, ContentType: contentType, Content: rc}, func() { _ = rc.Close() }
+}
@@ -0,0 +1,413 @@
+package export_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"ishari-backend/internal/core/entity"
+	"ishari-backend/internal/core/port/repository"
+	"ishari-backend/internal/core/port/storage"
+	portuc "ishari-backend/internal/core/port/usecase"
+	"ishari-backend/internal/core/usecase/export"
+)
+
+// MockExportRepository is a manual mock for ExportRepository
+type MockExportRepository struct {
+	GetBookFunc             func(ctx context.Context, id int) (*entity.ExportBook, error)
+	StreamBookFunc          func(ctx context.Context, bookID int, sink repository.BookExportSink) error
+	ListBookletChaptersFunc func(ctx context.Context, bookID int, chapterIDs []uint) ([]entity.BookletChapter, error)
+	StreamBookletVersesFunc func(ctx context.Context, chapterIDs []uint, language string, fn func(*entity.BookletVerse) error) error
+}
+
+func (m *MockExportRepository) GetBook(ctx context.Context, id int) (*entity.ExportBook, error) {
+	if m.GetBookFunc != nil {
+		return m.GetBookFunc(ctx, id)
+	}
+	return &entity.ExportBook{ID: id, Title: "Diwan"}, nil
+}
+
+func (m *MockExportRepository) StreamBook(ctx context.Context, bookID int, sink repository.BookExportSink) error {
+	if m.StreamBookFunc != nil {
+		return m.StreamBookFunc(ctx, bookID, sink)
+	}
+	return nil
+}
+
+func (m *MockExportRepository) ListBookletChapters(ctx context.Context, bookID int, chapterIDs []uint) ([]entity.BookletChapter, error) {
+	if m.ListBookletChaptersFunc != nil {
+		return m.ListBookletChaptersFunc(ctx, bookID, chapterIDs)
+	}
+	return nil, nil
+}
+
+func (m *MockExportRepository) StreamBookletVerses(ctx context.Context, chapterIDs []uint, language string, fn func(*entity.BookletVerse) error) error {
+	if m.StreamBookletVersesFunc != nil {
+		return m.StreamBookletVersesFunc(ctx, chapterIDs, language, fn)
+	}
+	return nil
+}
+
+// MockStorage is an in-memory storage for testing
+type MockStorage struct {
+	Objects map[string][]byte
+}
+
+func (m *MockStorage) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) (*storage.ObjectInfo, error) {
+	return nil, nil
+}
+func (m *MockStorage) Get(ctx context.Context, key string) (io.ReadCloser, *storage.ObjectInfo, error) {
+	data, ok := m.Objects[key]
+	if !ok {
+		return nil, nil, storage.ErrObjectNotFound
+	}
+	return io.NopCloser(bytes.NewReader(data)), &storage.ObjectInfo{Key: key, Size: int64(len(data)), ContentType: "image/jpeg"}, nil
+}
+func (m *MockStorage) Stat(ctx context.Context, key string) (*storage.ObjectInfo, error) {
+	return nil, nil
+}
+func (m *MockStorage) GetRange(ctx context.Context, key string, offset, length int64) (io.ReadCloser, error) {
+	return nil, nil
+}
+func (m *MockStorage) Delete(ctx context.Context, key string) error { return nil }
+func (m *MockStorage) Presign(ctx context.Context, key string, ttl time.Duration) (string, error) {
+	return m.URL(key), nil
+}
+func (m *MockStorage) URL(key string) string { return "http://files.test/" + key }
+func (m *MockStorage) KeyFromURL(url string) (string, bool) {
+	return strings.CutPrefix(url, "http://files.test/")
+}
+
+// MockLogger is a manual mock for Logger
+type MockLogger struct{}
+
+func (m *MockLogger) Info(msg string, fields ...any)  {}
+func (m *MockLogger) Error(msg string, fields ...any) {}
+
+func strPtr(s string) *string {
+	return &s
+}
+
+// streamSample emits two verses of one chapter and a translation, but no media
+func streamSample(ctx context.Context, bookID int, sink repository.BookExportSink) error {
+	if err := sink.Chapter(&entity.ExportChapter{ID: 10, ChapterNumber: 1, Title: "Assalamu", CategorySlug: "diwan", CategoryName: "Diwan"}); err != nil {
+		return err
+	}
+	for n := uint(1); n <= 2; n++ {
+		if err := sink.Verse(&entity.ExportVerse{ID: 100 + n, ChapterNumber: 1, VerseNumber: n, ArabicText: "يَا رَبِّ", Transliteration: strPtr("Ya Rabbi, \"salli\"")}); err != nil {
+			return err
+		}
+	}
+	return sink.Translation(&entity.ExportTranslation{ID: 7, VerseID: 101, ChapterNumber: 1, VerseNumber: 1, LanguageCode: "id", TranslationText: "Wahai Tuhanku"})
+}
+
+func prepareAndWrite(t *testing.T, repo *MockExportRepository, format string) []byte {
+	t.Helper()
+	uc := export.NewExportUsecase(repo, &MockStorage{}, &MockLogger{})
+
+	prepared, err := uc.PrepareBook(context.Background(), 3, format)
+	if err != nil {
+		t.Fatalf("expected no error preparing, got %v", err)
+	}
+	var buf bytes.Buffer
+	if err := uc.WriteBook(context.Background(), prepared, &buf); err != nil {
+		t.Fatalf("expected no error writing, got %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestExportUsecase_WriteBook_JSON(t *testing.T) {
+	out := prepareAndWrite(t, &MockExportRepository{StreamBookFunc: streamSample}, "json")
+
+	var doc struct {
+		Format       string                     `json:"format"`
+		Version      int                        `json:"version"`
+		Book         entity.ExportBook          `json:"book"`
+		Chapters     []entity.ExportChapter     `json:"chapters"`
+		Verses       []entity.ExportVerse       `json:"verses"`
+		Translations []entity.ExportTranslation `json:"translations"`
+		Media        []entity.ExportMedia       `json:"media"`
+	}
+	if err := json.Unmarshal(out, &doc); err != nil {
+		t.Fatalf("expected valid JSON, got %v:\n%s", err, out)
+	}
+	if doc.Format != entity.BookExportFormat || doc.Version != entity.BookExportVersion {
+		t.Errorf("unexpected schema %q v%d", doc.Format, doc.Version)
+	}
+	if doc.Book.ID != 3 || len(doc.Chapters) != 1 || len(doc.Verses) != 2 || len(doc.Translations) != 1 {
+		t.Errorf("unexpected document %+v", doc)
+	}
+	if doc.Media == nil {
+		t.Error("expected an empty media array rather than a missing one")
+	}
+}
+
+func TestExportUsecase_WriteBook_JSONEmptyBook(t *testing.T) {
+	out := prepareAndWrite(t, &MockExportRepository{}, "")
+
+	var doc map[string]json.RawMessage
+	if err := json.Unmarshal(out, &doc); err != nil {
+		t.Fatalf("expected valid JSON, got %v:\n%s", err, out)
+	}
+	for _, section := range []string{"chapters", "verses", "translations", "media"} {
+		if string(doc[section]) != "[\n]" {
+			t.Errorf("expected empty %s array, got %s", section, doc[section])
+		}
+	}
+}
+
+func TestExportUsecase_WriteBook_CSV(t *testing.T) {
+	out := prepareAndWrite(t, &MockExportRepository{StreamBookFunc: streamSample}, "CSV")
+
+	zr, err := zip.NewReader(bytes.NewReader(out), int64(len(out)))
+	if err != nil {
+		t.Fatalf("expected a zip, got %v", err)
+	}
+	files := make(map[string][][]string)
+	for _, f := range zr.File {
+		if f.Name == "manifest.json" {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		records, err := csv.NewReader(rc).ReadAll()
+		rc.Close()
+		if err != nil {
+			t.Fatalf("%s: %v", f.Name, err)
+		}
+		files[f.Name] = records
+	}
+
+	want := map[string]int{"book.csv": 2, "chapters.csv": 2, "verses.csv": 3, "translations.csv": 2, "media.csv": 1}
+	for name, rows := range want {
+		if len(files[name]) != rows {
+			t.Errorf("expected %d records in %s, got %d", rows, name, len(files[name]))
+		}
+	}
+	if got := files["verses.csv"][1][4]; got != "Ya Rabbi, \"salli\"" {
+		t.Errorf("expected the transliteration to survive quoting, got %q", got)
+	}
+}
+
+func TestExportUsecase_PrepareBook_Errors(t *testing.T) {
+	missing := &MockExportRepository{
+		GetBookFunc: func(ctx context.Context, id int) (*entity.ExportBook, error) {
+			return nil, nil
+		},
+	}
+
+	tests := []struct {
+		name   string
+		repo   *MockExportRepository
+		bookID int
+		format string
+		want   error
+	}{
+		{"unknown format", &MockExportRepository{}, 3, "xml", export.ErrInvalidFormat},
+		{"invalid id", &MockExportRepository{}, 0, "json", export.ErrBookNotFound},
+		{"missing book", missing, 3, "json", export.ErrBookNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := export.NewExportUsecase(tt.repo, &MockStorage{}, &MockLogger{})
+			if _, err := uc.PrepareBook(context.Background(), tt.bookID, tt.format); !errors.Is(err, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestExportUsecase_WriteBook_StreamError(t *testing.T) {
+	repo := &MockExportRepository{
+		StreamBookFunc: func(ctx context.Context, bookID int, sink repository.BookExportSink) error {
+			return errors.New("connection reset")
+		},
+	}
+	uc := export.NewExportUsecase(repo, &MockStorage{}, &MockLogger{})
+
+	prepared, err := uc.PrepareBook(context.Background(), 3, portuc.ExportFormatJSON)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := uc.WriteBook(context.Background(), prepared, io.Discard); err == nil {
+		t.Error("expected the stream error to be returned")
+	}
+}
+
+// ==================== EPUB Tests ====================
+
+func bookletRepo() *MockExportRepository {
+	return &MockExportRepository{
+		GetBookFunc: func(ctx context.Context, id int) (*entity.ExportBook, error) {
+			return &entity.ExportBook{ID: id, Title: "Diwan", Author: strPtr("Syaikh Ja'far"), CoverImageURL: strPtr("http://files.test/covers/diwan.jpg")}, nil
+		},
+		ListBookletChaptersFunc: func(ctx context.Context, bookID int, chapterIDs []uint) ([]entity.BookletChapter, error) {
+			chapters := []entity.BookletChapter{
+				{ID: 10, BookID: 3, ChapterNumber: 1, Title: "Assalamu <Alaik>"},
+				{ID: 11, BookID: 3, ChapterNumber: 2, Title: "Ya Rabbi"},
+				{ID: 12, BookID: 3, ChapterNumber: 3, Title: "Badat"},
+			}
+			if len(chapterIDs) == 0 {
+				return chapters, nil
+			}
+			var found []entity.BookletChapter
+			for _, chapter := range chapters {
+				for _, id := range chapterIDs {
+					if chapter.ID == id {
+						found = append(found, chapter)
+					}
+				}
+			}
+			return found, nil
+		},
+		StreamBookletVersesFunc: func(ctx context.Context, chapterIDs []uint, language string, fn func(*entity.BookletVerse) error) error {
+			// the middle chapter has no verses
+			for _, v := range []entity.BookletVerse{
+				{ChapterID: chapterIDs[0], VerseNumber: 12, ArabicText: "اَلسَّلَامُ عَلَيْكَ", Transliteration: strPtr("Assalamu 'alaika"), Translation: strPtr("Salam atasmu")},
+				{ChapterID: chapterIDs[len(chapterIDs)-1], VerseNumber: 1, ArabicText: "بَدَتْ"},
+			} {
+				if err := fn(&v); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+}
+
+func readZip(t *testing.T, data []byte) (names []string, files map[string]string) {
+	t.Helper()
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("expected a zip, got %v", err)
+	}
+	files = make(map[string]string)
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		names = append(names, f.Name)
+		files[f.Name] = string(b)
+	}
+	return names, files
+}
+
+func TestExportUsecase_WriteEPUB_Book(t *testing.T) {
+	store := &MockStorage{Objects: map[string][]byte{"covers/diwan.jpg": []byte("jpeg")}}
+	uc := export.NewExportUsecase(bookletRepo(), store, &MockLogger{})
+
+	prepared, err := uc.PrepareEPUB(context.Background(), portuc.EPUBExportInput{BookID: 3, Language: "id"})
+	if err != nil {
+		t.Fatalf("expected no error preparing, got %v", err)
+	}
+	if prepared.Title != "Diwan" || len(prepared.Chapters) != 3 || prepared.Filename != "book-3.epub" {
+		t.Errorf("unexpected export %+v", prepared)
+	}
+
+	var buf bytes.Buffer
+	if err := uc.WriteEPUB(context.Background(), prepared, &buf); err != nil {
+		t.Fatalf("expected no error writing, got %v", err)
+	}
+	names, files := readZip(t, buf.Bytes())
+
+	if names[0] != "mimetype" || files["mimetype"] != "application/epub+zip" {
+		t.Errorf("expected the mimetype first, got %v", names)
+	}
+	for name, content := range files {
+		if !strings.HasSuffix(name, ".xhtml") && !strings.HasSuffix(name, ".opf") && !strings.HasSuffix(name, ".xml") {
+			continue
+		}
+		d := xml.NewDecoder(strings.NewReader(content))
+		for {
+			if _, err := d.Token(); err == io.EOF {
+				break
+			} else if err != nil {
+				t.Errorf("%s is not well-formed: %v", name, err)
+				break
+			}
+		}
+	}
+	if files["OEBPS/cover.jpg"] != "jpeg" || !strings.Contains(files["OEBPS/content.opf"], `properties="cover-image"`) {
+		t.Error("expected the stored cover in the package")
+	}
+	if !strings.Contains(files["OEBPS/content.opf"], `page-progression-direction="rtl"`) {
+		t.Error("expected a right-to-left spine")
+	}
+	chapter := files["OEBPS/chapter-001.xhtml"]
+	for _, want := range []string{`dir="rtl"`, "Assalamu &lt;Alaik&gt;", "١٢", "Assalamu &#39;alaika", `lang="id">Salam atasmu`} {
+		if !strings.Contains(chapter, want) {
+			t.Errorf("expected chapter 1 to contain %q:\n%s", want, chapter)
+		}
+	}
+	if _, ok := files["OEBPS/chapter-002.xhtml"]; !ok {
+		t.Error("expected a file for the chapter without verses")
+	}
+	if !strings.Contains(files["OEBPS/chapter-003.xhtml"], "بَدَتْ") {
+		t.Error("expected the verse of chapter 3 in its own file")
+	}
+	if !strings.Contains(files["OEBPS/nav.xhtml"], `<a href="chapter-002.xhtml" dir="auto">Ya Rabbi</a>`) {
+		t.Errorf("expected chapter titles in the nav document:\n%s", files["OEBPS/nav.xhtml"])
+	}
+}
+
+func TestExportUsecase_PrepareEPUB_ChaptersInRequestedOrder(t *testing.T) {
+	uc := export.NewExportUsecase(bookletRepo(), &MockStorage{}, &MockLogger{})
+
+	prepared, err := uc.PrepareEPUB(context.Background(), portuc.EPUBExportInput{ChapterIDs: []uint{12, 10, 12}, Title: "Booklet"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(prepared.Chapters) != 2 || prepared.Chapters[0].ID != 12 || prepared.Chapters[1].ID != 10 {
+		t.Errorf("expected chapters 12 then 10, got %+v", prepared.Chapters)
+	}
+	if prepared.Title != "Booklet" || prepared.Book == nil {
+		t.Errorf("expected the given title and the shared book, got %+v", prepared)
+	}
+
+	// The cover is not in storage, so the e-book is written without one
+	var buf bytes.Buffer
+	if err := uc.WriteEPUB(context.Background(), prepared, &buf); err != nil {
+		t.Fatalf("expected no error writing, got %v", err)
+	}
+	_, files := readZip(t, buf.Bytes())
+	if strings.Contains(files["OEBPS/content.opf"], "cover-image") {
+		t.Error("expected no cover")
+	}
+}
+
+func TestExportUsecase_PrepareEPUB_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input portuc.EPUBExportInput
+		want  error
+	}{
+		{"nothing selected", portuc.EPUBExportInput{}, export.ErrNoChapters},
+		{"missing chapter", portuc.EPUBExportInput{ChapterIDs: []uint{10, 99}}, export.ErrChapterNotFound},
+		{"long language", portuc.EPUBExportInput{BookID: 3, Language: "id-ID-jawa-x"}, export.ErrInvalidLanguage},
+		{"not a language code", portuc.EPUBExportInput{BookID: 3, Language: "en_US"}, export.ErrInvalidLanguage},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := export.NewExportUsecase(bookletRepo(), &MockStorage{}, &MockLogger{})
+			if _, err := uc.PrepareEPUB(context.Background(), tt.input); !errors.Is(err, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+}
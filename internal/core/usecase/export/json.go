@@ -0,0 +1,117 @@
+package export
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"time"
+
+	"ishari-backend/internal/core/entity"
+)
+
+// exportSections are the row sections of a book export in the order they are streamed
+var exportSections = []string{"chapters", "verses", "translations", "media"}
+
+const (
+	sectionChapters = iota
+	sectionVerses
+	sectionTranslations
+	sectionMedia
+)
+
+// jsonHeader opens a JSON book export; the sections follow as arrays of rows
+type jsonHeader struct {
+	Format     string             `json:"format"`
+	Version    int                `json:"version"`
+	ExportedAt time.Time          `json:"exported_at"`
+	Book       *entity.ExportBook `json:"book"`
+}
+
+// jsonBookWriter writes a book export as one JSON object with a row per line, opening each
+// section when its first row arrives and writing empty arrays for sections without rows
+type jsonBookWriter struct {
+	w       *bufio.Writer
+	section int  // index of the open section, -1 before the first
+	empty   bool // whether the open section has no rows yet
+}
+
+func newJSONBookWriter(w io.Writer, book *entity.ExportBook, exportedAt time.Time) (*jsonBookWriter, error) {
+	header, err := json.Marshal(jsonHeader{
+		Format:     entity.BookExportFormat,
+		Version:    entity.BookExportVersion,
+		ExportedAt: exportedAt,
+		Book:       book,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	j := &jsonBookWriter{w: bufio.NewWriter(w), section: -1}
+	// the sections are appended to the header object
+	_, err = j.w.Write(header[:len(header)-1])
+	return j, err
+}
+
+func (j *jsonBookWriter) Chapter(chapter *entity.ExportChapter) error {
+	return j.row(sectionChapters, chapter)
+}
+
+func (j *jsonBookWriter) Verse(verse *entity.ExportVerse) error {
+	return j.row(sectionVerses, verse)
+}
+
+func (j *jsonBookWriter) Translation(translation *entity.ExportTranslation) error {
+	return j.row(sectionTranslations, translation)
+}
+
+func (j *jsonBookWriter) Media(media *entity.ExportMedia) error {
+	return j.row(sectionMedia, media)
+}
+
+func (j *jsonBookWriter) row(section int, v any) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	if err := j.enter(section); err != nil {
+		return err
+	}
+	sep := ",\n"
+	if j.empty {
+		sep = "\n"
+	}
+	j.empty = false
+	if _, err := j.w.WriteString(sep); err != nil {
+		return err
+	}
+	_, err = j.w.Write(b)
+	return err
+}
+
+// enter closes the open section and opens every section up to and including section
+func (j *jsonBookWriter) enter(section int) error {
+	for j.section < section {
+		if j.section >= 0 {
+			if _, err := j.w.WriteString("\n]"); err != nil {
+				return err
+			}
+		}
+		j.section++
+		name, _ := json.Marshal(exportSections[j.section])
+		if _, err := j.w.WriteString(",\n" + string(name) + ":["); err != nil {
+			return err
+		}
+		j.empty = true
+	}
+	return nil
+}
+
+func (j *jsonBookWriter) close() error {
+	if err := j.enter(len(exportSections) - 1); err != nil {
+		return err
+	}
+	if _, err := j.w.WriteString("\n]}\n"); err != nil {
+		return err
+	}
+	return j.w.Flush()
+}
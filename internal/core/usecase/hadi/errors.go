@@ -0,0 +1,12 @@
+package hadi
+
+import "ishari-backend/internal/core/domain"
+
+// Hadi domain errors
+var (
+	// ErrHadiNotFound indicates the hadi does not exist
+	ErrHadiNotFound = domain.NewNotFoundError("hadi not found", nil)
+
+	// ErrVerseNotFound indicates the referenced verse does not exist
+	ErrVerseNotFound = domain.NewNotFoundError("verse not found", nil)
+)
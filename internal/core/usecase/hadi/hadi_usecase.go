@@ -6,6 +6,7 @@ import (
 
 	"ishari-backend/internal/adapter/handler/http/dto"
 	"ishari-backend/internal/adapter/handler/http/response"
+	"ishari-backend/internal/core/domain"
 	"ishari-backend/internal/core/entity"
 	portrepo "ishari-backend/internal/core/port/repository"
 	portusecase "ishari-backend/internal/core/port/usecase"
@@ -14,13 +15,15 @@ import (
 )
 
 type hadiUseCase struct {
-	hadiRepo portrepo.HadiRepository
+	hadiRepo  portrepo.HadiRepository
+	verseRepo portrepo.VerseRepository
 }
 
 // NewHadiUseCase creates a new hadi usecase instance
-func NewHadiUseCase(hadiRepo portrepo.HadiRepository) portusecase.HadiUseCase {
+func NewHadiUseCase(hadiRepo portrepo.HadiRepository, verseRepo portrepo.VerseRepository) portusecase.HadiUseCase {
 	return &hadiUseCase{
-		hadiRepo: hadiRepo,
+		hadiRepo:  hadiRepo,
+		verseRepo: verseRepo,
 	}
 }
 
@@ -43,7 +46,7 @@ func (u *hadiUseCase) GetByID(ctx context.Context, id int) (*response.HadiRespon
 	hadi, err := u.hadiRepo.GetByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errors.New("hadi not found")
+			return nil, ErrHadiNotFound
 		}
 		return nil, err
 	}
@@ -72,13 +75,17 @@ func (u *hadiUseCase) Update(ctx context.Context, id int, req dto.UpdateHadiRequ
 	hadi, err := u.hadiRepo.GetByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errors.New("hadi not found")
+			return nil, ErrHadiNotFound
 		}
 		return nil, err
 	}
 
 	hadi.Name = req.Name
 	hadi.Description = req.Description
+	// resized copies belong to the previous image
+	if !domain.SameURL(hadi.ImageURL, req.ImageURL) {
+		hadi.Images = nil
+	}
 	hadi.ImageURL = req.ImageURL
 
 	err = u.hadiRepo.Update(ctx, hadi)
@@ -93,7 +100,7 @@ func (u *hadiUseCase) Delete(ctx context.Context, id int) error {
 	_, err := u.hadiRepo.GetByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return errors.New("hadi not found")
+			return ErrHadiNotFound
 		}
 		return err
 	}
@@ -105,3 +112,99 @@ func (u *hadiUseCase) Delete(ctx context.Context, id int) error {
 
 	return nil
 }
+
+// GetRepertoire returns the chapters and verses a hadi has recordings for, grouped by book and category
+func (u *hadiUseCase) GetRepertoire(ctx context.Context, id int) (*response.HadiRepertoireResponse, error) {
+	hadi, err := u.hadiRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrHadiNotFound
+		}
+		return nil, err
+	}
+
+	rows, err := u.hadiRepo.ListRepertoire(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// rows are ordered by book, category, chapter and verse, so each group is contiguous
+	resp := &response.HadiRepertoireResponse{
+		Hadi:            response.MapHadiResponse(hadi),
+		TotalRecordings: len(rows),
+		Books:           []response.RepertoireBookGroup{},
+	}
+	for _, row := range rows {
+		if n := len(resp.Books); n == 0 || resp.Books[n-1].ID != row.BookID {
+			resp.Books = append(resp.Books, response.RepertoireBookGroup{ID: row.BookID, Title: row.BookTitle})
+		}
+		book := &resp.Books[len(resp.Books)-1]
+
+		if n := len(book.Categories); n == 0 || book.Categories[n-1].Category != row.Category {
+			book.Categories = append(book.Categories, response.RepertoireCategoryGroup{Category: row.Category})
+		}
+		category := &book.Categories[len(book.Categories)-1]
+
+		if n := len(category.Chapters); n == 0 || category.Chapters[n-1].ID != row.ChapterID {
+			category.Chapters = append(category.Chapters, response.RepertoireChapter{
+				ID:            row.ChapterID,
+				ChapterNumber: row.ChapterNumber,
+				Title:         row.ChapterTitle,
+			})
+		}
+		chapter := &category.Chapters[len(category.Chapters)-1]
+
+		if n := len(chapter.Verses); n == 0 || chapter.Verses[n-1].ID != row.VerseID {
+			chapter.Verses = append(chapter.Verses, response.RepertoireVerse{ID: row.VerseID, VerseNumber: row.VerseNumber})
+		}
+		verse := &chapter.Verses[len(chapter.Verses)-1]
+
+		verse.Recordings = append(verse.Recordings, response.RecordingItem{
+			MediaID:  row.MediaID,
+			MediaURL: row.MediaURL,
+			Duration: row.Duration,
+		})
+	}
+
+	return resp, nil
+}
+
+// ListByVerse returns the hadi who have recorded a verse, each with their recordings
+func (u *hadiUseCase) ListByVerse(ctx context.Context, verseID uint) ([]response.VerseHadiResponse, error) {
+	verse, err := u.verseRepo.GetById(ctx, verseID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrVerseNotFound
+		}
+		return nil, err
+	}
+	if verse == nil {
+		return nil, ErrVerseNotFound
+	}
+
+	rows, err := u.hadiRepo.ListByVerse(ctx, verseID)
+	if err != nil {
+		return nil, err
+	}
+
+	// rows are ordered by hadi, so each hadi's recordings are contiguous
+	hadis := []response.VerseHadiResponse{}
+	for _, row := range rows {
+		if n := len(hadis); n == 0 || hadis[n-1].ID != row.HadiID {
+			hadis = append(hadis, response.VerseHadiResponse{
+				ID:       row.HadiID,
+				Name:     row.HadiName,
+				ImageURL: row.HadiImageURL,
+				Images:   response.MapImageVariantsResponse(row.HadiImages),
+			})
+		}
+		hadi := &hadis[len(hadis)-1]
+		hadi.Recordings = append(hadi.Recordings, response.RecordingItem{
+			MediaID:  row.MediaID,
+			MediaURL: row.MediaURL,
+			Duration: row.Duration,
+		})
+	}
+
+	return hadis, nil
+}
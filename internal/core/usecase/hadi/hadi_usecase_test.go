@@ -8,7 +8,10 @@ import (
 
 	"ishari-backend/internal/adapter/handler/http/dto"
 	"ishari-backend/internal/core/entity"
+	"ishari-backend/internal/core/port/repository"
 	hadiusecase "ishari-backend/internal/core/usecase/hadi"
+
+	"gorm.io/gorm"
 )
 
 // MockHadiRepository is a manual mock for testing
@@ -18,6 +21,9 @@ type MockHadiRepository struct {
 	ListFunc    func(ctx context.Context, limit, offset int) ([]entity.Hadi, int64, error)
 	UpdateFunc  func(ctx context.Context, hadi *entity.Hadi) error
 	DeleteFunc  func(ctx context.Context, id int) error
+
+	ListRepertoireFunc func(ctx context.Context, hadiID int) ([]repository.HadiRepertoireRow, error)
+	ListByVerseFunc    func(ctx context.Context, verseID uint) ([]repository.VerseHadiRow, error)
 }
 
 func (m *MockHadiRepository) Create(ctx context.Context, hadi *entity.Hadi) error {
@@ -55,6 +61,53 @@ func (m *MockHadiRepository) Delete(ctx context.Context, id int) error {
 	return nil
 }
 
+func (m *MockHadiRepository) ListRepertoire(ctx context.Context, hadiID int) ([]repository.HadiRepertoireRow, error) {
+	if m.ListRepertoireFunc != nil {
+		return m.ListRepertoireFunc(ctx, hadiID)
+	}
+	return nil, nil
+}
+
+func (m *MockHadiRepository) ListByVerse(ctx context.Context, verseID uint) ([]repository.VerseHadiRow, error) {
+	if m.ListByVerseFunc != nil {
+		return m.ListByVerseFunc(ctx, verseID)
+	}
+	return nil, nil
+}
+
+// MockVerseRepository is a manual mock for testing
+type MockVerseRepository struct {
+	GetByIdFunc func(ctx context.Context, id uint) (*entity.Verse, error)
+}
+
+func (m *MockVerseRepository) Create(ctx context.Context, v *entity.Verse) error { return nil }
+func (m *MockVerseRepository) List(ctx context.Context, filter repository.VerseFilter) ([]entity.Verse, uint, error) {
+	return nil, 0, nil
+}
+func (m *MockVerseRepository) Update(ctx context.Context, v *entity.Verse) error { return nil }
+func (m *MockVerseRepository) Delete(ctx context.Context, id uint) error         { return nil }
+func (m *MockVerseRepository) BulkDelete(ctx context.Context, ids []uint) error  { return nil }
+func (m *MockVerseRepository) GetById(ctx context.Context, id uint) (*entity.Verse, error) {
+	if m.GetByIdFunc != nil {
+		return m.GetByIdFunc(ctx, id)
+	}
+	return &entity.Verse{ID: id}, nil
+}
+func (m *MockVerseRepository) Reorder(ctx context.Context, chapterID uint, verseIDs []uint) error {
+	return nil
+}
+func (m *MockVerseRepository) Move(ctx context.Context, id, chapterID, position uint) error {
+	return nil
+}
+
+func (m *MockVerseRepository) VerseNumbers(ctx context.Context, chapterID uint) (map[uint]uint, error) {
+	return nil, nil
+}
+
+func (m *MockVerseRepository) Import(ctx context.Context, chapterID uint, verses []entity.VerseImport) error {
+	return nil
+}
+
 func TestHadiUseCase_Create(t *testing.T) {
 	mockRepo := &MockHadiRepository{
 		CreateFunc: func(ctx context.Context, hadi *entity.Hadi) error {
@@ -65,7 +118,7 @@ func TestHadiUseCase_Create(t *testing.T) {
 		},
 	}
 
-	uc := hadiusecase.NewHadiUseCase(mockRepo)
+	uc := hadiusecase.NewHadiUseCase(mockRepo, &MockVerseRepository{})
 	desc := "A renowned reciter"
 	imgURL := "http://example.com/image.png"
 
@@ -101,7 +154,7 @@ func TestHadiUseCase_GetByID(t *testing.T) {
 		},
 	}
 
-	uc := hadiusecase.NewHadiUseCase(mockRepo)
+	uc := hadiusecase.NewHadiUseCase(mockRepo, &MockVerseRepository{})
 
 	resp, err := uc.GetByID(context.Background(), 1)
 	if err != nil {
@@ -127,7 +180,7 @@ func TestHadiUseCase_List(t *testing.T) {
 		},
 	}
 
-	uc := hadiusecase.NewHadiUseCase(mockRepo)
+	uc := hadiusecase.NewHadiUseCase(mockRepo, &MockVerseRepository{})
 
 	resp, total, err := uc.List(context.Background(), 1, 10)
 
@@ -159,7 +212,7 @@ func TestHadiUseCase_Update(t *testing.T) {
 		},
 	}
 
-	uc := hadiusecase.NewHadiUseCase(mockRepo)
+	uc := hadiusecase.NewHadiUseCase(mockRepo, &MockVerseRepository{})
 	desc := "Updated Description"
 
 	req := dto.UpdateHadiRequest{
@@ -180,6 +233,30 @@ func TestHadiUseCase_Update(t *testing.T) {
 	}
 }
 
+func TestHadiUseCase_Update_ImageChangeDropsVariants(t *testing.T) {
+	oldImage := "http://files.test/hadis/1/old.png"
+	var saved *entity.Hadi
+	mockRepo := &MockHadiRepository{
+		GetByIDFunc: func(ctx context.Context, id int) (*entity.Hadi, error) {
+			return &entity.Hadi{ID: 1, Name: "Name", ImageURL: &oldImage, Images: &entity.ImageVariants{Thumb: "thumb"}}, nil
+		},
+		UpdateFunc: func(ctx context.Context, hadi *entity.Hadi) error {
+			saved = hadi
+			return nil
+		},
+	}
+	uc := hadiusecase.NewHadiUseCase(mockRepo, &MockVerseRepository{})
+
+	newImage := "https://cdn.example.com/new.png"
+	resp, err := uc.Update(context.Background(), 1, dto.UpdateHadiRequest{Name: "Name", ImageURL: &newImage})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if saved.Images != nil || resp.Images != nil {
+		t.Error("expected image variants to be dropped when the image changes")
+	}
+}
+
 func TestHadiUseCase_Delete(t *testing.T) {
 	mockRepo := &MockHadiRepository{
 		GetByIDFunc: func(ctx context.Context, id int) (*entity.Hadi, error) {
@@ -196,7 +273,7 @@ func TestHadiUseCase_Delete(t *testing.T) {
 		},
 	}
 
-	uc := hadiusecase.NewHadiUseCase(mockRepo)
+	uc := hadiusecase.NewHadiUseCase(mockRepo, &MockVerseRepository{})
 
 	err := uc.Delete(context.Background(), 1)
 	if err != nil {
@@ -208,3 +285,105 @@ func TestHadiUseCase_Delete(t *testing.T) {
 		t.Error("expected error for non-existent hadi, got nil")
 	}
 }
+
+func TestHadiUseCase_GetRepertoire(t *testing.T) {
+	duration := 42
+	mockRepo := &MockHadiRepository{
+		GetByIDFunc: func(ctx context.Context, id int) (*entity.Hadi, error) {
+			return &entity.Hadi{ID: id, Name: "Hadi"}, nil
+		},
+		ListRepertoireFunc: func(ctx context.Context, hadiID int) ([]repository.HadiRepertoireRow, error) {
+			return []repository.HadiRepertoireRow{
+				{BookID: 1, BookTitle: "Diwan", Category: "Asyraqal", ChapterID: 10, ChapterNumber: 1, VerseID: 100, VerseNumber: 1, MediaID: 1000},
+				{BookID: 1, BookTitle: "Diwan", Category: "Asyraqal", ChapterID: 10, ChapterNumber: 1, VerseID: 100, VerseNumber: 1, MediaID: 1001, Duration: &duration},
+				{BookID: 1, BookTitle: "Diwan", Category: "Asyraqal", ChapterID: 10, ChapterNumber: 1, VerseID: 101, VerseNumber: 2, MediaID: 1002},
+				{BookID: 1, BookTitle: "Diwan", Category: "Bahar", ChapterID: 11, ChapterNumber: 2, VerseID: 110, VerseNumber: 1, MediaID: 1003},
+				{BookID: 2, BookTitle: "Maulid", Category: "Asyraqal", ChapterID: 20, ChapterNumber: 1, VerseID: 200, VerseNumber: 1, MediaID: 1004},
+			}, nil
+		},
+	}
+	uc := hadiusecase.NewHadiUseCase(mockRepo, &MockVerseRepository{})
+
+	resp, err := uc.GetRepertoire(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if resp.Hadi == nil || resp.Hadi.ID != 1 {
+		t.Errorf("expected hadi 1, got %+v", resp.Hadi)
+	}
+	if resp.TotalRecordings != 5 {
+		t.Errorf("expected 5 recordings, got %d", resp.TotalRecordings)
+	}
+	if len(resp.Books) != 2 {
+		t.Fatalf("expected 2 books, got %d", len(resp.Books))
+	}
+	diwan := resp.Books[0]
+	if len(diwan.Categories) != 2 || diwan.Categories[0].Category != "Asyraqal" {
+		t.Fatalf("expected 2 categories in first book, got %+v", diwan.Categories)
+	}
+	verses := diwan.Categories[0].Chapters[0].Verses
+	if len(verses) != 2 || len(verses[0].Recordings) != 2 {
+		t.Fatalf("expected 2 verses with the first recorded twice, got %+v", verses)
+	}
+	if verses[0].Recordings[1].Duration == nil || *verses[0].Recordings[1].Duration != 42 {
+		t.Error("expected recording duration to be kept")
+	}
+}
+
+func TestHadiUseCase_GetRepertoire_NotFound(t *testing.T) {
+	mockRepo := &MockHadiRepository{
+		GetByIDFunc: func(ctx context.Context, id int) (*entity.Hadi, error) {
+			return nil, gorm.ErrRecordNotFound
+		},
+	}
+	uc := hadiusecase.NewHadiUseCase(mockRepo, &MockVerseRepository{})
+
+	_, err := uc.GetRepertoire(context.Background(), 99)
+
+	if !errors.Is(err, hadiusecase.ErrHadiNotFound) {
+		t.Errorf("expected ErrHadiNotFound, got %v", err)
+	}
+}
+
+func TestHadiUseCase_ListByVerse(t *testing.T) {
+	mockRepo := &MockHadiRepository{
+		ListByVerseFunc: func(ctx context.Context, verseID uint) ([]repository.VerseHadiRow, error) {
+			return []repository.VerseHadiRow{
+				{HadiID: 1, HadiName: "Ahmad", HadiImages: &entity.ImageVariants{Thumb: "thumb", Medium: "medium", Large: "large"}, MediaID: 10},
+				{HadiID: 1, HadiName: "Ahmad", HadiImages: &entity.ImageVariants{Thumb: "thumb", Medium: "medium", Large: "large"}, MediaID: 11},
+				{HadiID: 2, HadiName: "Yusuf", MediaID: 12},
+			}, nil
+		},
+	}
+	uc := hadiusecase.NewHadiUseCase(mockRepo, &MockVerseRepository{})
+
+	hadis, err := uc.ListByVerse(context.Background(), 5)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(hadis) != 2 {
+		t.Fatalf("expected 2 hadis, got %d", len(hadis))
+	}
+	if len(hadis[0].Recordings) != 2 || len(hadis[1].Recordings) != 1 {
+		t.Errorf("expected recordings grouped per hadi, got %+v", hadis)
+	}
+	want := dto.ImageVariantsResponse{Thumb: "thumb", Medium: "medium", Large: "large"}
+	if hadis[0].Images == nil || *hadis[0].Images != want || hadis[1].Images != nil {
+		t.Errorf("expected image variants of the first hadi only, got %+v and %+v", hadis[0].Images, hadis[1].Images)
+	}
+}
+
+func TestHadiUseCase_ListByVerse_VerseNotFound(t *testing.T) {
+	verseRepo := &MockVerseRepository{
+		GetByIdFunc: func(ctx context.Context, id uint) (*entity.Verse, error) {
+			return nil, gorm.ErrRecordNotFound
+		},
+	}
+	uc := hadiusecase.NewHadiUseCase(&MockHadiRepository{}, verseRepo)
+
+	_, err := uc.ListByVerse(context.Background(), 99)
+
+	if !errors.Is(err, hadiusecase.ErrVerseNotFound) {
+		t.Errorf("expected ErrVerseNotFound, got %v", err)
+	}
+}
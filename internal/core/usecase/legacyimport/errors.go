@@ -0,0 +1,13 @@
+package legacyimport
+
+import "ishari-backend/internal/core/domain"
+
+var (
+	ErrSourceRequired       = domain.NewInvalidInputError("legacy source name is required", nil)
+	ErrTableRequired        = domain.NewInvalidInputError("legacy table name is required", nil)
+	ErrNoRecords            = domain.NewInvalidInputError("no legacy records to import", nil)
+	ErrNoMuhuds             = domain.NewInvalidInputError("mapping maps no muhud to a chapter number", nil)
+	ErrNoCategories         = domain.NewInvalidInputError("mapping needs category rules or a default category", nil)
+	ErrInvalidCategoryRule  = domain.NewInvalidInputError("category rules need a column and a category", nil)
+	ErrInvalidChapterNumber = domain.NewInvalidInputError("muhuds must map to chapter numbers above 0", nil)
+)
@@ -0,0 +1,280 @@
+package legacyimport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"ishari-backend/internal/core/domain"
+	"ishari-backend/internal/core/entity"
+	"ishari-backend/internal/core/port/logger"
+	"ishari-backend/internal/core/port/repository"
+	portuc "ishari-backend/internal/core/port/usecase"
+)
+
+const (
+	versesTable                = "verses"
+	defaultTranslationLanguage = "id"
+)
+
+type legacyImportUsecase struct {
+	legacyRepo repository.LegacyRepository
+	verseRepo  repository.VerseRepository
+	verseUC    portuc.VerseUseCase
+	log        logger.Logger
+}
+
+func NewLegacyImportUsecase(legacyRepo repository.LegacyRepository, verseRepo repository.VerseRepository, verseUC portuc.VerseUseCase, log logger.Logger) portuc.LegacyImportUseCase {
+	return &legacyImportUsecase{
+		legacyRepo: legacyRepo,
+		verseRepo:  verseRepo,
+		verseUC:    verseUC,
+		log:        log,
+	}
+}
+
+// chapterKey identifies the chapter records are mapped to
+type chapterKey struct {
+	category string
+	number   uint
+}
+
+// chapterRecords are the records of one chapter in the order they were read, each with
+// its verse number
+type chapterRecords struct {
+	key     chapterKey
+	records []entity.LegacyRecord
+	numbers []uint
+}
+
+// Import maps the records to chapters and imports the verses of each chapter through the
+// verse import, updating existing verse numbers. Chapters are written one at a time, so a
+// chapter with invalid records does not stop the others; an import can be rerun once they
+// are fixed. Only internal errors abort the import.
+func (u *legacyImportUsecase) Import(ctx context.Context, input portuc.LegacyImportInput) (*portuc.LegacyImportReport, error) {
+	if err := validateInput(input); err != nil {
+		return nil, err
+	}
+	language := input.Mapping.TranslationLanguage
+	if language == "" {
+		language = defaultTranslationLanguage
+	}
+
+	report := &portuc.LegacyImportReport{DryRun: input.DryRun, Records: len(input.Records)}
+	groups, skipped := groupByChapter(input.Records, input.Mapping)
+	report.Skipped = skipped
+
+	for _, group := range groups {
+		chapter, err := u.importChapter(ctx, input, group, language)
+		if err != nil {
+			return nil, err
+		}
+		report.Chapters = append(report.Chapters, chapter)
+		if len(chapter.Errors) > 0 {
+			report.Failed++
+			continue
+		}
+		report.Created += chapter.Created
+		report.Updated += chapter.Updated
+	}
+	return report, nil
+}
+
+// importChapter imports the records of one chapter and records their legacy IDs
+func (u *legacyImportUsecase) importChapter(ctx context.Context, input portuc.LegacyImportInput, group chapterRecords, language string) (portuc.LegacyChapterReport, error) {
+	chapter := portuc.LegacyChapterReport{
+		Category:      group.key.category,
+		ChapterNumber: group.key.number,
+		Records:       len(group.records),
+	}
+
+	ids, err := u.legacyRepo.FindChapterIDs(ctx, group.key.category, group.key.number, input.Mapping.BookID)
+	if err != nil {
+		u.log.Error("failed to find chapter for legacy import", "error", err, "category", group.key.category, "chapter_number", group.key.number)
+		return chapter, domain.NewInternalError("failed to import legacy verses", err)
+	}
+	switch {
+	case len(ids) == 0:
+		chapter.Errors = append(chapter.Errors, "chapter not found")
+		return chapter, nil
+	case len(ids) > 1:
+		chapter.Errors = append(chapter.Errors, fmt.Sprintf("chapter exists in %d books; set the book id of the mapping", len(ids)))
+		return chapter, nil
+	}
+	chapter.ChapterID = ids[0]
+
+	rows := make([]portuc.ImportVerseRow, len(group.records))
+	for i, record := range group.records {
+		rows[i] = toImportRow(record, group.numbers[i], language)
+	}
+	result, err := u.verseUC.Import(ctx, portuc.ImportVersesInput{
+		ChapterID:  chapter.ChapterID,
+		Rows:       rows,
+		DryRun:     input.DryRun,
+		OnConflict: portuc.ImportOnConflictUpdate,
+	})
+	if err != nil {
+		var domainErr *domain.DomainError
+		if errors.As(err, &domainErr) && domainErr.Type != domain.ErrTypeInternal {
+			chapter.Errors = append(chapter.Errors, err.Error())
+			return chapter, nil
+		}
+		return chapter, err
+	}
+	for i, row := range result.Rows {
+		if len(row.Errors) > 0 {
+			chapter.Errors = append(chapter.Errors, fmt.Sprintf("legacy id %d, verse %d: %s",
+				group.records[i].ID, row.VerseNumber, strings.Join(row.Errors, "; ")))
+		}
+	}
+	if !result.Valid {
+		return chapter, nil
+	}
+	chapter.Created = result.Created
+	chapter.Updated = result.Updated
+	if input.DryRun {
+		return chapter, nil
+	}
+
+	verseIDs, err := u.verseRepo.VerseNumbers(ctx, chapter.ChapterID)
+	if err != nil {
+		u.log.Error("failed to get imported verse ids", "error", err, "chapter_id", chapter.ChapterID)
+		return chapter, domain.NewInternalError("failed to record legacy ids", err)
+	}
+	mappings := make([]entity.LegacyIDMapping, 0, len(group.records))
+	for i, record := range group.records {
+		if verseID, ok := verseIDs[group.numbers[i]]; ok {
+			mappings = append(mappings, entity.LegacyIDMapping{
+				Source:      input.Source,
+				LegacyTable: input.Table,
+				LegacyID:    record.ID,
+				EntityTable: versesTable,
+				EntityID:    verseID,
+			})
+		}
+	}
+	if err := u.legacyRepo.SaveIDMappings(ctx, mappings); err != nil {
+		u.log.Error("failed to record legacy ids", "error", err, "chapter_id", chapter.ChapterID)
+		return chapter, domain.NewInternalError("failed to record legacy ids", err)
+	}
+	return chapter, nil
+}
+
+// validateInput checks the input and mapping before any record is looked at
+func validateInput(input portuc.LegacyImportInput) error {
+	if strings.TrimSpace(input.Source) == "" {
+		return ErrSourceRequired
+	}
+	if strings.TrimSpace(input.Table) == "" {
+		return ErrTableRequired
+	}
+	if len(input.Records) == 0 {
+		return ErrNoRecords
+	}
+	if len(input.Mapping.Muhuds) == 0 {
+		return ErrNoMuhuds
+	}
+	for _, number := range input.Mapping.Muhuds {
+		if number == 0 {
+			return ErrInvalidChapterNumber
+		}
+	}
+	if len(input.Mapping.Categories) == 0 && input.Mapping.DefaultCategory == "" {
+		return ErrNoCategories
+	}
+	for _, rule := range input.Mapping.Categories {
+		if rule.Column == "" || rule.Category == "" {
+			return ErrInvalidCategoryRule
+		}
+	}
+	return nil
+}
+
+// groupByChapter numbers the records and groups them by the chapter the mapping puts them
+// in, in the order chapters first appear. Records the mapping cannot place are skipped.
+func groupByChapter(records []entity.LegacyRecord, mapping portuc.LegacyMapping) ([]chapterRecords, []portuc.LegacySkippedRecord) {
+	numbers := verseNumbers(records)
+	var (
+		groups  []chapterRecords
+		skipped []portuc.LegacySkippedRecord
+	)
+	index := make(map[chapterKey]int)
+	for i, record := range records {
+		number, ok := mapping.Muhuds[record.MuhudID]
+		if !ok {
+			skipped = append(skipped, portuc.LegacySkippedRecord{
+				LegacyID: record.ID,
+				Reason:   fmt.Sprintf("muhud %d is not in the mapping", record.MuhudID),
+			})
+			continue
+		}
+		category := categoryOf(record, mapping)
+		if category == "" {
+			skipped = append(skipped, portuc.LegacySkippedRecord{
+				LegacyID: record.ID,
+				Reason:   "no category rule matches",
+			})
+			continue
+		}
+
+		key := chapterKey{category: category, number: number}
+		at, ok := index[key]
+		if !ok {
+			at = len(groups)
+			index[key] = at
+			groups = append(groups, chapterRecords{key: key})
+		}
+		groups[at].records = append(groups[at].records, record)
+		groups[at].numbers = append(groups[at].numbers, numbers[i])
+	}
+	return groups, skipped
+}
+
+// verseNumbers returns the verse number of each record: its position, or for tables
+// without one, its place among the records of its muhud in legacy ID order
+func verseNumbers(records []entity.LegacyRecord) []uint {
+	numbers := make([]uint, len(records))
+	unnumbered := make(map[int64][]int)
+	for i, record := range records {
+		if record.Position > 0 {
+			numbers[i] = record.Position
+			continue
+		}
+		unnumbered[record.MuhudID] = append(unnumbered[record.MuhudID], i)
+	}
+	for _, indexes := range unnumbered {
+		sort.Slice(indexes, func(a, b int) bool { return records[indexes[a]].ID < records[indexes[b]].ID })
+		for n, i := range indexes {
+			numbers[i] = uint(n + 1)
+		}
+	}
+	return numbers
+}
+
+// categoryOf returns the category slug of the first rule matching the record, or the
+// default category
+func categoryOf(record entity.LegacyRecord, mapping portuc.LegacyMapping) string {
+	for _, rule := range mapping.Categories {
+		if strings.EqualFold(strings.TrimSpace(record.Columns[rule.Column]), rule.Value) {
+			return rule.Category
+		}
+	}
+	return mapping.DefaultCategory
+}
+
+// toImportRow builds the verse import row of a record, leaving out empty optional text
+func toImportRow(record entity.LegacyRecord, number uint, language string) portuc.ImportVerseRow {
+	row := portuc.ImportVerseRow{
+		VerseNumber: number,
+		ArabicText:  strings.TrimSpace(record.Text),
+	}
+	if transliteration := strings.TrimSpace(record.Transliteration); transliteration != "" {
+		row.Transliteration = &transliteration
+	}
+	if translation := strings.TrimSpace(record.Translation); translation != "" {
+		row.Translations = map[string]string{language: translation}
+	}
+	return row
+}
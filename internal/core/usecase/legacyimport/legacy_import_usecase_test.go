@@ -0,0 +1,364 @@
+package legacyimport_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"ishari-backend/internal/core/entity"
+	"ishari-backend/internal/core/port/repository"
+	portuc "ishari-backend/internal/core/port/usecase"
+	"ishari-backend/internal/core/usecase/legacyimport"
+)
+
+// MockLegacyRepository is a manual mock for LegacyRepository
+type MockLegacyRepository struct {
+	FindChapterIDsFunc func(ctx context.Context, categorySlug string, chapterNumber, bookID uint) ([]uint, error)
+	SaveIDMappingsFunc func(ctx context.Context, mappings []entity.LegacyIDMapping) error
+}
+
+func (m *MockLegacyRepository) FindChapterIDs(ctx context.Context, categorySlug string, chapterNumber, bookID uint) ([]uint, error) {
+	if m.FindChapterIDsFunc != nil {
+		return m.FindChapterIDsFunc(ctx, categorySlug, chapterNumber, bookID)
+	}
+	return nil, nil
+}
+
+func (m *MockLegacyRepository) SaveIDMappings(ctx context.Context, mappings []entity.LegacyIDMapping) error {
+	if m.SaveIDMappingsFunc != nil {
+		return m.SaveIDMappingsFunc(ctx, mappings)
+	}
+	return nil
+}
+
+// MockVerseRepository is a manual mock for VerseRepository
+type MockVerseRepository struct {
+	VerseNumbersFunc func(ctx context.Context, chapterID uint) (map[uint]uint, error)
+}
+
+func (m *MockVerseRepository) Create(ctx context.Context, v *entity.Verse) error { return nil }
+func (m *MockVerseRepository) List(ctx context.Context, filter repository.VerseFilter) ([]entity.Verse, uint, error) {
+	return nil, 0, nil
+}
+func (m *MockVerseRepository) Update(ctx context.Context, v *entity.Verse) error { return nil }
+func (m *MockVerseRepository) Delete(ctx context.Context, id uint) error         { return nil }
+func (m *MockVerseRepository) BulkDelete(ctx context.Context, ids []uint) error  { return nil }
+func (m *MockVerseRepository) GetById(ctx context.Context, id uint) (*entity.Verse, error) {
+	return nil, nil
+}
+
+func (m *MockVerseRepository) Reorder(ctx context.Context, chapterID uint, verseIDs []uint) error {
+	return nil
+}
+
+func (m *MockVerseRepository) Move(ctx context.Context, id, chapterID, position uint) error {
+	return nil
+}
+
+func (m *MockVerseRepository) VerseNumbers(ctx context.Context, chapterID uint) (map[uint]uint, error) {
+	if m.VerseNumbersFunc != nil {
+		return m.VerseNumbersFunc(ctx, chapterID)
+	}
+	return nil, nil
+}
+
+func (m *MockVerseRepository) Import(ctx context.Context, chapterID uint, verses []entity.VerseImport) error {
+	return nil
+}
+
+// MockVerseUseCase is a manual mock for VerseUseCase
+type MockVerseUseCase struct {
+	ImportFunc func(ctx context.Context, input portuc.ImportVersesInput) (*portuc.ImportVersesResult, error)
+}
+
+func (m *MockVerseUseCase) Create(ctx context.Context, input portuc.CreateVerseInput) (*entity.Verse, error) {
+	return nil, nil
+}
+
+func (m *MockVerseUseCase) List(ctx context.Context, params portuc.ListParams) (*portuc.PaginatedResult[entity.Verse], error) {
+	return nil, nil
+}
+
+func (m *MockVerseUseCase) Update(ctx context.Context, id uint, input portuc.UpdateVerseInput) (*entity.Verse, error) {
+	return nil, nil
+}
+
+func (m *MockVerseUseCase) Delete(ctx context.Context, id uint) error {
+	return nil
+}
+
+func (m *MockVerseUseCase) BulkDelete(ctx context.Context, ids []uint) error {
+	return nil
+}
+
+func (m *MockVerseUseCase) GetById(ctx context.Context, id uint) (*entity.Verse, error) {
+	return nil, nil
+}
+
+func (m *MockVerseUseCase) Reorder(ctx context.Context, chapterID uint, verseIDs []uint) error {
+	return nil
+}
+
+func (m *MockVerseUseCase) Move(ctx context.Context, id uint, input portuc.MoveVerseInput) (*entity.Verse, error) {
+	return nil, nil
+}
+
+func (m *MockVerseUseCase) Import(ctx context.Context, input portuc.ImportVersesInput) (*portuc.ImportVersesResult, error) {
+	if m.ImportFunc != nil {
+		return m.ImportFunc(ctx, input)
+	}
+	result := &portuc.ImportVersesResult{DryRun: input.DryRun, Valid: true}
+	for i, row := range input.Rows {
+		result.Rows = append(result.Rows, portuc.ImportRowReport{Row: i + 1, VerseNumber: row.VerseNumber, Action: "create"})
+		result.Created++
+	}
+	return result, nil
+}
+
+// MockLogger is a manual mock
+type MockLogger struct{}
+
+func (m *MockLogger) Info(msg string, fields ...any)  {}
+func (m *MockLogger) Error(msg string, fields ...any) {}
+
+// diwanDibaMapping places muhuds 10 and 20 in chapters 1 and 2 of Diwan, or of Diba when
+// is_diba is Y
+var diwanDibaMapping = portuc.LegacyMapping{
+	Muhuds:          map[int64]uint{10: 1, 20: 2},
+	Categories:      []portuc.LegacyCategoryRule{{Column: "is_diba", Value: "Y", Category: "diba"}},
+	DefaultCategory: "diwan",
+}
+
+func record(id, muhudID int64, position uint, text string, columns map[string]string) entity.LegacyRecord {
+	return entity.LegacyRecord{ID: id, MuhudID: muhudID, Position: position, Text: text, Columns: columns}
+}
+
+// chapterIDs resolves diwan chapters to 100+n and diba chapters to 200+n
+func chapterIDs(ctx context.Context, categorySlug string, chapterNumber, bookID uint) ([]uint, error) {
+	if categorySlug == "diba" {
+		return []uint{200 + chapterNumber}, nil
+	}
+	return []uint{100 + chapterNumber}, nil
+}
+
+func TestImport_UpsertsChaptersAndRecordsLegacyIDs(t *testing.T) {
+	var imports []portuc.ImportVersesInput
+	verseUC := &MockVerseUseCase{}
+	verseUC.ImportFunc = func(ctx context.Context, input portuc.ImportVersesInput) (*portuc.ImportVersesResult, error) {
+		imports = append(imports, input)
+		return (&MockVerseUseCase{}).Import(ctx, input)
+	}
+	verseRepo := &MockVerseRepository{
+		VerseNumbersFunc: func(ctx context.Context, chapterID uint) (map[uint]uint, error) {
+			return map[uint]uint{1: chapterID*10 + 1, 2: chapterID*10 + 2}, nil
+		},
+	}
+	var mappings []entity.LegacyIDMapping
+	legacyRepo := &MockLegacyRepository{
+		FindChapterIDsFunc: chapterIDs,
+		SaveIDMappingsFunc: func(ctx context.Context, m []entity.LegacyIDMapping) error {
+			mappings = append(mappings, m...)
+			return nil
+		},
+	}
+	uc := legacyimport.NewLegacyImportUsecase(legacyRepo, verseRepo, verseUC, &MockLogger{})
+
+	records := []entity.LegacyRecord{
+		record(1, 10, 1, " نص ", map[string]string{"is_diba": "N"}),
+		record(2, 10, 2, "نص", map[string]string{"is_diba": "N"}),
+		record(3, 20, 1, "نص", map[string]string{"is_diba": "Y"}),
+		record(4, 99, 1, "نص", nil),
+	}
+	records[0].Transliteration = "bismillah"
+	records[0].Translation = "Dengan nama Allah"
+
+	report, err := uc.Import(context.Background(), portuc.LegacyImportInput{
+		Source: "ishari", Table: "arabic_text", Records: records, Mapping: diwanDibaMapping,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if report.Records != 4 || report.Created != 3 || report.Failed != 0 {
+		t.Errorf("unexpected totals: %+v", report)
+	}
+	if len(report.Skipped) != 1 || report.Skipped[0].LegacyID != 4 {
+		t.Errorf("expected record 4 to be skipped, got %+v", report.Skipped)
+	}
+
+	if len(imports) != 2 || imports[0].ChapterID != 101 || imports[1].ChapterID != 202 {
+		t.Fatalf("expected imports into chapters 101 and 202, got %+v", imports)
+	}
+	if imports[0].OnConflict != portuc.ImportOnConflictUpdate {
+		t.Errorf("expected existing verses to be updated, got %q", imports[0].OnConflict)
+	}
+	first := imports[0].Rows[0]
+	if first.ArabicText != "نص" || first.Transliteration == nil || *first.Transliteration != "bismillah" {
+		t.Errorf("unexpected row: %+v", first)
+	}
+	if !reflect.DeepEqual(first.Translations, map[string]string{"id": "Dengan nama Allah"}) {
+		t.Errorf("expected the translation in id, got %v", first.Translations)
+	}
+	if imports[0].Rows[1].Transliteration != nil || imports[0].Rows[1].Translations != nil {
+		t.Errorf("expected empty text to be left out, got %+v", imports[0].Rows[1])
+	}
+
+	want := []entity.LegacyIDMapping{
+		{Source: "ishari", LegacyTable: "arabic_text", LegacyID: 1, EntityTable: "verses", EntityID: 1011},
+		{Source: "ishari", LegacyTable: "arabic_text", LegacyID: 2, EntityTable: "verses", EntityID: 1012},
+		{Source: "ishari", LegacyTable: "arabic_text", LegacyID: 3, EntityTable: "verses", EntityID: 2021},
+	}
+	if !reflect.DeepEqual(mappings, want) {
+		t.Errorf("unexpected mappings:\n got %+v\nwant %+v", mappings, want)
+	}
+}
+
+func TestImport_NumbersRecordsWithoutPositionByLegacyID(t *testing.T) {
+	var numbers []uint
+	verseUC := &MockVerseUseCase{}
+	verseUC.ImportFunc = func(ctx context.Context, input portuc.ImportVersesInput) (*portuc.ImportVersesResult, error) {
+		for _, row := range input.Rows {
+			numbers = append(numbers, row.VerseNumber)
+		}
+		return (&MockVerseUseCase{}).Import(ctx, input)
+	}
+	uc := legacyimport.NewLegacyImportUsecase(&MockLegacyRepository{FindChapterIDsFunc: chapterIDs}, &MockVerseRepository{}, verseUC, &MockLogger{})
+
+	// Muhud 10 mixes Diwan and Diba records; numbering follows the muhud, not the category
+	records := []entity.LegacyRecord{
+		record(30, 10, 0, "c", nil),
+		record(10, 10, 0, "a", nil),
+		record(20, 10, 0, "b", map[string]string{"is_diba": "y"}),
+	}
+	report, err := uc.Import(context.Background(), portuc.LegacyImportInput{
+		Source: "ishari", Table: "shalawats", Records: records, Mapping: diwanDibaMapping, DryRun: true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(numbers, []uint{3, 1, 2}) {
+		t.Errorf("expected verse numbers 3, 1, 2, got %v", numbers)
+	}
+	if len(report.Chapters) != 2 || report.Chapters[1].Category != "diba" {
+		t.Errorf("expected a diwan and a diba chapter, got %+v", report.Chapters)
+	}
+}
+
+func TestImport_DryRunWritesNothing(t *testing.T) {
+	verseRepo := &MockVerseRepository{
+		VerseNumbersFunc: func(ctx context.Context, chapterID uint) (map[uint]uint, error) {
+			t.Error("dry run must not read the imported verses")
+			return nil, nil
+		},
+	}
+	legacyRepo := &MockLegacyRepository{
+		FindChapterIDsFunc: chapterIDs,
+		SaveIDMappingsFunc: func(ctx context.Context, mappings []entity.LegacyIDMapping) error {
+			t.Error("dry run must not record legacy ids")
+			return nil
+		},
+	}
+	dryRun := false
+	verseUC := &MockVerseUseCase{}
+	verseUC.ImportFunc = func(ctx context.Context, input portuc.ImportVersesInput) (*portuc.ImportVersesResult, error) {
+		dryRun = input.DryRun
+		return (&MockVerseUseCase{}).Import(ctx, input)
+	}
+	uc := legacyimport.NewLegacyImportUsecase(legacyRepo, verseRepo, verseUC, &MockLogger{})
+
+	report, err := uc.Import(context.Background(), portuc.LegacyImportInput{
+		Source: "ishari", Table: "arabic_text", Records: []entity.LegacyRecord{record(1, 10, 1, "نص", nil)},
+		Mapping: diwanDibaMapping, DryRun: true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !dryRun || !report.DryRun || report.Created != 1 {
+		t.Errorf("expected a dry run creating one verse, got %+v", report)
+	}
+}
+
+func TestImport_ReportsChaptersThatCannotBeImported(t *testing.T) {
+	legacyRepo := &MockLegacyRepository{
+		FindChapterIDsFunc: func(ctx context.Context, categorySlug string, chapterNumber, bookID uint) ([]uint, error) {
+			switch chapterNumber {
+			case 1:
+				return nil, nil
+			case 2:
+				return []uint{5, 6}, nil
+			}
+			return []uint{7}, nil
+		},
+	}
+	verseUC := &MockVerseUseCase{
+		ImportFunc: func(ctx context.Context, input portuc.ImportVersesInput) (*portuc.ImportVersesResult, error) {
+			return &portuc.ImportVersesResult{Valid: false, Rows: []portuc.ImportRowReport{
+				{Row: 1, VerseNumber: 1, Errors: []string{"verse text is required"}},
+			}}, nil
+		},
+	}
+	uc := legacyimport.NewLegacyImportUsecase(legacyRepo, &MockVerseRepository{}, verseUC, &MockLogger{})
+
+	mapping := diwanDibaMapping
+	mapping.Muhuds = map[int64]uint{10: 1, 20: 2, 30: 3}
+	report, err := uc.Import(context.Background(), portuc.LegacyImportInput{
+		Source: "ishari", Table: "arabic_text", Mapping: mapping,
+		Records: []entity.LegacyRecord{record(1, 10, 1, "a", nil), record(2, 20, 1, "b", nil), record(3, 30, 1, "", nil)},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if report.Failed != 3 || report.Created != 0 {
+		t.Fatalf("expected three failed chapters, got %+v", report)
+	}
+	checks := []string{"chapter not found", "exists in 2 books", "legacy id 3, verse 1: verse text is required"}
+	for i, check := range checks {
+		errs := report.Chapters[i].Errors
+		if len(errs) != 1 || !strings.Contains(errs[0], check) {
+			t.Errorf("chapter %d: expected an error containing %q, got %v", i, check, errs)
+		}
+	}
+}
+
+func TestImport_InternalErrorAborts(t *testing.T) {
+	dbErr := errors.New("connection refused")
+	legacyRepo := &MockLegacyRepository{
+		FindChapterIDsFunc: func(ctx context.Context, categorySlug string, chapterNumber, bookID uint) ([]uint, error) {
+			return nil, dbErr
+		},
+	}
+	uc := legacyimport.NewLegacyImportUsecase(legacyRepo, &MockVerseRepository{}, &MockVerseUseCase{}, &MockLogger{})
+
+	_, err := uc.Import(context.Background(), portuc.LegacyImportInput{
+		Source: "ishari", Table: "arabic_text", Records: []entity.LegacyRecord{record(1, 10, 1, "a", nil)}, Mapping: diwanDibaMapping,
+	})
+	if !errors.Is(err, dbErr) {
+		t.Errorf("expected the database error, got %v", err)
+	}
+}
+
+func TestImport_InvalidInput(t *testing.T) {
+	records := []entity.LegacyRecord{record(1, 10, 1, "a", nil)}
+	tests := []struct {
+		name  string
+		input portuc.LegacyImportInput
+		want  error
+	}{
+		{"no source", portuc.LegacyImportInput{Table: "t", Records: records, Mapping: diwanDibaMapping}, legacyimport.ErrSourceRequired},
+		{"no table", portuc.LegacyImportInput{Source: "s", Records: records, Mapping: diwanDibaMapping}, legacyimport.ErrTableRequired},
+		{"no records", portuc.LegacyImportInput{Source: "s", Table: "t", Mapping: diwanDibaMapping}, legacyimport.ErrNoRecords},
+		{"no muhuds", portuc.LegacyImportInput{Source: "s", Table: "t", Records: records, Mapping: portuc.LegacyMapping{DefaultCategory: "diwan"}}, legacyimport.ErrNoMuhuds},
+		{"chapter 0", portuc.LegacyImportInput{Source: "s", Table: "t", Records: records, Mapping: portuc.LegacyMapping{Muhuds: map[int64]uint{1: 0}, DefaultCategory: "diwan"}}, legacyimport.ErrInvalidChapterNumber},
+		{"no categories", portuc.LegacyImportInput{Source: "s", Table: "t", Records: records, Mapping: portuc.LegacyMapping{Muhuds: map[int64]uint{1: 1}}}, legacyimport.ErrNoCategories},
+		{"incomplete rule", portuc.LegacyImportInput{Source: "s", Table: "t", Records: records, Mapping: portuc.LegacyMapping{Muhuds: map[int64]uint{1: 1}, Categories: []portuc.LegacyCategoryRule{{Column: "is_diba"}}}}, legacyimport.ErrInvalidCategoryRule},
+	}
+	uc := legacyimport.NewLegacyImportUsecase(&MockLegacyRepository{}, &MockVerseRepository{}, &MockVerseUseCase{}, &MockLogger{})
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := uc.Import(context.Background(), tt.input); !errors.Is(err, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package mediastream
+
+import "ishari-backend/internal/core/domain"
+
+// Media stream domain errors
+var (
+	// ErrMediaNotFound indicates the verse media does not exist
+	ErrMediaNotFound = domain.NewNotFoundError("verse media not found", nil)
+
+	// ErrFileNotFound indicates the media record points to a file missing from storage
+	ErrFileNotFound = domain.NewNotFoundError("media file not found", nil)
+
+	// ErrInvalidRange indicates the requested byte range is outside the file
+	ErrInvalidRange = domain.NewInvalidInputError("requested range is not satisfiable", nil)
+)
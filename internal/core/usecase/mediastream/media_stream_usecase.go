@@ -0,0 +1,75 @@
+package mediastream
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"ishari-backend/internal/core/domain"
+	"ishari-backend/internal/core/port/logger"
+	"ishari-backend/internal/core/port/repository"
+	"ishari-backend/internal/core/port/storage"
+	portuc "ishari-backend/internal/core/port/usecase"
+)
+
+type mediaStreamUsecase struct {
+	mediaRepo repository.VerseMediaRepository
+	storage   storage.Storage
+	log       logger.Logger
+}
+
+func NewMediaStreamUsecase(mediaRepo repository.VerseMediaRepository, store storage.Storage, log logger.Logger) portuc.MediaStreamUseCase {
+	return &mediaStreamUsecase{
+		mediaRepo: mediaRepo,
+		storage:   store,
+		log:       log,
+	}
+}
+
+// Resolve finds the verse media and stats its file in storage
+func (u *mediaStreamUsecase) Resolve(ctx context.Context, id uint) (*portuc.MediaObject, error) {
+	media, err := u.mediaRepo.GetById(ctx, id)
+	if err != nil {
+		u.log.Error("failed to get verse media for streaming", "error", err, "media_id", id)
+		return nil, domain.NewInternalError("failed to get verse media", err)
+	}
+	if media == nil {
+		return nil, ErrMediaNotFound
+	}
+
+	key, ok := u.storage.KeyFromURL(media.MediaURL)
+	if !ok {
+		return &portuc.MediaObject{Media: media, ExternalURL: media.MediaURL}, nil
+	}
+
+	info, err := u.storage.Stat(ctx, key)
+	if err != nil {
+		if errors.Is(err, storage.ErrObjectNotFound) {
+			return nil, ErrFileNotFound
+		}
+		u.log.Error("failed to stat media file", "error", err, "media_id", id, "key", key)
+		return nil, domain.NewInternalError("failed to read media file", err)
+	}
+
+	return &portuc.MediaObject{Media: media, Key: key, Info: info}, nil
+}
+
+// Open returns a reader over [offset, offset+length) of the stored file
+func (u *mediaStreamUsecase) Open(ctx context.Context, obj *portuc.MediaObject, offset, length int64) (io.ReadCloser, error) {
+	if obj == nil || obj.Info == nil {
+		return nil, ErrFileNotFound
+	}
+	if offset < 0 || length < 0 || offset+length > obj.Info.Size {
+		return nil, ErrInvalidRange
+	}
+
+	rc, err := u.storage.GetRange(ctx, obj.Key, offset, length)
+	if err != nil {
+		if errors.Is(err, storage.ErrObjectNotFound) {
+			return nil, ErrFileNotFound
+		}
+		u.log.Error("failed to open media file", "error", err, "key", obj.Key)
+		return nil, domain.NewInternalError("failed to read media file", err)
+	}
+	return rc, nil
+}
@@ -0,0 +1,176 @@
+package mediastream_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"ishari-backend/internal/core/entity"
+	"ishari-backend/internal/core/port/repository"
+	"ishari-backend/internal/core/port/storage"
+	portuc "ishari-backend/internal/core/port/usecase"
+	"ishari-backend/internal/core/usecase/mediastream"
+)
+
+// MockVerseMediaRepository is a manual mock for testing
+type MockVerseMediaRepository struct {
+	GetByIdFunc func(ctx context.Context, id uint) (*entity.VerseMedia, error)
+}
+
+func (m *MockVerseMediaRepository) Create(ctx context.Context, media *entity.VerseMedia) error {
+	return nil
+}
+func (m *MockVerseMediaRepository) List(ctx context.Context, filter repository.VerseMediaFilter) ([]entity.VerseMedia, uint, error) {
+	return nil, 0, nil
+}
+func (m *MockVerseMediaRepository) GetById(ctx context.Context, id uint) (*entity.VerseMedia, error) {
+	if m.GetByIdFunc != nil {
+		return m.GetByIdFunc(ctx, id)
+	}
+	return nil, nil
+}
+func (m *MockVerseMediaRepository) Update(ctx context.Context, media *entity.VerseMedia) error {
+	return nil
+}
+func (m *MockVerseMediaRepository) Delete(ctx context.Context, id uint) error { return nil }
+
+// MockStorage is an in-memory storage for testing
+type MockStorage struct {
+	Objects map[string][]byte
+}
+
+func (m *MockStorage) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) (*storage.ObjectInfo, error) {
+	return nil, nil
+}
+func (m *MockStorage) Get(ctx context.Context, key string) (io.ReadCloser, *storage.ObjectInfo, error) {
+	return nil, nil, nil
+}
+func (m *MockStorage) Stat(ctx context.Context, key string) (*storage.ObjectInfo, error) {
+	data, ok := m.Objects[key]
+	if !ok {
+		return nil, storage.ErrObjectNotFound
+	}
+	return &storage.ObjectInfo{Key: key, Size: int64(len(data)), ContentType: "audio/mpeg", ETag: `"etag"`}, nil
+}
+func (m *MockStorage) GetRange(ctx context.Context, key string, offset, length int64) (io.ReadCloser, error) {
+	data, ok := m.Objects[key]
+	if !ok {
+		return nil, storage.ErrObjectNotFound
+	}
+	return io.NopCloser(bytes.NewReader(data[offset : offset+length])), nil
+}
+func (m *MockStorage) Delete(ctx context.Context, key string) error { return nil }
+func (m *MockStorage) Presign(ctx context.Context, key string, ttl time.Duration) (string, error) {
+	return m.URL(key), nil
+}
+func (m *MockStorage) URL(key string) string { return "http://files.test/" + key }
+func (m *MockStorage) KeyFromURL(url string) (string, bool) {
+	return strings.CutPrefix(url, "http://files.test/")
+}
+
+// MockLogger is a manual mock for testing
+type MockLogger struct{}
+
+func (m *MockLogger) Info(msg string, fields ...any)  {}
+func (m *MockLogger) Error(msg string, fields ...any) {}
+
+func newUsecase(mediaURL string, objects map[string][]byte) portuc.MediaStreamUseCase {
+	repo := &MockVerseMediaRepository{
+		GetByIdFunc: func(ctx context.Context, id uint) (*entity.VerseMedia, error) {
+			if id != 1 {
+				return nil, nil
+			}
+			return &entity.VerseMedia{ID: id, MediaType: entity.MediaTypeAudio, MediaURL: mediaURL}, nil
+		},
+	}
+	return mediastream.NewMediaStreamUsecase(repo, &MockStorage{Objects: objects}, &MockLogger{})
+}
+
+// =============================================================================
+// TEST: Resolve
+// =============================================================================
+
+func TestMediaStreamUseCase_Resolve_Stored(t *testing.T) {
+	uc := newUsecase("http://files.test/verse-media/1/a.mp3", map[string][]byte{"verse-media/1/a.mp3": []byte("0123456789")})
+
+	obj, err := uc.Resolve(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if obj.Key != "verse-media/1/a.mp3" || obj.Info == nil || obj.Info.Size != 10 {
+		t.Errorf("unexpected media object: %+v", obj)
+	}
+	if obj.ExternalURL != "" {
+		t.Errorf("expected no external url, got %s", obj.ExternalURL)
+	}
+}
+
+func TestMediaStreamUseCase_Resolve_External(t *testing.T) {
+	uc := newUsecase("https://cdn.example.com/a.mp3", nil)
+
+	obj, err := uc.Resolve(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if obj.ExternalURL != "https://cdn.example.com/a.mp3" {
+		t.Errorf("expected external url, got %q", obj.ExternalURL)
+	}
+}
+
+func TestMediaStreamUseCase_Resolve_MediaNotFound(t *testing.T) {
+	uc := newUsecase("http://files.test/a.mp3", nil)
+
+	_, err := uc.Resolve(context.Background(), 2)
+
+	if !errors.Is(err, mediastream.ErrMediaNotFound) {
+		t.Errorf("expected ErrMediaNotFound, got %v", err)
+	}
+}
+
+func TestMediaStreamUseCase_Resolve_FileMissing(t *testing.T) {
+	uc := newUsecase("http://files.test/missing.mp3", map[string][]byte{})
+
+	_, err := uc.Resolve(context.Background(), 1)
+
+	if !errors.Is(err, mediastream.ErrFileNotFound) {
+		t.Errorf("expected ErrFileNotFound, got %v", err)
+	}
+}
+
+// =============================================================================
+// TEST: Open
+// =============================================================================
+
+func TestMediaStreamUseCase_Open_Range(t *testing.T) {
+	uc := newUsecase("http://files.test/a.mp3", map[string][]byte{"a.mp3": []byte("0123456789")})
+	obj, err := uc.Resolve(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	rc, err := uc.Open(context.Background(), obj, 2, 3)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	defer rc.Close()
+
+	got, _ := io.ReadAll(rc)
+	if string(got) != "234" {
+		t.Errorf("expected 234, got %q", got)
+	}
+}
+
+func TestMediaStreamUseCase_Open_OutOfBounds(t *testing.T) {
+	uc := newUsecase("http://files.test/a.mp3", map[string][]byte{"a.mp3": []byte("0123456789")})
+	obj, _ := uc.Resolve(context.Background(), 1)
+
+	_, err := uc.Open(context.Background(), obj, 8, 5)
+
+	if !errors.Is(err, mediastream.ErrInvalidRange) {
+		t.Errorf("expected ErrInvalidRange, got %v", err)
+	}
+}
@@ -0,0 +1,18 @@
+package reader
+
+import "ishari-backend/internal/core/domain"
+
+// Reader domain errors
+var (
+	// ErrBookNotFound indicates the book does not exist
+	ErrBookNotFound = domain.NewNotFoundError("book not found", nil)
+
+	// ErrChapterNotFound indicates the chapter does not exist
+	ErrChapterNotFound = domain.NewNotFoundError("chapter not found", nil)
+
+	// ErrInvalidLanguage indicates a requested language is not a language code
+	ErrInvalidLanguage = domain.NewInvalidInputError("lang must be a comma-separated list of language codes such as id,en", nil)
+
+	// ErrInvalidMediaType indicates a requested media type is not supported
+	ErrInvalidMediaType = domain.NewInvalidInputError("media must be a comma-separated list of audio, image", nil)
+)
@@ -0,0 +1,125 @@
+package reader
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+
+	"ishari-backend/internal/core/domain"
+	"ishari-backend/internal/core/entity"
+	"ishari-backend/internal/core/port/logger"
+	"ishari-backend/internal/core/port/repository"
+	portuc "ishari-backend/internal/core/port/usecase"
+)
+
+type readerUsecase struct {
+	readerRepo repository.ReaderRepository
+	log        logger.Logger
+}
+
+func NewReaderUsecase(readerRepo repository.ReaderRepository, log logger.Logger) portuc.ReaderUseCase {
+	return &readerUsecase{
+		readerRepo: readerRepo,
+		log:        log,
+	}
+}
+
+// GetChapter returns a chapter with its book and all verses, each carrying the requested
+// translations (in the requested language order) and media
+func (u *readerUsecase) GetChapter(ctx context.Context, params portuc.ChapterReaderParams) (*entity.ChapterReader, error) {
+	if params.ChapterID == 0 {
+		return nil, ErrChapterNotFound
+	}
+
+	languages := dedupe(params.Languages)
+	for _, lang := range languages {
+		if !domain.IsLanguageCode(lang) {
+			return nil, ErrInvalidLanguage
+		}
+	}
+	mediaTypes := dedupe(params.MediaTypes)
+	for _, mediaType := range mediaTypes {
+		if mediaType != entity.MediaTypeAudio && mediaType != entity.MediaTypeImage {
+			return nil, ErrInvalidMediaType
+		}
+	}
+
+	reader, err := u.readerRepo.GetChapterReader(ctx, repository.ChapterReaderFilter{
+		ChapterID:  params.ChapterID,
+		Languages:  languages,
+		MediaTypes: mediaTypes,
+	})
+	if err != nil {
+		u.log.Error("failed to load chapter reader", "error", err, "chapter_id", params.ChapterID)
+		return nil, domain.NewInternalError("failed to load chapter", err)
+	}
+	if reader == nil {
+		return nil, ErrChapterNotFound
+	}
+
+	if len(languages) > 1 {
+		for i := range reader.Verses {
+			slices.SortStableFunc(reader.Verses[i].Translations, func(a, b entity.Translation) int {
+				return slices.Index(languages, a.LanguageCode) - slices.Index(languages, b.LanguageCode)
+			})
+		}
+	}
+	return reader, nil
+}
+
+// GetBookTOC returns a book with its chapters grouped by category. Categories follow their
+// sort order and empty categories are omitted.
+func (u *readerUsecase) GetBookTOC(ctx context.Context, bookID int) (*entity.BookTOC, error) {
+	if bookID <= 0 {
+		return nil, ErrBookNotFound
+	}
+
+	book, chapters, err := u.readerRepo.GetBookTOC(ctx, bookID)
+	if err != nil {
+		u.log.Error("failed to load book table of contents", "error", err, "book_id", bookID)
+		return nil, domain.NewInternalError("failed to load table of contents", err)
+	}
+	if book == nil {
+		return nil, ErrBookNotFound
+	}
+
+	toc := &entity.BookTOC{Book: *book, Categories: []entity.TOCCategory{}}
+	position := make(map[uint]int)
+	for _, chapter := range chapters {
+		for i := range chapter.Translations {
+			if chapter.VerseCount > 0 {
+				chapter.Translations[i].Coverage = float64(chapter.Translations[i].TranslatedVerses) / float64(chapter.VerseCount)
+			}
+		}
+		i, ok := position[chapter.CategoryID]
+		if !ok {
+			i = len(toc.Categories)
+			position[chapter.CategoryID] = i
+			toc.Categories = append(toc.Categories, entity.TOCCategory{
+				ID:        chapter.CategoryID,
+				Slug:      chapter.CategorySlug,
+				Name:      chapter.Category,
+				SortOrder: chapter.CategoryOrder,
+			})
+		}
+		toc.Categories[i].Chapters = append(toc.Categories[i].Chapters, chapter)
+	}
+
+	slices.SortStableFunc(toc.Categories, func(a, b entity.TOCCategory) int {
+		return cmp.Or(cmp.Compare(a.SortOrder, b.SortOrder), cmp.Compare(a.ID, b.ID))
+	})
+	return toc, nil
+}
+
+// dedupe trims the values and drops empty and repeated ones, keeping the first occurrence
+func dedupe(values []string) []string {
+	var out []string
+	for _, v := range values {
+		v = strings.TrimSpace(v)
+		if v != "" && !slices.Contains(out, v) {
+			out = append(out, v)
+		}
+	}
+	return out
+}
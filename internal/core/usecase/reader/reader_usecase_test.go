@@ -0,0 +1,249 @@
+package reader_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"ishari-backend/internal/core/entity"
+	"ishari-backend/internal/core/port/repository"
+	portuc "ishari-backend/internal/core/port/usecase"
+	"ishari-backend/internal/core/usecase/reader"
+)
+
+// MockReaderRepository is a manual mock for testing
+type MockReaderRepository struct {
+	GetChapterReaderFunc func(ctx context.Context, filter repository.ChapterReaderFilter) (*entity.ChapterReader, error)
+	GetBookTOCFunc       func(ctx context.Context, bookID int) (*entity.Book, []entity.TOCChapter, error)
+}
+
+func (m *MockReaderRepository) GetChapterReader(ctx context.Context, filter repository.ChapterReaderFilter) (*entity.ChapterReader, error) {
+	if m.GetChapterReaderFunc != nil {
+		return m.GetChapterReaderFunc(ctx, filter)
+	}
+	return nil, nil
+}
+
+func (m *MockReaderRepository) GetBookTOC(ctx context.Context, bookID int) (*entity.Book, []entity.TOCChapter, error) {
+	if m.GetBookTOCFunc != nil {
+		return m.GetBookTOCFunc(ctx, bookID)
+	}
+	return nil, nil, nil
+}
+
+// MockLogger is a manual mock for testing
+type MockLogger struct{}
+
+func (m *MockLogger) Info(msg string, fields ...any)  {}
+func (m *MockLogger) Error(msg string, fields ...any) {}
+
+// =============================================================================
+// TEST: Get Chapter Reader
+// =============================================================================
+
+func TestReaderUseCase_GetChapter_Success(t *testing.T) {
+	var got repository.ChapterReaderFilter
+	repo := &MockReaderRepository{
+		GetChapterReaderFunc: func(ctx context.Context, filter repository.ChapterReaderFilter) (*entity.ChapterReader, error) {
+			got = filter
+			return &entity.ChapterReader{
+				Chapter: entity.Chapter{ID: filter.ChapterID, Title: "Asyraqal", Book: &entity.Book{ID: 1, Title: "Diwan"}},
+				Verses: []entity.ReaderVerse{
+					{
+						Verse: entity.Verse{ID: 10, VerseNumber: 1},
+						// repository order is by language code
+						Translations: []entity.Translation{
+							{ID: 2, LanguageCode: "en"},
+							{ID: 1, LanguageCode: "id"},
+						},
+						Media: []entity.VerseMedia{{ID: 5, MediaType: entity.MediaTypeAudio}},
+					},
+					{Verse: entity.Verse{ID: 11, VerseNumber: 2}},
+				},
+			}, nil
+		},
+	}
+	uc := reader.NewReaderUsecase(repo, &MockLogger{})
+
+	result, err := uc.GetChapter(context.Background(), portuc.ChapterReaderParams{
+		ChapterID:  3,
+		Languages:  []string{" id", "en", "id", ""},
+		MediaTypes: []string{"audio"},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got.ChapterID != 3 || len(got.Languages) != 2 || got.Languages[0] != "id" || got.Languages[1] != "en" {
+		t.Errorf("expected deduplicated languages [id en] for chapter 3, got %+v", got)
+	}
+	if len(got.MediaTypes) != 1 || got.MediaTypes[0] != entity.MediaTypeAudio {
+		t.Errorf("expected media filter [audio], got %v", got.MediaTypes)
+	}
+	if len(result.Verses) != 2 {
+		t.Fatalf("expected 2 verses, got %d", len(result.Verses))
+	}
+	translations := result.Verses[0].Translations
+	if translations[0].LanguageCode != "id" || translations[1].LanguageCode != "en" {
+		t.Errorf("expected translations in requested order id, en, got %s, %s", translations[0].LanguageCode, translations[1].LanguageCode)
+	}
+}
+
+func TestReaderUseCase_GetChapter_AllTranslationsAndMedia(t *testing.T) {
+	var got repository.ChapterReaderFilter
+	repo := &MockReaderRepository{
+		GetChapterReaderFunc: func(ctx context.Context, filter repository.ChapterReaderFilter) (*entity.ChapterReader, error) {
+			got = filter
+			return &entity.ChapterReader{Chapter: entity.Chapter{ID: filter.ChapterID}}, nil
+		},
+	}
+	uc := reader.NewReaderUsecase(repo, &MockLogger{})
+
+	if _, err := uc.GetChapter(context.Background(), portuc.ChapterReaderParams{ChapterID: 3}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got.Languages != nil || got.MediaTypes != nil {
+		t.Errorf("expected no language or media filter, got %+v", got)
+	}
+}
+
+func TestReaderUseCase_GetChapter_NotFound(t *testing.T) {
+	uc := reader.NewReaderUsecase(&MockReaderRepository{}, &MockLogger{})
+
+	if _, err := uc.GetChapter(context.Background(), portuc.ChapterReaderParams{ChapterID: 99}); !errors.Is(err, reader.ErrChapterNotFound) {
+		t.Errorf("expected ErrChapterNotFound, got %v", err)
+	}
+}
+
+func TestReaderUseCase_GetChapter_InvalidInput(t *testing.T) {
+	repo := &MockReaderRepository{
+		GetChapterReaderFunc: func(ctx context.Context, filter repository.ChapterReaderFilter) (*entity.ChapterReader, error) {
+			t.Fatal("repository must not be called for invalid input")
+			return nil, nil
+		},
+	}
+	uc := reader.NewReaderUsecase(repo, &MockLogger{})
+
+	tests := []struct {
+		name   string
+		params portuc.ChapterReaderParams
+		want   error
+	}{
+		{name: "missing chapter", params: portuc.ChapterReaderParams{}, want: reader.ErrChapterNotFound},
+		{name: "invalid language", params: portuc.ChapterReaderParams{ChapterID: 1, Languages: []string{"id", "e'n"}}, want: reader.ErrInvalidLanguage},
+		{name: "invalid media type", params: portuc.ChapterReaderParams{ChapterID: 1, MediaTypes: []string{"video"}}, want: reader.ErrInvalidMediaType},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := uc.GetChapter(context.Background(), tt.params); !errors.Is(err, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestReaderUseCase_GetChapter_RepositoryError(t *testing.T) {
+	repo := &MockReaderRepository{
+		GetChapterReaderFunc: func(ctx context.Context, filter repository.ChapterReaderFilter) (*entity.ChapterReader, error) {
+			return nil, errors.New("db down")
+		},
+	}
+	uc := reader.NewReaderUsecase(repo, &MockLogger{})
+
+	if _, err := uc.GetChapter(context.Background(), portuc.ChapterReaderParams{ChapterID: 1}); err == nil {
+		t.Fatal("expected error, got nil")
+	}
+}
+
+// =============================================================================
+// TEST: Get Book Table of Contents
+// =============================================================================
+
+func TestReaderUseCase_GetBookTOC_GroupsByCategory(t *testing.T) {
+	repo := &MockReaderRepository{
+		GetBookTOCFunc: func(ctx context.Context, bookID int) (*entity.Book, []entity.TOCChapter, error) {
+			// repository order is by chapter_number
+			return &entity.Book{ID: bookID, Title: "Maulid Syaraful Anam"}, []entity.TOCChapter{
+				{ID: 1, ChapterNumber: 1, CategoryID: 3, Category: "Muhud", CategoryOrder: 3, VerseCount: 4, HasAudio: true,
+					Translations: []entity.TranslationCoverage{{LanguageCode: "id", TranslatedVerses: 3}}},
+				{ID: 2, ChapterNumber: 2, CategoryID: 1, Category: "Diwan", CategoryOrder: 1, VerseCount: 0},
+				{ID: 3, ChapterNumber: 3, CategoryID: 7, Category: "Qasidah Banjar", CategoryOrder: 7, VerseCount: 2},
+				{ID: 4, ChapterNumber: 4, CategoryID: 3, Category: "Muhud", CategoryOrder: 3, VerseCount: 2,
+					Translations: []entity.TranslationCoverage{{LanguageCode: "en", TranslatedVerses: 2}}},
+			}, nil
+		},
+	}
+	uc := reader.NewReaderUsecase(repo, &MockLogger{})
+
+	toc, err := uc.GetBookTOC(context.Background(), 7)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if toc.Book.ID != 7 {
+		t.Errorf("expected book 7, got %d", toc.Book.ID)
+	}
+
+	var names []string
+	for _, category := range toc.Categories {
+		names = append(names, category.Name)
+	}
+	want := []string{"Diwan", "Muhud", "Qasidah Banjar"}
+	if len(names) != len(want) {
+		t.Fatalf("expected categories %v, got %v", want, names)
+	}
+	for i := range want {
+		if names[i] != want[i] {
+			t.Fatalf("expected categories %v, got %v", want, names)
+		}
+	}
+
+	muhud := toc.Categories[1].Chapters
+	if len(muhud) != 2 || muhud[0].ID != 1 || muhud[1].ID != 4 {
+		t.Fatalf("expected Muhud chapters 1 and 4 in order, got %+v", muhud)
+	}
+	if got := muhud[0].Translations[0].Coverage; got != 0.75 {
+		t.Errorf("expected coverage 0.75, got %v", got)
+	}
+	if got := muhud[1].Translations[0].Coverage; got != 1 {
+		t.Errorf("expected coverage 1, got %v", got)
+	}
+}
+
+func TestReaderUseCase_GetBookTOC_EmptyBook(t *testing.T) {
+	repo := &MockReaderRepository{
+		GetBookTOCFunc: func(ctx context.Context, bookID int) (*entity.Book, []entity.TOCChapter, error) {
+			return &entity.Book{ID: 1}, nil, nil
+		},
+	}
+	uc := reader.NewReaderUsecase(repo, &MockLogger{})
+
+	toc, err := uc.GetBookTOC(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if toc.Categories == nil || len(toc.Categories) != 0 {
+		t.Errorf("expected empty non-nil categories, got %v", toc.Categories)
+	}
+}
+
+func TestReaderUseCase_GetBookTOC_NotFound(t *testing.T) {
+	uc := reader.NewReaderUsecase(&MockReaderRepository{}, &MockLogger{})
+
+	for _, id := range []int{0, 99} {
+		if _, err := uc.GetBookTOC(context.Background(), id); !errors.Is(err, reader.ErrBookNotFound) {
+			t.Errorf("book %d: expected ErrBookNotFound, got %v", id, err)
+		}
+	}
+}
+
+func TestReaderUseCase_GetBookTOC_RepositoryError(t *testing.T) {
+	repo := &MockReaderRepository{
+		GetBookTOCFunc: func(ctx context.Context, bookID int) (*entity.Book, []entity.TOCChapter, error) {
+			return nil, nil, errors.New("db down")
+		},
+	}
+	uc := reader.NewReaderUsecase(repo, &MockLogger{})
+
+	if _, err := uc.GetBookTOC(context.Background(), 1); err == nil {
+		t.Fatal("expected error, got nil")
+	}
+}
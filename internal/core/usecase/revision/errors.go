@@ -0,0 +1,9 @@
+package revision
+
+import "ishari-backend/internal/core/domain"
+
+// Revision domain errors
+var (
+	// ErrRevisionNotFound indicates the revision does not exist or belongs to another row
+	ErrRevisionNotFound = domain.NewNotFoundError("revision not found", nil)
+)
@@ -0,0 +1,134 @@
+package revision
+
+import (
+	"context"
+	"encoding/json"
+
+	"ishari-backend/internal/core/domain"
+	"ishari-backend/internal/core/entity"
+	"ishari-backend/internal/core/port/logger"
+	"ishari-backend/internal/core/port/repository"
+	portuc "ishari-backend/internal/core/port/usecase"
+)
+
+const (
+	versesTable       = "verses"
+	translationsTable = "translations"
+)
+
+// verseSnapshot holds the restorable columns of a verses row in the audit log
+type verseSnapshot struct {
+	ArabicText      string  `json:"arabic_text"`
+	Transliteration *string `json:"transliteration"`
+}
+
+// translationSnapshot holds the restorable columns of a translations row in the audit log
+type translationSnapshot struct {
+	LanguageCode    string  `json:"language_code"`
+	TranslationText string  `json:"translation_text"`
+	TranslatorName  *string `json:"translator_name"`
+}
+
+type revisionUsecase struct {
+	auditRepo     repository.AuditLogRepository
+	verseUC       portuc.VerseUseCase
+	translationUC portuc.TranslationUseCase
+	log           logger.Logger
+}
+
+func NewRevisionUsecase(auditRepo repository.AuditLogRepository, verseUC portuc.VerseUseCase, translationUC portuc.TranslationUseCase, log logger.Logger) portuc.RevisionUseCase {
+	return &revisionUsecase{
+		auditRepo:     auditRepo,
+		verseUC:       verseUC,
+		translationUC: translationUC,
+		log:           log,
+	}
+}
+
+// ListVerseRevisions returns the versions of a verse, newest first
+func (u *revisionUsecase) ListVerseRevisions(ctx context.Context, verseID uint) ([]entity.Revision, error) {
+	return u.list(ctx, versesTable, verseID)
+}
+
+// RevertVerse restores the text and transliteration of a verse revision
+func (u *revisionUsecase) RevertVerse(ctx context.Context, verseID, revisionID uint) (*entity.Verse, error) {
+	var snapshot verseSnapshot
+	if err := u.snapshot(ctx, versesTable, verseID, revisionID, &snapshot); err != nil {
+		return nil, err
+	}
+	return u.verseUC.Update(ctx, verseID, portuc.UpdateVerseInput{
+		ArabicText:      &snapshot.ArabicText,
+		Transliteration: orEmpty(snapshot.Transliteration),
+	})
+}
+
+// ListTranslationRevisions returns the versions of a translation, newest first
+func (u *revisionUsecase) ListTranslationRevisions(ctx context.Context, translationID uint) ([]entity.Revision, error) {
+	return u.list(ctx, translationsTable, translationID)
+}
+
+// RevertTranslation restores the language, text and translator of a translation revision
+func (u *revisionUsecase) RevertTranslation(ctx context.Context, translationID, revisionID uint) (*entity.Translation, error) {
+	var snapshot translationSnapshot
+	if err := u.snapshot(ctx, translationsTable, translationID, revisionID, &snapshot); err != nil {
+		return nil, err
+	}
+	return u.translationUC.Update(ctx, translationID, portuc.UpdateTranslationInput{
+		LanguageCode:    &snapshot.LanguageCode,
+		TranslationText: &snapshot.TranslationText,
+		TranslatorName:  orEmpty(snapshot.TranslatorName),
+	})
+}
+
+// list turns the inserts and updates of a row into revisions. Deletes leave no version behind.
+func (u *revisionUsecase) list(ctx context.Context, table string, recordID uint) ([]entity.Revision, error) {
+	logs, err := u.auditRepo.List(ctx, repository.AuditLogFilter{Table: table, RecordID: &recordID})
+	if err != nil {
+		u.log.Error("failed to list revisions", "error", err, "table", table, "record_id", recordID)
+		return nil, domain.NewInternalError("failed to list revisions", err)
+	}
+
+	revisions := make([]entity.Revision, 0, len(logs))
+	for _, log := range logs {
+		if log.Operation == entity.AuditOperationDelete || len(log.NewData) == 0 {
+			continue
+		}
+		revisions = append(revisions, entity.Revision{
+			ID:                log.ID,
+			Operation:         log.Operation,
+			Data:              log.NewData,
+			ChangedByUserID:   log.ChangedByUserID,
+			ChangedByUsername: log.ChangedByUsername,
+			ChangedAt:         log.ChangedAt,
+		})
+	}
+	return revisions, nil
+}
+
+// snapshot decodes the row recorded by a revision of the given row into dst
+func (u *revisionUsecase) snapshot(ctx context.Context, table string, recordID, revisionID uint, dst any) error {
+	log, err := u.auditRepo.GetByID(ctx, revisionID)
+	if err != nil {
+		u.log.Error("failed to get revision", "error", err, "revision_id", revisionID)
+		return domain.NewInternalError("failed to get revision", err)
+	}
+	if log == nil || log.Table != table || log.RecordID != recordID ||
+		log.Operation == entity.AuditOperationDelete || len(log.NewData) == 0 {
+		return ErrRevisionNotFound
+	}
+
+	if err := json.Unmarshal(log.NewData, dst); err != nil {
+		u.log.Error("failed to decode revision", "error", err, "revision_id", revisionID)
+		return domain.NewInternalError("failed to decode revision", err)
+	}
+	return nil
+}
+
+// orEmpty maps a null column to an empty value, since a nil update input means "unchanged"
+func orEmpty(s *string) *string {
+	if s == nil {
+		empty := ""
+		return &empty
+	}
+	return s
+}
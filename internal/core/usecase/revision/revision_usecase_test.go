@@ -0,0 +1,271 @@
+package revision_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"ishari-backend/internal/core/entity"
+	"ishari-backend/internal/core/port/repository"
+	portuc "ishari-backend/internal/core/port/usecase"
+	"ishari-backend/internal/core/usecase/revision"
+)
+
+// MockAuditLogRepository is a manual mock for AuditLogRepository
+type MockAuditLogRepository struct {
+	ListFunc    func(ctx context.Context, filter repository.AuditLogFilter) ([]entity.AuditLog, error)
+	GetByIDFunc func(ctx context.Context, id uint) (*entity.AuditLog, error)
+}
+
+func (m *MockAuditLogRepository) List(ctx context.Context, filter repository.AuditLogFilter) ([]entity.AuditLog, error) {
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, filter)
+	}
+	return nil, nil
+}
+
+func (m *MockAuditLogRepository) GetByID(ctx context.Context, id uint) (*entity.AuditLog, error) {
+	if m.GetByIDFunc != nil {
+		return m.GetByIDFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+// MockVerseUseCase is a manual mock for VerseUseCase
+type MockVerseUseCase struct {
+	UpdateFunc func(ctx context.Context, id uint, input portuc.UpdateVerseInput) (*entity.Verse, error)
+}
+
+func (m *MockVerseUseCase) Create(ctx context.Context, input portuc.CreateVerseInput) (*entity.Verse, error) {
+	return nil, nil
+}
+
+func (m *MockVerseUseCase) List(ctx context.Context, params portuc.ListParams) (*portuc.PaginatedResult[entity.Verse], error) {
+	return nil, nil
+}
+
+func (m *MockVerseUseCase) Update(ctx context.Context, id uint, input portuc.UpdateVerseInput) (*entity.Verse, error) {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, id, input)
+	}
+	return nil, nil
+}
+
+func (m *MockVerseUseCase) Delete(ctx context.Context, id uint) error {
+	return nil
+}
+
+func (m *MockVerseUseCase) BulkDelete(ctx context.Context, ids []uint) error {
+	return nil
+}
+
+func (m *MockVerseUseCase) GetById(ctx context.Context, id uint) (*entity.Verse, error) {
+	return nil, nil
+}
+
+func (m *MockVerseUseCase) Reorder(ctx context.Context, chapterID uint, verseIDs []uint) error {
+	return nil
+}
+
+func (m *MockVerseUseCase) Move(ctx context.Context, id uint, input portuc.MoveVerseInput) (*entity.Verse, error) {
+	return nil, nil
+}
+
+func (m *MockVerseUseCase) Import(ctx context.Context, input portuc.ImportVersesInput) (*portuc.ImportVersesResult, error) {
+	return nil, nil
+}
+
+// MockTranslationUseCase is a manual mock for TranslationUseCase
+type MockTranslationUseCase struct {
+	UpdateFunc func(ctx context.Context, id uint, input portuc.UpdateTranslationInput) (*entity.Translation, error)
+}
+
+func (m *MockTranslationUseCase) Create(ctx context.Context, input portuc.CreateTranslationInput) (*entity.Translation, error) {
+	return nil, nil
+}
+
+func (m *MockTranslationUseCase) List(ctx context.Context, params portuc.TranslationListParams) (*portuc.PaginatedResult[entity.Translation], error) {
+	return nil, nil
+}
+
+func (m *MockTranslationUseCase) Update(ctx context.Context, id uint, input portuc.UpdateTranslationInput) (*entity.Translation, error) {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, id, input)
+	}
+	return nil, nil
+}
+
+func (m *MockTranslationUseCase) Delete(ctx context.Context, id uint) error {
+	return nil
+}
+
+func (m *MockTranslationUseCase) GetById(ctx context.Context, id uint) (*entity.Translation, error) {
+	return nil, nil
+}
+
+func (m *MockTranslationUseCase) GetByVerseId(ctx context.Context, verseId uint) ([]entity.Translation, error) {
+	return nil, nil
+}
+
+func (m *MockTranslationUseCase) GetDropdownData(ctx context.Context) (*portuc.TranslationDropdownData, error) {
+	return nil, nil
+}
+
+func (m *MockTranslationUseCase) BulkDelete(ctx context.Context, ids []uint) error {
+	return nil
+}
+
+// MockLogger is a manual mock for Logger
+type MockLogger struct{}
+
+func (m *MockLogger) Info(msg string, fields ...any)  {}
+func (m *MockLogger) Error(msg string, fields ...any) {}
+
+func verseRevision(id uint) *entity.AuditLog {
+	return &entity.AuditLog{
+		ID:        id,
+		Table:     "verses",
+		RecordID:  5,
+		Operation: entity.AuditOperationUpdate,
+		OldData:   json.RawMessage(`{"id":5,"arabic_text":"بِسْمِ","transliteration":"bismi"}`),
+		NewData:   json.RawMessage(`{"id":5,"arabic_text":"بِسمِ","transliteration":null}`),
+	}
+}
+
+// ==================== List Tests ====================
+
+func TestRevisionUsecase_ListVerseRevisions_SkipsDeletes(t *testing.T) {
+	repo := &MockAuditLogRepository{
+		ListFunc: func(ctx context.Context, filter repository.AuditLogFilter) ([]entity.AuditLog, error) {
+			if filter.Table != "verses" || filter.RecordID == nil || *filter.RecordID != 5 || filter.Limit != 0 {
+				t.Errorf("unexpected filter %+v", filter)
+			}
+			return []entity.AuditLog{
+				{ID: 3, Operation: entity.AuditOperationDelete, OldData: json.RawMessage(`{"id":5}`)},
+				*verseRevision(2),
+				{ID: 1, Operation: entity.AuditOperationInsert, NewData: json.RawMessage(`{"id":5}`)},
+			}, nil
+		},
+	}
+
+	uc := revision.NewRevisionUsecase(repo, &MockVerseUseCase{}, &MockTranslationUseCase{}, &MockLogger{})
+	revisions, err := uc.ListVerseRevisions(context.Background(), 5)
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(revisions) != 2 || revisions[0].ID != 2 || revisions[1].ID != 1 {
+		t.Errorf("expected revisions 2 and 1, got %+v", revisions)
+	}
+}
+
+func TestRevisionUsecase_ListTranslationRevisions_RepositoryError(t *testing.T) {
+	repo := &MockAuditLogRepository{
+		ListFunc: func(ctx context.Context, filter repository.AuditLogFilter) ([]entity.AuditLog, error) {
+			return nil, errors.New("db down")
+		},
+	}
+
+	uc := revision.NewRevisionUsecase(repo, &MockVerseUseCase{}, &MockTranslationUseCase{}, &MockLogger{})
+	if _, err := uc.ListTranslationRevisions(context.Background(), 1); err == nil {
+		t.Error("expected error, got nil")
+	}
+}
+
+// ==================== Revert Tests ====================
+
+func TestRevisionUsecase_RevertVerse_UsesVerseUsecase(t *testing.T) {
+	repo := &MockAuditLogRepository{
+		GetByIDFunc: func(ctx context.Context, id uint) (*entity.AuditLog, error) {
+			return verseRevision(id), nil
+		},
+	}
+	var got portuc.UpdateVerseInput
+	verseUC := &MockVerseUseCase{
+		UpdateFunc: func(ctx context.Context, id uint, input portuc.UpdateVerseInput) (*entity.Verse, error) {
+			got = input
+			return &entity.Verse{ID: id, ArabicText: *input.ArabicText}, nil
+		},
+	}
+
+	uc := revision.NewRevisionUsecase(repo, verseUC, &MockTranslationUseCase{}, &MockLogger{})
+	verse, err := uc.RevertVerse(context.Background(), 5, 2)
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if verse.ArabicText != "بِسمِ" {
+		t.Errorf("expected the revision text, got %q", verse.ArabicText)
+	}
+	if got.ChapterID != nil || got.VerseNumber != nil {
+		t.Errorf("expected position to be left alone, got %+v", got)
+	}
+	if got.Transliteration == nil || *got.Transliteration != "" {
+		t.Errorf("expected a null transliteration to be restored as empty, got %v", got.Transliteration)
+	}
+}
+
+func TestRevisionUsecase_RevertVerse_NotFound(t *testing.T) {
+	tests := []struct {
+		name  string
+		entry *entity.AuditLog
+	}{
+		{"missing", nil},
+		{"other record", &entity.AuditLog{ID: 2, Table: "verses", RecordID: 6, Operation: entity.AuditOperationUpdate, NewData: json.RawMessage(`{}`)}},
+		{"other table", &entity.AuditLog{ID: 2, Table: "translations", RecordID: 5, Operation: entity.AuditOperationUpdate, NewData: json.RawMessage(`{}`)}},
+		{"delete", &entity.AuditLog{ID: 2, Table: "verses", RecordID: 5, Operation: entity.AuditOperationDelete}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &MockAuditLogRepository{
+				GetByIDFunc: func(ctx context.Context, id uint) (*entity.AuditLog, error) {
+					return tt.entry, nil
+				},
+			}
+			verseUC := &MockVerseUseCase{
+				UpdateFunc: func(ctx context.Context, id uint, input portuc.UpdateVerseInput) (*entity.Verse, error) {
+					t.Fatal("Update should not be called")
+					return nil, nil
+				},
+			}
+
+			uc := revision.NewRevisionUsecase(repo, verseUC, &MockTranslationUseCase{}, &MockLogger{})
+			_, err := uc.RevertVerse(context.Background(), 5, 2)
+
+			if !errors.Is(err, revision.ErrRevisionNotFound) {
+				t.Errorf("expected ErrRevisionNotFound, got %v", err)
+			}
+		})
+	}
+}
+
+func TestRevisionUsecase_RevertTranslation_UsesTranslationUsecase(t *testing.T) {
+	repo := &MockAuditLogRepository{
+		GetByIDFunc: func(ctx context.Context, id uint) (*entity.AuditLog, error) {
+			return &entity.AuditLog{
+				ID:        id,
+				Table:     "translations",
+				RecordID:  9,
+				Operation: entity.AuditOperationInsert,
+				NewData:   json.RawMessage(`{"id":9,"verse_id":5,"language_code":"id","translation_text":"Dengan nama Allah","translator_name":"Tim"}`),
+			}, nil
+		},
+	}
+	var got portuc.UpdateTranslationInput
+	translationUC := &MockTranslationUseCase{
+		UpdateFunc: func(ctx context.Context, id uint, input portuc.UpdateTranslationInput) (*entity.Translation, error) {
+			got = input
+			return &entity.Translation{ID: id}, nil
+		},
+	}
+
+	uc := revision.NewRevisionUsecase(repo, &MockVerseUseCase{}, translationUC, &MockLogger{})
+	if _, err := uc.RevertTranslation(context.Background(), 9, 4); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got.VerseID != nil || *got.LanguageCode != "id" || *got.TranslationText != "Dengan nama Allah" || *got.TranslatorName != "Tim" {
+		t.Errorf("unexpected update input %+v", got)
+	}
+}
@@ -0,0 +1,24 @@
+package search
+
+import "ishari-backend/internal/core/domain"
+
+// Search domain errors
+var (
+	// ErrQueryRequired indicates the search query is empty
+	ErrQueryRequired = domain.NewInvalidInputError("search query is required", nil)
+
+	// ErrQueryTooLong indicates the search query exceeds MaxQueryLength
+	ErrQueryTooLong = domain.NewInvalidInputError("search query must be at most 200 characters", nil)
+
+	// ErrInvalidLanguage indicates the language filter is not a language code
+	ErrInvalidLanguage = domain.NewInvalidInputError("language must be a language code such as ar, id or en", nil)
+
+	// ErrInvalidMode indicates the search mode is neither fulltext nor fuzzy
+	ErrInvalidMode = domain.NewInvalidInputError("mode must be fulltext or fuzzy", nil)
+
+	// ErrInvalidThreshold indicates the similarity threshold is outside (0, 1]
+	ErrInvalidThreshold = domain.NewInvalidInputError("threshold must be greater than 0 and at most 1", nil)
+
+	// ErrLanguageNotSupported indicates a language filter was combined with fuzzy mode
+	ErrLanguageNotSupported = domain.NewInvalidInputError("language filter is not supported in fuzzy mode", nil)
+)
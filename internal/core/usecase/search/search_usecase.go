@@ -0,0 +1,139 @@
+package search
+
+import (
+	"context"
+	"math"
+	"strings"
+	"unicode/utf8"
+
+	"ishari-backend/internal/core/domain"
+	"ishari-backend/internal/core/entity"
+	"ishari-backend/internal/core/port/logger"
+	"ishari-backend/internal/core/port/repository"
+	portuc "ishari-backend/internal/core/port/usecase"
+)
+
+const (
+	// MaxQueryLength is the maximum search query length in characters
+	MaxQueryLength = 200
+
+	// DefaultThreshold is the fuzzy similarity threshold used when none is given,
+	// low enough to match spellings like "sholawat" and "solawat"
+	DefaultThreshold = 0.3
+
+	defaultLimit = 20
+	maxLimit     = 100
+)
+
+type searchUsecase struct {
+	searchRepo  repository.SearchRepository
+	historyRepo repository.SearchHistoryRepository
+	log         logger.Logger
+}
+
+func NewSearchUsecase(searchRepo repository.SearchRepository, historyRepo repository.SearchHistoryRepository, log logger.Logger) portuc.SearchUseCase {
+	return &searchUsecase{
+		searchRepo:  searchRepo,
+		historyRepo: historyRepo,
+		log:         log,
+	}
+}
+
+// Search runs a ranked full-text search over verses and translations, or a
+// similarity search over transliterations in fuzzy mode
+func (u *searchUsecase) Search(ctx context.Context, params portuc.SearchParams) (*portuc.PaginatedResult[entity.SearchResult], error) {
+	query := strings.Join(strings.Fields(params.Query), " ")
+	if query == "" {
+		return nil, ErrQueryRequired
+	}
+	if utf8.RuneCountInString(query) > MaxQueryLength {
+		return nil, ErrQueryTooLong
+	}
+
+	language := strings.TrimSpace(params.Language)
+	if language != "" && !domain.IsLanguageCode(language) {
+		return nil, ErrInvalidLanguage
+	}
+
+	mode := strings.ToLower(strings.TrimSpace(params.Mode))
+	switch mode {
+	case "":
+		mode = entity.SearchModeFullText
+	case entity.SearchModeFullText:
+	case entity.SearchModeFuzzy:
+		if language != "" {
+			return nil, ErrLanguageNotSupported
+		}
+	default:
+		return nil, ErrInvalidMode
+	}
+
+	threshold := params.Threshold
+	if threshold == 0 {
+		threshold = DefaultThreshold
+	}
+	if math.IsNaN(threshold) || threshold <= 0 || threshold > 1 {
+		return nil, ErrInvalidThreshold
+	}
+
+	// apply param default
+	if params.Page == 0 {
+		params.Page = 1
+	}
+	if params.Limit == 0 {
+		params.Limit = defaultLimit
+	}
+	params.Limit = min(params.Limit, maxLimit)
+
+	filter := repository.SearchFilter{
+		Offset:    (params.Page - 1) * params.Limit,
+		Limit:     params.Limit,
+		Query:     query,
+		BookID:    params.BookID,
+		Category:  strings.TrimSpace(params.Category),
+		Language:  language,
+		Threshold: threshold,
+	}
+
+	search := u.searchRepo.Search
+	if mode == entity.SearchModeFuzzy {
+		search = u.searchRepo.SearchTransliteration
+	}
+	results, total, err := search(ctx, filter)
+	if err != nil {
+		u.log.Error("failed to search", "error", err, "query", query, "mode", mode)
+		return nil, domain.NewInternalError("failed to search", err)
+	}
+
+	// later pages of the same search are not recorded again
+	if claims, ok := portuc.GetUserFromContext(ctx); ok && claims != nil && params.Page == 1 {
+		u.record(ctx, claims.UserID, query, mode, total)
+	}
+
+	totalPages := int(total / params.Limit)
+	if total%params.Limit > 0 {
+		totalPages++
+	}
+	return &portuc.PaginatedResult[entity.SearchResult]{
+		Data:       results,
+		Total:      int64(total),
+		Page:       int(params.Page),
+		Limit:      int(params.Limit),
+		TotalPages: totalPages,
+	}, nil
+}
+
+// record stores the search in the user's history without delaying the response
+func (u *searchUsecase) record(ctx context.Context, userID uint, query, mode string, total uint) {
+	history := &entity.SearchHistory{
+		UserID:       userID,
+		SearchQuery:  query,
+		SearchType:   mode,
+		ResultsCount: int(total),
+	}
+	go func() {
+		if err := u.historyRepo.Create(context.WithoutCancel(ctx), history); err != nil {
+			u.log.Error("failed to record search history", "error", err, "user_id", userID)
+		}
+	}()
+}
@@ -0,0 +1,262 @@
+package search_test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"ishari-backend/internal/core/entity"
+	"ishari-backend/internal/core/port/repository"
+	portuc "ishari-backend/internal/core/port/usecase"
+	"ishari-backend/internal/core/usecase/search"
+)
+
+// MockSearchRepository is a manual mock for testing
+type MockSearchRepository struct {
+	SearchFunc                func(ctx context.Context, filter repository.SearchFilter) ([]entity.SearchResult, uint, error)
+	SearchTransliterationFunc func(ctx context.Context, filter repository.SearchFilter) ([]entity.SearchResult, uint, error)
+}
+
+func (m *MockSearchRepository) Search(ctx context.Context, filter repository.SearchFilter) ([]entity.SearchResult, uint, error) {
+	if m.SearchFunc != nil {
+		return m.SearchFunc(ctx, filter)
+	}
+	return []entity.SearchResult{}, 0, nil
+}
+
+func (m *MockSearchRepository) SearchTransliteration(ctx context.Context, filter repository.SearchFilter) ([]entity.SearchResult, uint, error) {
+	if m.SearchTransliterationFunc != nil {
+		return m.SearchTransliterationFunc(ctx, filter)
+	}
+	return []entity.SearchResult{}, 0, nil
+}
+
+// MockSearchHistoryRepository is a manual mock for testing
+type MockSearchHistoryRepository struct {
+	CreateFunc func(ctx context.Context, history *entity.SearchHistory) error
+}
+
+func (m *MockSearchHistoryRepository) Create(ctx context.Context, history *entity.SearchHistory) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, history)
+	}
+	return nil
+}
+
+func (m *MockSearchHistoryRepository) ListByUserID(ctx context.Context, userID uint, offset, limit int) ([]entity.SearchHistory, int64, error) {
+	return nil, 0, nil
+}
+
+func (m *MockSearchHistoryRepository) Delete(ctx context.Context, id, userID uint) (bool, error) {
+	return false, nil
+}
+
+func (m *MockSearchHistoryRepository) DeleteByUserID(ctx context.Context, userID uint) (int64, error) {
+	return 0, nil
+}
+
+// MockLogger is a manual mock for testing
+type MockLogger struct{}
+
+func (m *MockLogger) Info(msg string, fields ...any)  {}
+func (m *MockLogger) Error(msg string, fields ...any) {}
+
+// =============================================================================
+// TEST: Search
+// =============================================================================
+
+func TestSearchUseCase_Search_Success(t *testing.T) {
+	var got repository.SearchFilter
+	repo := &MockSearchRepository{
+		SearchFunc: func(ctx context.Context, filter repository.SearchFilter) ([]entity.SearchResult, uint, error) {
+			got = filter
+			return []entity.SearchResult{{Source: entity.SearchSourceVerse, VerseID: 1, Rank: 0.6}}, 45, nil
+		},
+	}
+	uc := search.NewSearchUsecase(repo, &MockSearchHistoryRepository{}, &MockLogger{})
+	bookID := uint(2)
+
+	result, err := uc.Search(context.Background(), portuc.SearchParams{
+		Query:    "  ya   nabi ",
+		BookID:   &bookID,
+		Category: " Asyraqal ",
+		Language: "id",
+		Page:     3,
+		Limit:    10,
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got.Query != "ya nabi" || got.Category != "Asyraqal" || got.Language != "id" || got.BookID == nil || *got.BookID != 2 {
+		t.Errorf("unexpected filter %+v", got)
+	}
+	if got.Offset != 20 || got.Limit != 10 {
+		t.Errorf("expected offset 20 limit 10, got %d %d", got.Offset, got.Limit)
+	}
+	if result.Total != 45 || result.TotalPages != 5 || result.Page != 3 {
+		t.Errorf("unexpected pagination %+v", result)
+	}
+}
+
+func TestSearchUseCase_Search_Defaults(t *testing.T) {
+	var got repository.SearchFilter
+	repo := &MockSearchRepository{
+		SearchFunc: func(ctx context.Context, filter repository.SearchFilter) ([]entity.SearchResult, uint, error) {
+			got = filter
+			return nil, 0, nil
+		},
+	}
+	uc := search.NewSearchUsecase(repo, &MockSearchHistoryRepository{}, &MockLogger{})
+
+	if _, err := uc.Search(context.Background(), portuc.SearchParams{Query: "salla", Limit: 1000}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got.Offset != 0 || got.Limit != 100 {
+		t.Errorf("expected first page capped at 100, got offset %d limit %d", got.Offset, got.Limit)
+	}
+}
+
+func TestSearchUseCase_Search_InvalidInput(t *testing.T) {
+	uc := search.NewSearchUsecase(&MockSearchRepository{}, &MockSearchHistoryRepository{}, &MockLogger{})
+
+	tests := []struct {
+		name   string
+		params portuc.SearchParams
+		want   error
+	}{
+		{name: "empty query", params: portuc.SearchParams{Query: "   "}, want: search.ErrQueryRequired},
+		{name: "query too long", params: portuc.SearchParams{Query: strings.Repeat("ب", search.MaxQueryLength+1)}, want: search.ErrQueryTooLong},
+		{name: "invalid language", params: portuc.SearchParams{Query: "salla", Language: "id'; --"}, want: search.ErrInvalidLanguage},
+		{name: "invalid mode", params: portuc.SearchParams{Query: "salla", Mode: "regex"}, want: search.ErrInvalidMode},
+		{name: "negative threshold", params: portuc.SearchParams{Query: "salla", Mode: "fuzzy", Threshold: -0.1}, want: search.ErrInvalidThreshold},
+		{name: "threshold above one", params: portuc.SearchParams{Query: "salla", Mode: "fuzzy", Threshold: 1.5}, want: search.ErrInvalidThreshold},
+		{name: "NaN threshold", params: portuc.SearchParams{Query: "salla", Mode: "fuzzy", Threshold: math.NaN()}, want: search.ErrInvalidThreshold},
+		{name: "fuzzy with language", params: portuc.SearchParams{Query: "salla", Mode: "fuzzy", Language: "id"}, want: search.ErrLanguageNotSupported},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := uc.Search(context.Background(), tt.params); !errors.Is(err, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestSearchUseCase_Search_RepositoryError(t *testing.T) {
+	repo := &MockSearchRepository{
+		SearchFunc: func(ctx context.Context, filter repository.SearchFilter) ([]entity.SearchResult, uint, error) {
+			return nil, 0, errors.New("db down")
+		},
+	}
+	uc := search.NewSearchUsecase(repo, &MockSearchHistoryRepository{}, &MockLogger{})
+
+	if _, err := uc.Search(context.Background(), portuc.SearchParams{Query: "salla"}); err == nil {
+		t.Fatal("expected error, got nil")
+	}
+}
+
+func TestSearchUseCase_Search_FuzzyMode(t *testing.T) {
+	var got repository.SearchFilter
+	repo := &MockSearchRepository{
+		SearchFunc: func(ctx context.Context, filter repository.SearchFilter) ([]entity.SearchResult, uint, error) {
+			t.Fatal("full-text search must not run in fuzzy mode")
+			return nil, 0, nil
+		},
+		SearchTransliterationFunc: func(ctx context.Context, filter repository.SearchFilter) ([]entity.SearchResult, uint, error) {
+			got = filter
+			return []entity.SearchResult{{Source: entity.SearchSourceVerse, VerseID: 4, Rank: 0.62}}, 1, nil
+		},
+	}
+	uc := search.NewSearchUsecase(repo, &MockSearchHistoryRepository{}, &MockLogger{})
+
+	result, err := uc.Search(context.Background(), portuc.SearchParams{Query: " ya   robbi ", Mode: "Fuzzy", Threshold: 0.5})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got.Query != "ya robbi" || got.Threshold != 0.5 {
+		t.Errorf("unexpected filter %+v", got)
+	}
+	if result.Total != 1 || result.Data[0].VerseID != 4 {
+		t.Errorf("unexpected result %+v", result)
+	}
+}
+
+func TestSearchUseCase_Search_FuzzyDefaultThreshold(t *testing.T) {
+	var got repository.SearchFilter
+	repo := &MockSearchRepository{
+		SearchTransliterationFunc: func(ctx context.Context, filter repository.SearchFilter) ([]entity.SearchResult, uint, error) {
+			got = filter
+			return []entity.SearchResult{}, 0, nil
+		},
+	}
+	uc := search.NewSearchUsecase(repo, &MockSearchHistoryRepository{}, &MockLogger{})
+
+	if _, err := uc.Search(context.Background(), portuc.SearchParams{Query: "sholawat", Mode: entity.SearchModeFuzzy}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got.Threshold != search.DefaultThreshold {
+		t.Errorf("expected default threshold %v, got %v", search.DefaultThreshold, got.Threshold)
+	}
+}
+
+func TestSearchUseCase_Search_RecordsHistoryForUser(t *testing.T) {
+	repo := &MockSearchRepository{
+		SearchFunc: func(ctx context.Context, filter repository.SearchFilter) ([]entity.SearchResult, uint, error) {
+			return []entity.SearchResult{}, 7, nil
+		},
+	}
+	recorded := make(chan *entity.SearchHistory, 1)
+	history := &MockSearchHistoryRepository{
+		CreateFunc: func(ctx context.Context, h *entity.SearchHistory) error {
+			recorded <- h
+			return nil
+		},
+	}
+	uc := search.NewSearchUsecase(repo, history, &MockLogger{})
+
+	ctx, cancel := context.WithCancel(portuc.NewContextWithUser(context.Background(), &portuc.TokenClaims{UserID: 9}))
+	if _, err := uc.Search(ctx, portuc.SearchParams{Query: " ya  nabi "}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	// the request finishing must not abort the recording
+	cancel()
+
+	select {
+	case h := <-recorded:
+		if h.UserID != 9 || h.SearchQuery != "ya nabi" || h.SearchType != entity.SearchModeFullText || h.ResultsCount != 7 {
+			t.Errorf("unexpected history entry %+v", h)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected search to be recorded")
+	}
+}
+
+func TestSearchUseCase_Search_SkipsHistory(t *testing.T) {
+	recorded := make(chan struct{}, 2)
+	history := &MockSearchHistoryRepository{
+		CreateFunc: func(ctx context.Context, h *entity.SearchHistory) error {
+			recorded <- struct{}{}
+			return nil
+		},
+	}
+	uc := search.NewSearchUsecase(&MockSearchRepository{}, history, &MockLogger{})
+
+	// anonymous search
+	if _, err := uc.Search(context.Background(), portuc.SearchParams{Query: "salla"}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	// next page of a recorded search
+	userCtx := portuc.NewContextWithUser(context.Background(), &portuc.TokenClaims{UserID: 9})
+	if _, err := uc.Search(userCtx, portuc.SearchParams{Query: "salla", Page: 2}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	select {
+	case <-recorded:
+		t.Error("expected no search history to be recorded")
+	case <-time.After(50 * time.Millisecond):
+	}
+}
@@ -0,0 +1,9 @@
+package searchhistory
+
+import "ishari-backend/internal/core/domain"
+
+// Search history domain errors
+var (
+	// ErrSearchHistoryNotFound indicates the entry does not exist or belongs to another user
+	ErrSearchHistoryNotFound = domain.NewNotFoundError("search history entry not found", nil)
+)
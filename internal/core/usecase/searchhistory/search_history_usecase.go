@@ -0,0 +1,83 @@
+package searchhistory
+
+import (
+	"context"
+
+	"ishari-backend/internal/core/domain"
+	"ishari-backend/internal/core/entity"
+	"ishari-backend/internal/core/port/logger"
+	"ishari-backend/internal/core/port/repository"
+	portuc "ishari-backend/internal/core/port/usecase"
+)
+
+const (
+	defaultLimit = 20
+	maxLimit     = 100
+)
+
+type searchHistoryUsecase struct {
+	historyRepo repository.SearchHistoryRepository
+	log         logger.Logger
+}
+
+func NewSearchHistoryUsecase(historyRepo repository.SearchHistoryRepository, log logger.Logger) portuc.SearchHistoryUseCase {
+	return &searchHistoryUsecase{
+		historyRepo: historyRepo,
+		log:         log,
+	}
+}
+
+// List returns the user's searches, newest first
+func (u *searchHistoryUsecase) List(ctx context.Context, input portuc.ListSearchHistoryInput) (*portuc.PaginatedResult[entity.SearchHistory], error) {
+	if input.Page <= 0 {
+		input.Page = 1
+	}
+	if input.Limit <= 0 {
+		input.Limit = defaultLimit
+	}
+	input.Limit = min(input.Limit, maxLimit)
+
+	offset := (input.Page - 1) * input.Limit
+
+	history, total, err := u.historyRepo.ListByUserID(ctx, input.UserID, offset, input.Limit)
+	if err != nil {
+		u.log.Error("failed to list search history", "error", err, "user_id", input.UserID)
+		return nil, domain.NewInternalError("failed to list search history", err)
+	}
+
+	totalPages := int(total) / input.Limit
+	if int(total)%input.Limit > 0 {
+		totalPages++
+	}
+
+	return &portuc.PaginatedResult[entity.SearchHistory]{
+		Data:       history,
+		Total:      total,
+		Page:       input.Page,
+		Limit:      input.Limit,
+		TotalPages: totalPages,
+	}, nil
+}
+
+// Delete removes one of the user's searches
+func (u *searchHistoryUsecase) Delete(ctx context.Context, id uint, userID uint) error {
+	deleted, err := u.historyRepo.Delete(ctx, id, userID)
+	if err != nil {
+		u.log.Error("failed to delete search history", "error", err, "id", id, "user_id", userID)
+		return domain.NewInternalError("failed to delete search history", err)
+	}
+	if !deleted {
+		return ErrSearchHistoryNotFound
+	}
+	return nil
+}
+
+// Clear removes all of the user's searches
+func (u *searchHistoryUsecase) Clear(ctx context.Context, userID uint) (int64, error) {
+	count, err := u.historyRepo.DeleteByUserID(ctx, userID)
+	if err != nil {
+		u.log.Error("failed to clear search history", "error", err, "user_id", userID)
+		return 0, domain.NewInternalError("failed to clear search history", err)
+	}
+	return count, nil
+}
@@ -0,0 +1,180 @@
+package searchhistory_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"ishari-backend/internal/core/entity"
+	portuc "ishari-backend/internal/core/port/usecase"
+	"ishari-backend/internal/core/usecase/searchhistory"
+)
+
+// MockSearchHistoryRepository is a manual mock for testing
+type MockSearchHistoryRepository struct {
+	CreateFunc         func(ctx context.Context, history *entity.SearchHistory) error
+	ListByUserIDFunc   func(ctx context.Context, userID uint, offset, limit int) ([]entity.SearchHistory, int64, error)
+	DeleteFunc         func(ctx context.Context, id, userID uint) (bool, error)
+	DeleteByUserIDFunc func(ctx context.Context, userID uint) (int64, error)
+}
+
+func (m *MockSearchHistoryRepository) Create(ctx context.Context, history *entity.SearchHistory) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, history)
+	}
+	return nil
+}
+
+func (m *MockSearchHistoryRepository) ListByUserID(ctx context.Context, userID uint, offset, limit int) ([]entity.SearchHistory, int64, error) {
+	if m.ListByUserIDFunc != nil {
+		return m.ListByUserIDFunc(ctx, userID, offset, limit)
+	}
+	return nil, 0, nil
+}
+
+func (m *MockSearchHistoryRepository) Delete(ctx context.Context, id, userID uint) (bool, error) {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id, userID)
+	}
+	return true, nil
+}
+
+func (m *MockSearchHistoryRepository) DeleteByUserID(ctx context.Context, userID uint) (int64, error) {
+	if m.DeleteByUserIDFunc != nil {
+		return m.DeleteByUserIDFunc(ctx, userID)
+	}
+	return 0, nil
+}
+
+// MockLogger is a manual mock for testing
+type MockLogger struct{}
+
+func (m *MockLogger) Info(msg string, fields ...any)  {}
+func (m *MockLogger) Error(msg string, fields ...any) {}
+
+// =============================================================================
+// TEST: List Search History
+// =============================================================================
+
+func TestSearchHistoryUseCase_List_Success(t *testing.T) {
+	var gotUser uint
+	var gotOffset, gotLimit int
+	repo := &MockSearchHistoryRepository{
+		ListByUserIDFunc: func(ctx context.Context, userID uint, offset, limit int) ([]entity.SearchHistory, int64, error) {
+			gotUser, gotOffset, gotLimit = userID, offset, limit
+			return []entity.SearchHistory{{ID: 3, UserID: userID, SearchQuery: "ya nabi"}}, 11, nil
+		},
+	}
+	uc := searchhistory.NewSearchHistoryUsecase(repo, &MockLogger{})
+
+	result, err := uc.List(context.Background(), portuc.ListSearchHistoryInput{UserID: 5, Page: 2, Limit: 5})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if gotUser != 5 || gotOffset != 5 || gotLimit != 5 {
+		t.Errorf("expected user 5 offset 5 limit 5, got user %d offset %d limit %d", gotUser, gotOffset, gotLimit)
+	}
+	if result.Total != 11 || result.TotalPages != 3 || len(result.Data) != 1 {
+		t.Errorf("unexpected result %+v", result)
+	}
+}
+
+func TestSearchHistoryUseCase_List_Defaults(t *testing.T) {
+	var gotOffset, gotLimit int
+	repo := &MockSearchHistoryRepository{
+		ListByUserIDFunc: func(ctx context.Context, userID uint, offset, limit int) ([]entity.SearchHistory, int64, error) {
+			gotOffset, gotLimit = offset, limit
+			return nil, 0, nil
+		},
+	}
+	uc := searchhistory.NewSearchHistoryUsecase(repo, &MockLogger{})
+
+	result, err := uc.List(context.Background(), portuc.ListSearchHistoryInput{UserID: 5, Limit: 1000})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if gotOffset != 0 || gotLimit != 100 {
+		t.Errorf("expected offset 0 and limit capped at 100, got offset %d limit %d", gotOffset, gotLimit)
+	}
+	if result.Page != 1 {
+		t.Errorf("expected page 1, got %d", result.Page)
+	}
+}
+
+func TestSearchHistoryUseCase_List_RepositoryError(t *testing.T) {
+	repo := &MockSearchHistoryRepository{
+		ListByUserIDFunc: func(ctx context.Context, userID uint, offset, limit int) ([]entity.SearchHistory, int64, error) {
+			return nil, 0, errors.New("db down")
+		},
+	}
+	uc := searchhistory.NewSearchHistoryUsecase(repo, &MockLogger{})
+
+	if _, err := uc.List(context.Background(), portuc.ListSearchHistoryInput{UserID: 5}); err == nil {
+		t.Fatal("expected error, got nil")
+	}
+}
+
+// =============================================================================
+// TEST: Delete / Clear Search History
+// =============================================================================
+
+func TestSearchHistoryUseCase_Delete_Success(t *testing.T) {
+	var gotID, gotUser uint
+	repo := &MockSearchHistoryRepository{
+		DeleteFunc: func(ctx context.Context, id, userID uint) (bool, error) {
+			gotID, gotUser = id, userID
+			return true, nil
+		},
+	}
+	uc := searchhistory.NewSearchHistoryUsecase(repo, &MockLogger{})
+
+	if err := uc.Delete(context.Background(), 4, 5); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if gotID != 4 || gotUser != 5 {
+		t.Errorf("expected entry 4 of user 5, got entry %d of user %d", gotID, gotUser)
+	}
+}
+
+func TestSearchHistoryUseCase_Delete_NotOwned(t *testing.T) {
+	repo := &MockSearchHistoryRepository{
+		DeleteFunc: func(ctx context.Context, id, userID uint) (bool, error) {
+			return false, nil
+		},
+	}
+	uc := searchhistory.NewSearchHistoryUsecase(repo, &MockLogger{})
+
+	if err := uc.Delete(context.Background(), 4, 5); !errors.Is(err, searchhistory.ErrSearchHistoryNotFound) {
+		t.Errorf("expected ErrSearchHistoryNotFound, got %v", err)
+	}
+}
+
+func TestSearchHistoryUseCase_Clear_Success(t *testing.T) {
+	repo := &MockSearchHistoryRepository{
+		DeleteByUserIDFunc: func(ctx context.Context, userID uint) (int64, error) {
+			return 12, nil
+		},
+	}
+	uc := searchhistory.NewSearchHistoryUsecase(repo, &MockLogger{})
+
+	count, err := uc.Clear(context.Background(), 5)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if count != 12 {
+		t.Errorf("expected 12 removed entries, got %d", count)
+	}
+}
+
+func TestSearchHistoryUseCase_Clear_RepositoryError(t *testing.T) {
+	repo := &MockSearchHistoryRepository{
+		DeleteByUserIDFunc: func(ctx context.Context, userID uint) (int64, error) {
+			return 0, errors.New("db down")
+		},
+	}
+	uc := searchhistory.NewSearchHistoryUsecase(repo, &MockLogger{})
+
+	if _, err := uc.Clear(context.Background(), 5); err == nil {
+		t.Fatal("expected error, got nil")
+	}
+}
@@ -0,0 +1,12 @@
+package suggest
+
+import "ishari-backend/internal/core/domain"
+
+// Suggest domain errors
+var (
+	// ErrQueryRequired indicates the prefix is empty once normalized
+	ErrQueryRequired = domain.NewInvalidInputError("suggestion query is required", nil)
+
+	// ErrQueryTooLong indicates the prefix exceeds MaxQueryLength
+	ErrQueryTooLong = domain.NewInvalidInputError("suggestion query must be at most 100 characters", nil)
+)
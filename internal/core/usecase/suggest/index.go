@@ -0,0 +1,157 @@
+package suggest
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"ishari-backend/internal/core/domain/arabic"
+	"ishari-backend/internal/core/entity"
+)
+
+// typeOrder ranks suggestion types when match position ties
+var typeOrder = map[string]int{
+	entity.SuggestionTypeBook:    0,
+	entity.SuggestionTypeChapter: 1,
+	entity.SuggestionTypeHadi:    2,
+	entity.SuggestionTypeQuery:   3,
+	entity.SuggestionTypeVerse:   4,
+}
+
+// indexKey is a normalized text suffix starting at a word boundary
+type indexKey struct {
+	key string
+	doc int32
+	// word is the position of the first word of key in the text, 0 for the whole text
+	word int32
+}
+
+// prefixIndex is an immutable sorted list of every word suffix of every suggestion, so
+// a prefix lookup is a binary search followed by a scan over the matching keys.
+// "ya robbi bil musthofa" is found by "ya ro", "robbi b" and "musth".
+type prefixIndex struct {
+	docs []entity.Suggestion
+	keys []indexKey
+}
+
+func buildIndex(suggestions []entity.Suggestion) *prefixIndex {
+	idx := &prefixIndex{docs: make([]entity.Suggestion, 0, len(suggestions))}
+	seen := make(map[string]bool, len(suggestions))
+	for _, s := range suggestions {
+		key := normalizeKey(s.Text)
+		if key == "" {
+			continue
+		}
+		// the same text under the same target (or the same popular query) is indexed once
+		dedupe := s.Type + "\x00" + key
+		if s.TargetID != nil {
+			dedupe += "\x00" + strconv.FormatUint(uint64(*s.TargetID), 10)
+		}
+		if seen[dedupe] {
+			continue
+		}
+		seen[dedupe] = true
+
+		s.Text = strings.Join(strings.Fields(s.Text), " ")
+		doc := int32(len(idx.docs))
+		idx.docs = append(idx.docs, s)
+
+		word := int32(0)
+		for i := 0; i < len(key); i++ {
+			if i == 0 || key[i-1] == ' ' {
+				idx.keys = append(idx.keys, indexKey{key: key[i:], doc: doc, word: word})
+				word++
+			}
+		}
+	}
+	sort.Slice(idx.keys, func(i, j int) bool { return idx.keys[i].key < idx.keys[j].key })
+	return idx
+}
+
+// lookup returns up to limit suggestions whose text has a word starting with prefix.
+// Matches at the start of the text come first, then by type, popularity and length.
+func (idx *prefixIndex) lookup(prefix string, limit int) []entity.Suggestion {
+	start := sort.Search(len(idx.keys), func(i int) bool { return idx.keys[i].key >= prefix })
+
+	// best (lowest) word position per suggestion
+	best := make(map[int32]int32)
+	for i := start; i < len(idx.keys) && strings.HasPrefix(idx.keys[i].key, prefix); i++ {
+		k := idx.keys[i]
+		if w, ok := best[k.doc]; !ok || k.word < w {
+			best[k.doc] = k.word
+		}
+	}
+
+	docs := make([]int32, 0, len(best))
+	for doc := range best {
+		docs = append(docs, doc)
+	}
+	sort.Slice(docs, func(i, j int) bool {
+		a, b := idx.docs[docs[i]], idx.docs[docs[j]]
+		if startA, startB := best[docs[i]] == 0, best[docs[j]] == 0; startA != startB {
+			return startA
+		}
+		if typeOrder[a.Type] != typeOrder[b.Type] {
+			return typeOrder[a.Type] < typeOrder[b.Type]
+		}
+		if a.Weight != b.Weight {
+			return a.Weight > b.Weight
+		}
+		if len(a.Text) != len(b.Text) {
+			return len(a.Text) < len(b.Text)
+		}
+		return docs[i] < docs[j]
+	})
+	if len(docs) > limit {
+		docs = docs[:limit]
+	}
+
+	out := make([]entity.Suggestion, len(docs))
+	for i, doc := range docs {
+		out[i] = idx.docs[doc]
+	}
+	return out
+}
+
+// size is the number of indexed suggestions
+func (idx *prefixIndex) size() int {
+	return len(idx.docs)
+}
+
+// latinFolds maps the accented letters of academic transliteration to what people type
+var latinFolds = map[rune]rune{
+	'ā': 'a', 'á': 'a', 'à': 'a', 'â': 'a',
+	'ī': 'i', 'í': 'i', 'ì': 'i', 'î': 'i',
+	'ū': 'u', 'ú': 'u', 'ù': 'u', 'û': 'u',
+	'ē': 'e', 'é': 'e', 'è': 'e', 'ê': 'e',
+	'ō': 'o', 'ó': 'o', 'ò': 'o', 'ô': 'o',
+	'ḥ': 'h', 'ḫ': 'h', 'ṣ': 's', 'š': 's', 'ḍ': 'd', 'ṭ': 't', 'ẓ': 'z', 'ž': 'z', 'ġ': 'g',
+}
+
+// normalizeKey folds text and queries to the same form: Arabic is normalized as for
+// full-text search, Latin is lowercased without accents, and punctuation (including the
+// apostrophes of 'ain and hamza) is dropped. Words are separated by single spaces.
+func normalizeKey(s string) string {
+	s = arabic.Normalize(s)
+	var b strings.Builder
+	b.Grow(len(s))
+	space := true
+	for _, r := range strings.ToLower(s) {
+		if folded, ok := latinFolds[r]; ok {
+			r = folded
+		}
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			b.WriteRune(r)
+			space = false
+		case unicode.IsSpace(r) || r == '-' || r == '/':
+			if !space {
+				b.WriteByte(' ')
+				space = true
+			}
+		}
+		// other punctuation is removed without splitting the word: "'alaika" -> "alaika"
+	}
+	return strings.TrimRight(b.String(), " ")
+}
@@ -0,0 +1,163 @@
+package suggest
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"ishari-backend/internal/core/domain"
+	"ishari-backend/internal/core/entity"
+	"ishari-backend/internal/core/port/logger"
+	"ishari-backend/internal/core/port/repository"
+	portuc "ishari-backend/internal/core/port/usecase"
+)
+
+const (
+	// MaxQueryLength is the maximum suggestion prefix length in characters
+	MaxQueryLength = 100
+
+	defaultLimit = 10
+	maxLimit     = 20
+
+	popularQueryLimit = 500
+	// popular queries are only suggested once several people have searched them,
+	// so one user's history is never exposed to others
+	popularQueryMinUsers = 3
+)
+
+// Refresh controls how the index follows content changes
+type Refresh struct {
+	// CheckInterval is how often the content version is compared with the indexed one
+	CheckInterval time.Duration
+	// MaxAge forces a rebuild to pick up new popular queries even if content did not change
+	MaxAge time.Duration
+}
+
+// DefaultRefresh checks for content changes every 30 seconds and rebuilds at least hourly
+var DefaultRefresh = Refresh{CheckInterval: 30 * time.Second, MaxAge: time.Hour}
+
+// snapshot is an index with the content version and time it was built from
+type snapshot struct {
+	index   *prefixIndex
+	version string
+	builtAt time.Time
+}
+
+type suggestUsecase struct {
+	suggestionRepo repository.SuggestionRepository
+	refresh        Refresh
+	log            logger.Logger
+
+	current atomic.Pointer[snapshot]
+	// buildMu serializes builds; refreshing guards the background check
+	buildMu    sync.Mutex
+	refreshing atomic.Bool
+	checkedAt  atomic.Int64
+}
+
+func NewSuggestUsecase(suggestionRepo repository.SuggestionRepository, refresh Refresh, log logger.Logger) portuc.SuggestUseCase {
+	return &suggestUsecase{
+		suggestionRepo: suggestionRepo,
+		refresh:        refresh,
+		log:            log,
+	}
+}
+
+// Suggest returns the best suggestions for the typed prefix. Lookups never touch the
+// database once the index is built: staleness is checked in the background and the
+// rebuilt index replaces the old one atomically.
+func (u *suggestUsecase) Suggest(ctx context.Context, params portuc.SuggestParams) ([]entity.Suggestion, error) {
+	if utf8.RuneCountInString(params.Query) > MaxQueryLength {
+		return nil, ErrQueryTooLong
+	}
+	prefix := normalizeKey(params.Query)
+	if prefix == "" {
+		return nil, ErrQueryRequired
+	}
+	// "ya " asks for the words after "ya", not for "yasin"
+	if last, _ := utf8.DecodeLastRuneInString(params.Query); unicode.IsSpace(last) {
+		prefix += " "
+	}
+
+	if params.Limit <= 0 {
+		params.Limit = defaultLimit
+	}
+	params.Limit = min(params.Limit, maxLimit)
+
+	snap := u.current.Load()
+	if snap == nil {
+		var err error
+		if snap, err = u.build(ctx, ""); err != nil {
+			u.log.Error("failed to build suggestion index", "error", err)
+			return nil, domain.NewInternalError("failed to build suggestion index", err)
+		}
+	} else {
+		u.refreshIfStale(ctx, snap)
+	}
+
+	return snap.index.lookup(prefix, params.Limit), nil
+}
+
+// build loads the suggestions and swaps in a new index. A build that finds another one
+// already completed since it was requested returns that one instead of loading again.
+func (u *suggestUsecase) build(ctx context.Context, version string) (*snapshot, error) {
+	requested := time.Now()
+	u.buildMu.Lock()
+	defer u.buildMu.Unlock()
+
+	if snap := u.current.Load(); snap != nil && !snap.builtAt.Before(requested) {
+		return snap, nil
+	}
+
+	if version == "" {
+		var err error
+		if version, err = u.suggestionRepo.ContentVersion(ctx); err != nil {
+			return nil, err
+		}
+	}
+	suggestions, err := u.suggestionRepo.ListSuggestions(ctx, repository.PopularQueryFilter{
+		Limit:    popularQueryLimit,
+		MinUsers: popularQueryMinUsers,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	snap := &snapshot{index: buildIndex(suggestions), version: version, builtAt: time.Now()}
+	u.current.Store(snap)
+	u.checkedAt.Store(snap.builtAt.UnixNano())
+	u.log.Info("suggestion index built", "suggestions", snap.index.size(), "version", version)
+	return snap, nil
+}
+
+// refreshIfStale starts a background rebuild when the content version changed or the
+// index is older than MaxAge. At most one check runs at a time and only once per CheckInterval.
+func (u *suggestUsecase) refreshIfStale(ctx context.Context, snap *snapshot) {
+	if time.Since(time.Unix(0, u.checkedAt.Load())) < u.refresh.CheckInterval {
+		return
+	}
+	if !u.refreshing.CompareAndSwap(false, true) {
+		return
+	}
+
+	go func() {
+		defer u.refreshing.Store(false)
+		ctx := context.WithoutCancel(ctx)
+
+		version, err := u.suggestionRepo.ContentVersion(ctx)
+		u.checkedAt.Store(time.Now().UnixNano())
+		if err != nil {
+			u.log.Error("failed to check suggestion content version", "error", err)
+			return
+		}
+		if version == snap.version && time.Since(snap.builtAt) < u.refresh.MaxAge {
+			return
+		}
+		if _, err := u.build(ctx, version); err != nil {
+			u.log.Error("failed to rebuild suggestion index", "error", err)
+		}
+	}()
+}
@@ -0,0 +1,285 @@
+package suggest_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"ishari-backend/internal/core/entity"
+	"ishari-backend/internal/core/port/repository"
+	portuc "ishari-backend/internal/core/port/usecase"
+	"ishari-backend/internal/core/usecase/suggest"
+)
+
+// MockSuggestionRepository is a manual mock for testing
+type MockSuggestionRepository struct {
+	mu          sync.Mutex
+	suggestions []entity.Suggestion
+	version     string
+	listCalls   int
+	ListErr     error
+}
+
+func (m *MockSuggestionRepository) ListSuggestions(ctx context.Context, popular repository.PopularQueryFilter) ([]entity.Suggestion, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.listCalls++
+	if m.ListErr != nil {
+		return nil, m.ListErr
+	}
+	return append([]entity.Suggestion(nil), m.suggestions...), nil
+}
+
+func (m *MockSuggestionRepository) ContentVersion(ctx context.Context) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.version, nil
+}
+
+func (m *MockSuggestionRepository) set(version string, suggestions []entity.Suggestion) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.version, m.suggestions = version, suggestions
+}
+
+func (m *MockSuggestionRepository) calls() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.listCalls
+}
+
+// MockLogger is a manual mock for testing
+type MockLogger struct{}
+
+func (m *MockLogger) Info(msg string, fields ...any)  {}
+func (m *MockLogger) Error(msg string, fields ...any) {}
+
+func uintPtr(u uint) *uint {
+	return &u
+}
+
+// noRefresh keeps the first index for the whole test
+var noRefresh = suggest.Refresh{CheckInterval: time.Hour, MaxAge: time.Hour}
+
+func fixtures() []entity.Suggestion {
+	return []entity.Suggestion{
+		{Type: entity.SuggestionTypeBook, TargetID: uintPtr(1), Text: "Diwan Hadroh"},
+		{Type: entity.SuggestionTypeBook, TargetID: uintPtr(2), Text: "Maulid ad-Diba'i"},
+		{Type: entity.SuggestionTypeChapter, TargetID: uintPtr(10), Text: "Ya Robbi Sholli"},
+		{Type: entity.SuggestionTypeChapter, TargetID: uintPtr(11), Text: "مَوْلِدُ الدِّيْبَعِيِّ"},
+		{Type: entity.SuggestionTypeHadi, TargetID: uintPtr(3), Text: "Ustadz Robbani"},
+		{Type: entity.SuggestionTypeVerse, TargetID: uintPtr(100), Text: "Ya robbi bil musthofa  balligh maqoshidana"},
+		{Type: entity.SuggestionTypeVerse, TargetID: uintPtr(101), Text: "Ya nabi salam 'alaika"},
+		{Type: entity.SuggestionTypeVerse, TargetID: uintPtr(102), Text: "Shollallāhu 'alā Muḥammad"},
+		{Type: entity.SuggestionTypeQuery, Text: "ya robbi", Weight: 4},
+		{Type: entity.SuggestionTypeQuery, Text: "ya rasulallah", Weight: 9},
+	}
+}
+
+func newUsecase(repo *MockSuggestionRepository, refresh suggest.Refresh) portuc.SuggestUseCase {
+	return suggest.NewSuggestUsecase(repo, refresh, &MockLogger{})
+}
+
+func texts(s []entity.Suggestion) []string {
+	out := make([]string, len(s))
+	for i, v := range s {
+		out[i] = v.Text
+	}
+	return out
+}
+
+// =============================================================================
+// TEST: Suggest
+// =============================================================================
+
+func TestSuggestUseCase_Suggest_RanksMatches(t *testing.T) {
+	repo := &MockSuggestionRepository{version: "v1", suggestions: fixtures()}
+	uc := newUsecase(repo, noRefresh)
+
+	got, err := uc.Suggest(context.Background(), portuc.SuggestParams{Query: "Ya Ro"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	// whole-text matches first (chapter, query, verse), no word-internal matches exist for "ya ro"
+	want := []string{"Ya Robbi Sholli", "ya robbi", "Ya robbi bil musthofa balligh maqoshidana"}
+	if len(got) != len(want) {
+		t.Fatalf("expected %v, got %v", want, texts(got))
+	}
+	for i := range want {
+		if got[i].Text != want[i] {
+			t.Errorf("position %d: expected %q, got %q", i, want[i], got[i].Text)
+		}
+	}
+	if got[0].Type != entity.SuggestionTypeChapter || got[0].TargetID == nil || *got[0].TargetID != 10 {
+		t.Errorf("expected chapter 10 first, got %+v", got[0])
+	}
+	if got[1].Type != entity.SuggestionTypeQuery || got[1].TargetID != nil {
+		t.Errorf("expected popular query without target, got %+v", got[1])
+	}
+}
+
+func TestSuggestUseCase_Suggest_MatchesWordStarts(t *testing.T) {
+	repo := &MockSuggestionRepository{version: "v1", suggestions: fixtures()}
+	uc := newUsecase(repo, noRefresh)
+
+	got, err := uc.Suggest(context.Background(), portuc.SuggestParams{Query: "robb"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	// every match is on a later word, so they are ordered by type
+	want := []string{"Ya Robbi Sholli", "Ustadz Robbani", "ya robbi", "Ya robbi bil musthofa balligh maqoshidana"}
+	if len(got) != len(want) {
+		t.Fatalf("expected %v, got %v", want, texts(got))
+	}
+	for i := range want {
+		if got[i].Text != want[i] {
+			t.Errorf("position %d: expected %q, got %q", i, want[i], got[i].Text)
+		}
+	}
+
+	if got, _ := uc.Suggest(context.Background(), portuc.SuggestParams{Query: "obbi"}); len(got) != 0 {
+		t.Errorf("expected no match inside a word, got %v", texts(got))
+	}
+}
+
+func TestSuggestUseCase_Suggest_Normalization(t *testing.T) {
+	repo := &MockSuggestionRepository{version: "v1", suggestions: fixtures()}
+	uc := newUsecase(repo, noRefresh)
+
+	tests := []struct {
+		query string
+		want  uint
+	}{
+		{query: "مولد الدي", want: 11},  // harakat in the title are ignored
+		{query: "مَوْلِد", want: 11},    // and in the query
+		{query: "alaika", want: 101},    // apostrophe for 'ain is dropped
+		{query: "ALA MUHAM", want: 102}, // case and transliteration accents are folded
+		{query: "diba", want: 2},        // "ad-Diba'i" splits on the hyphen
+	}
+	for _, tt := range tests {
+		got, err := uc.Suggest(context.Background(), portuc.SuggestParams{Query: tt.query})
+		if err != nil {
+			t.Fatalf("%q: expected no error, got %v", tt.query, err)
+		}
+		if len(got) == 0 || got[0].TargetID == nil || *got[0].TargetID != tt.want {
+			t.Errorf("%q: expected target %d first, got %+v", tt.query, tt.want, got)
+		}
+	}
+}
+
+func TestSuggestUseCase_Suggest_TrailingSpaceCompletesNextWord(t *testing.T) {
+	repo := &MockSuggestionRepository{version: "v1", suggestions: []entity.Suggestion{
+		{Type: entity.SuggestionTypeChapter, TargetID: uintPtr(1), Text: "Yasin"},
+		{Type: entity.SuggestionTypeChapter, TargetID: uintPtr(2), Text: "Ya Nabi"},
+	}}
+	uc := newUsecase(repo, noRefresh)
+
+	if got, _ := uc.Suggest(context.Background(), portuc.SuggestParams{Query: "ya"}); len(got) != 2 {
+		t.Errorf("expected both chapters for %q, got %v", "ya", texts(got))
+	}
+	got, _ := uc.Suggest(context.Background(), portuc.SuggestParams{Query: "ya "})
+	if len(got) != 1 || got[0].Text != "Ya Nabi" {
+		t.Errorf("expected only %q for %q, got %v", "Ya Nabi", "ya ", texts(got))
+	}
+}
+
+func TestSuggestUseCase_Suggest_Limit(t *testing.T) {
+	var many []entity.Suggestion
+	for i := uint(1); i <= 30; i++ {
+		many = append(many, entity.Suggestion{Type: entity.SuggestionTypeVerse, TargetID: uintPtr(i), Text: "salam " + string(rune('a'+i%26))})
+	}
+	repo := &MockSuggestionRepository{version: "v1", suggestions: many}
+	uc := newUsecase(repo, noRefresh)
+
+	if got, _ := uc.Suggest(context.Background(), portuc.SuggestParams{Query: "sal"}); len(got) != 10 {
+		t.Errorf("expected default limit 10, got %d", len(got))
+	}
+	if got, _ := uc.Suggest(context.Background(), portuc.SuggestParams{Query: "sal", Limit: 500}); len(got) != 20 {
+		t.Errorf("expected limit capped at 20, got %d", len(got))
+	}
+}
+
+func TestSuggestUseCase_Suggest_InvalidInput(t *testing.T) {
+	uc := newUsecase(&MockSuggestionRepository{}, noRefresh)
+
+	if _, err := uc.Suggest(context.Background(), portuc.SuggestParams{Query: " ' - "}); !errors.Is(err, suggest.ErrQueryRequired) {
+		t.Errorf("expected ErrQueryRequired, got %v", err)
+	}
+	long := make([]rune, suggest.MaxQueryLength+1)
+	for i := range long {
+		long[i] = 'a'
+	}
+	if _, err := uc.Suggest(context.Background(), portuc.SuggestParams{Query: string(long)}); !errors.Is(err, suggest.ErrQueryTooLong) {
+		t.Errorf("expected ErrQueryTooLong, got %v", err)
+	}
+}
+
+func TestSuggestUseCase_Suggest_RepositoryError(t *testing.T) {
+	uc := newUsecase(&MockSuggestionRepository{ListErr: errors.New("db down")}, noRefresh)
+
+	if _, err := uc.Suggest(context.Background(), portuc.SuggestParams{Query: "ya"}); err == nil {
+		t.Fatal("expected error, got nil")
+	}
+}
+
+// =============================================================================
+// TEST: Index refresh
+// =============================================================================
+
+func TestSuggestUseCase_Suggest_BuildsIndexOnce(t *testing.T) {
+	repo := &MockSuggestionRepository{version: "v1", suggestions: fixtures()}
+	uc := newUsecase(repo, noRefresh)
+
+	for _, q := range []string{"y", "ya", "ya r", "ya ro"} {
+		if _, err := uc.Suggest(context.Background(), portuc.SuggestParams{Query: q}); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	}
+	if repo.calls() != 1 {
+		t.Errorf("expected the index to be loaded once, got %d loads", repo.calls())
+	}
+}
+
+func TestSuggestUseCase_Suggest_RebuildsOnContentChange(t *testing.T) {
+	repo := &MockSuggestionRepository{version: "v1", suggestions: fixtures()}
+	uc := newUsecase(repo, suggest.Refresh{CheckInterval: 0, MaxAge: time.Hour})
+
+	if got, _ := uc.Suggest(context.Background(), portuc.SuggestParams{Query: "asyraqal"}); len(got) != 0 {
+		t.Fatalf("expected no match before the change, got %v", texts(got))
+	}
+
+	repo.set("v2", append(fixtures(), entity.Suggestion{Type: entity.SuggestionTypeChapter, TargetID: uintPtr(12), Text: "Asyraqal"}))
+
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		got, err := uc.Suggest(context.Background(), portuc.SuggestParams{Query: "asyraqal"})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(got) == 1 && *got[0].TargetID == 12 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("expected the index to be rebuilt after the content version changed")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestSuggestUseCase_Suggest_KeepsIndexWhileContentUnchanged(t *testing.T) {
+	repo := &MockSuggestionRepository{version: "v1", suggestions: fixtures()}
+	uc := newUsecase(repo, suggest.Refresh{CheckInterval: 0, MaxAge: time.Hour})
+
+	for i := 0; i < 5; i++ {
+		if _, err := uc.Suggest(context.Background(), portuc.SuggestParams{Query: "ya"}); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		time.Sleep(2 * time.Millisecond)
+	}
+	if repo.calls() != 1 {
+		t.Errorf("expected no rebuild for an unchanged version, got %d loads", repo.calls())
+	}
+}
@@ -59,6 +59,22 @@ func (m *MockTranslationRepository) GetById(ctx context.Context, id uint) (*enti
 	return nil, nil
 }
 
+func (m *MockVerseRepository) Reorder(ctx context.Context, chapterID uint, verseIDs []uint) error {
+	return nil
+}
+
+func (m *MockVerseRepository) Move(ctx context.Context, id, chapterID, position uint) error {
+	return nil
+}
+
+func (m *MockVerseRepository) VerseNumbers(ctx context.Context, chapterID uint) (map[uint]uint, error) {
+	return nil, nil
+}
+
+func (m *MockVerseRepository) Import(ctx context.Context, chapterID uint, verses []entity.VerseImport) error {
+	return nil
+}
+
 func (m *MockTranslationRepository) GetByVerseId(ctx context.Context, verseId uint) ([]entity.Translation, error) {
 	if m.GetByVerseIdFunc != nil {
 		return m.GetByVerseIdFunc(ctx, verseId)
@@ -0,0 +1,22 @@
+package upload
+
+import (
+	"io"
+	"time"
+)
+
+// AudioProber defines the interface for reading technical metadata from audio files.
+// This abstraction keeps container parsing out of the usecase.
+type AudioProber interface {
+	// Probe parses the audio headers of the size bytes readable from r
+	Probe(r io.ReaderAt, size int64) (*AudioMetadata, error)
+}
+
+// AudioMetadata describes an audio stream
+type AudioMetadata struct {
+	Codec      string
+	Duration   time.Duration
+	Bitrate    int // bit/s
+	SampleRate int
+	Channels   int
+}
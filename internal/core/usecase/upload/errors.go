@@ -0,0 +1,30 @@
+package upload
+
+import "ishari-backend/internal/core/domain"
+
+// Upload domain errors
+var (
+	// ErrEmptyFile indicates the uploaded file has no content
+	ErrEmptyFile = domain.NewInvalidInputError("file is empty", nil)
+
+	// ErrFileTooLarge indicates the uploaded file exceeds the size limit for its type
+	ErrFileTooLarge = domain.NewInvalidInputError("file exceeds the maximum allowed size", nil)
+
+	// ErrUnsupportedFileType indicates the file content is not an accepted audio or image format
+	ErrUnsupportedFileType = domain.NewInvalidInputError("unsupported file type", nil)
+
+	// ErrMediaTypeMismatch indicates the file content does not match the requested media type
+	ErrMediaTypeMismatch = domain.NewInvalidInputError("file content does not match media type", nil)
+
+	// ErrImageRequired indicates an image was expected but another file type was uploaded
+	ErrImageRequired = domain.NewInvalidInputError("file must be a jpeg, png or webp image", nil)
+
+	// ErrVerseNotFound indicates the referenced verse does not exist
+	ErrVerseNotFound = domain.NewNotFoundError("verse not found", nil)
+
+	// ErrBookNotFound indicates the referenced book does not exist
+	ErrBookNotFound = domain.NewNotFoundError("book not found", nil)
+
+	// ErrHadiNotFound indicates the referenced hadi does not exist
+	ErrHadiNotFound = domain.NewNotFoundError("hadi not found", nil)
+)
@@ -0,0 +1,19 @@
+package upload
+
+import "io"
+
+// ImageResizer produces downscaled copies of uploaded images
+type ImageResizer interface {
+	// Resize decodes the image and encodes one copy per requested width, keeping the aspect ratio.
+	// Images narrower than a width are re-encoded at their own size.
+	Resize(r io.ReaderAt, size int64, widths []int) ([]ResizedImage, error)
+}
+
+// ResizedImage is an encoded image variant
+type ResizedImage struct {
+	Width       int
+	Height      int
+	ContentType string
+	Extension   string
+	Data        []byte
+}
@@ -0,0 +1,60 @@
+package upload
+
+import (
+	"bytes"
+
+	"ishari-backend/internal/core/entity"
+)
+
+// sniffLen is the number of leading bytes inspected to detect the file format
+const sniffLen = 512
+
+// fileFormat describes an accepted upload format
+type fileFormat struct {
+	ContentType string
+	Extension   string
+	MediaType   string
+}
+
+var (
+	formatMP3  = fileFormat{ContentType: "audio/mpeg", Extension: ".mp3", MediaType: entity.MediaTypeAudio}
+	formatOGG  = fileFormat{ContentType: "audio/ogg", Extension: ".ogg", MediaType: entity.MediaTypeAudio}
+	formatWAV  = fileFormat{ContentType: "audio/wav", Extension: ".wav", MediaType: entity.MediaTypeAudio}
+	formatM4A  = fileFormat{ContentType: "audio/mp4", Extension: ".m4a", MediaType: entity.MediaTypeAudio}
+	formatJPEG = fileFormat{ContentType: "image/jpeg", Extension: ".jpg", MediaType: entity.MediaTypeImage}
+	formatPNG  = fileFormat{ContentType: "image/png", Extension: ".png", MediaType: entity.MediaTypeImage}
+	formatWEBP = fileFormat{ContentType: "image/webp", Extension: ".webp", MediaType: entity.MediaTypeImage}
+)
+
+// detectFormat identifies the file format from its magic bytes.
+// The client supplied content type is never trusted.
+func detectFormat(head []byte) (fileFormat, bool) {
+	switch {
+	case bytes.HasPrefix(head, []byte("ID3")):
+		return formatMP3, true
+	case len(head) >= 2 && head[0] == 0xFF && head[1]&0xE0 == 0xE0 && head[1]&0x06 != 0:
+		// MPEG audio frame sync with a valid layer
+		return formatMP3, true
+	case bytes.HasPrefix(head, []byte("OggS")):
+		return formatOGG, true
+	case len(head) >= 12 && bytes.Equal(head[:4], []byte("RIFF")) && bytes.Equal(head[8:12], []byte("WAVE")):
+		return formatWAV, true
+	case len(head) >= 12 && bytes.Equal(head[:4], []byte("RIFF")) && bytes.Equal(head[8:12], []byte("WEBP")):
+		return formatWEBP, true
+	case len(head) >= 12 && bytes.Equal(head[4:8], []byte("ftyp")) && isAudioBrand(head[8:12]):
+		return formatM4A, true
+	case bytes.HasPrefix(head, []byte{0xFF, 0xD8, 0xFF}):
+		return formatJPEG, true
+	case bytes.HasPrefix(head, []byte("\x89PNG\r\n\x1a\n")):
+		return formatPNG, true
+	}
+	return fileFormat{}, false
+}
+
+func isAudioBrand(brand []byte) bool {
+	switch string(brand) {
+	case "M4A ", "M4B ", "mp42", "isom", "dash":
+		return true
+	}
+	return false
+}
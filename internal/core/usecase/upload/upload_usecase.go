@@ -0,0 +1,437 @@
+package upload
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+
+	"ishari-backend/internal/core/domain"
+	"ishari-backend/internal/core/entity"
+	"ishari-backend/internal/core/port/logger"
+	"ishari-backend/internal/core/port/repository"
+	"ishari-backend/internal/core/port/storage"
+	portuc "ishari-backend/internal/core/port/usecase"
+)
+
+// Limits holds the maximum accepted upload size in bytes per media type
+type Limits struct {
+	MaxAudioSize int64
+	MaxImageSize int64
+}
+
+type uploadUsecase struct {
+	storage    storage.Storage
+	prober     AudioProber
+	resizer    ImageResizer
+	mediaUC    portuc.VerseMediaUseCase
+	waveform   portuc.WaveformUseCase
+	bookRepo   repository.BookRepository
+	hadiRepo   repository.HadiRepository
+	transactor repository.Transactor
+	limits     Limits
+	log        logger.Logger
+}
+
+func NewUploadUsecase(
+	store storage.Storage,
+	prober AudioProber,
+	resizer ImageResizer,
+	mediaUC portuc.VerseMediaUseCase,
+	waveform portuc.WaveformUseCase,
+	bookRepo repository.BookRepository,
+	hadiRepo repository.HadiRepository,
+	transactor repository.Transactor,
+	limits Limits,
+	log logger.Logger,
+) portuc.UploadUseCase {
+	return &uploadUsecase{
+		storage:    store,
+		prober:     prober,
+		resizer:    resizer,
+		mediaUC:    mediaUC,
+		waveform:   waveform,
+		bookRepo:   bookRepo,
+		hadiRepo:   hadiRepo,
+		transactor: transactor,
+		limits:     limits,
+		log:        log,
+	}
+}
+
+// imageVariantWidths lists the generated image variants in the order of imageVariantNames
+var (
+	imageVariantWidths = []int{entity.ImageVariantThumbWidth, entity.ImageVariantMediumWidth, entity.ImageVariantLargeWidth}
+	imageVariantNames  = []string{"thumb", "medium", "large"}
+)
+
+// storedFile is an uploaded file that has been written to storage
+type storedFile struct {
+	Key    string
+	URL    string
+	Size   int64
+	Format fileFormat
+	Audio  *AudioMetadata
+}
+
+// UploadVerseMedia stores the file and creates a verse media record pointing to it
+func (u *uploadUsecase) UploadVerseMedia(ctx context.Context, input portuc.UploadVerseMediaInput) (*entity.VerseMedia, error) {
+	if input.VerseID == 0 {
+		return nil, ErrVerseNotFound
+	}
+
+	inspected, err := u.inspect(input.File, input.MediaType)
+	if err != nil {
+		return nil, err
+	}
+
+	stored, err := u.store(ctx, fmt.Sprintf("verse-media/%d", input.VerseID), inspected)
+	if err != nil {
+		return nil, err
+	}
+
+	fileSize := int(stored.Size)
+	create := portuc.CreateVerseMediaInput{
+		VerseID:     input.VerseID,
+		MediaType:   stored.Format.MediaType,
+		MediaURL:    stored.URL,
+		FileSize:    &fileSize,
+		Description: input.Description,
+		HadiID:      input.HadiID,
+	}
+	create.Duration, create.Codec, create.Bitrate = audioFields(stored.Audio)
+
+	var media *entity.VerseMedia
+	err = u.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		media, err = u.mediaUC.Create(ctx, create)
+		return err
+	})
+	if err != nil {
+		u.discard(ctx, stored.Key)
+		return nil, err
+	}
+
+	u.generateWaveform(ctx, stored.Key, inspected)
+	return media, nil
+}
+
+// ReplaceVerseMediaFile uploads a new file for an existing verse media record and removes the old one
+func (u *uploadUsecase) ReplaceVerseMediaFile(ctx context.Context, id uint, file portuc.UploadFile) (*entity.VerseMedia, error) {
+	existing, err := u.mediaUC.GetById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	oldURL := existing.MediaURL
+
+	inspected, err := u.inspect(file, "")
+	if err != nil {
+		return nil, err
+	}
+
+	stored, err := u.store(ctx, fmt.Sprintf("verse-media/%d", existing.VerseID), inspected)
+	if err != nil {
+		return nil, err
+	}
+
+	fileSize := int(stored.Size)
+	input := portuc.UpdateVerseMediaInput{
+		MediaType: &stored.Format.MediaType,
+		MediaURL:  &stored.URL,
+		FileSize:  &fileSize,
+	}
+	input.Duration, input.Codec, input.Bitrate = audioFields(stored.Audio)
+	var media *entity.VerseMedia
+	err = u.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		media, err = u.mediaUC.Update(ctx, id, input)
+		return err
+	})
+	if err != nil {
+		u.discard(ctx, stored.Key)
+		return nil, err
+	}
+
+	u.generateWaveform(ctx, stored.Key, inspected)
+	if key, ok := u.storage.KeyFromURL(oldURL); ok {
+		u.discard(ctx, key)
+		u.waveform.Remove(ctx, key)
+	}
+	return media, nil
+}
+
+// UploadBookCover stores an image and sets it as the book cover
+func (u *uploadUsecase) UploadBookCover(ctx context.Context, bookID int64, file portuc.UploadFile) (*entity.Book, error) {
+	book, err := u.bookRepo.GetById(ctx, bookID)
+	if err != nil || book == nil {
+		if err != nil {
+			u.log.Error("failed to get book for cover upload", "error", err, "book_id", bookID)
+		}
+		return nil, ErrBookNotFound
+	}
+
+	inspected, err := u.inspectImage(file)
+	if err != nil {
+		return nil, err
+	}
+
+	stored, err := u.store(ctx, fmt.Sprintf("books/%d", bookID), inspected)
+	if err != nil {
+		return nil, err
+	}
+
+	var oldURL string
+	if book.CoverImageURL != nil {
+		oldURL = *book.CoverImageURL
+	}
+	oldVariants := book.CoverImages
+	variants := u.generateVariants(ctx, stored, inspected)
+	book.CoverImageURL = &stored.URL
+	book.CoverImages = variants
+
+	err = u.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		return u.bookRepo.Edit(ctx, book)
+	})
+	if err != nil {
+		u.discard(ctx, stored.Key)
+		u.discardVariants(ctx, variants)
+		u.log.Error("failed to update book cover", "error", err, "book_id", bookID)
+		return nil, domain.NewInternalError("failed to update book cover", err)
+	}
+
+	u.discardURL(ctx, oldURL)
+	u.discardVariants(ctx, oldVariants)
+	return book, nil
+}
+
+// UploadHadiImage stores an image and sets it as the hadi picture
+func (u *uploadUsecase) UploadHadiImage(ctx context.Context, hadiID int, file portuc.UploadFile) (*entity.Hadi, error) {
+	hadi, err := u.hadiRepo.GetByID(ctx, hadiID)
+	if err != nil || hadi == nil {
+		if err != nil {
+			u.log.Error("failed to get hadi for image upload", "error", err, "hadi_id", hadiID)
+		}
+		return nil, ErrHadiNotFound
+	}
+
+	inspected, err := u.inspectImage(file)
+	if err != nil {
+		return nil, err
+	}
+
+	stored, err := u.store(ctx, fmt.Sprintf("hadis/%d", hadiID), inspected)
+	if err != nil {
+		return nil, err
+	}
+
+	var oldURL string
+	if hadi.ImageURL != nil {
+		oldURL = *hadi.ImageURL
+	}
+	oldVariants := hadi.Images
+	variants := u.generateVariants(ctx, stored, inspected)
+	hadi.ImageURL = &stored.URL
+	hadi.Images = variants
+
+	err = u.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		return u.hadiRepo.Update(ctx, hadi)
+	})
+	if err != nil {
+		u.discard(ctx, stored.Key)
+		u.discardVariants(ctx, variants)
+		u.log.Error("failed to update hadi image", "error", err, "hadi_id", hadiID)
+		return nil, domain.NewInternalError("failed to update hadi image", err)
+	}
+
+	u.discardURL(ctx, oldURL)
+	u.discardVariants(ctx, oldVariants)
+	return hadi, nil
+}
+
+func (u *uploadUsecase) inspectImage(file portuc.UploadFile) (*inspectedFile, error) {
+	inspected, err := u.inspect(file, "")
+	if err != nil {
+		return nil, err
+	}
+	if inspected.Format.MediaType != entity.MediaTypeImage {
+		return nil, ErrImageRequired
+	}
+	return inspected, nil
+}
+
+// inspectedFile is an upload whose format and size have been validated
+type inspectedFile struct {
+	Format  fileFormat
+	Content io.ReaderAt
+	Size    int64
+	Audio   *AudioMetadata
+}
+
+// inspect sniffs the file format, enforces the size limit and probes audio metadata.
+// expectedType may be empty to accept any media type.
+func (u *uploadUsecase) inspect(file portuc.UploadFile, expectedType string) (*inspectedFile, error) {
+	if file.Content == nil || file.Size <= 0 {
+		return nil, ErrEmptyFile
+	}
+
+	head := make([]byte, min(sniffLen, file.Size))
+	n, err := file.Content.ReadAt(head, 0)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, domain.NewInternalError("failed to read uploaded file", err)
+	}
+	if n == 0 {
+		return nil, ErrEmptyFile
+	}
+	head = head[:n]
+
+	format, ok := detectFormat(head)
+	if !ok {
+		return nil, ErrUnsupportedFileType
+	}
+	if expectedType != "" && expectedType != format.MediaType {
+		return nil, ErrMediaTypeMismatch
+	}
+
+	limit := u.limits.MaxImageSize
+	if format.MediaType == entity.MediaTypeAudio {
+		limit = u.limits.MaxAudioSize
+	}
+	if limit > 0 && file.Size > limit {
+		return nil, ErrFileTooLarge
+	}
+
+	inspected := &inspectedFile{Format: format, Content: file.Content, Size: file.Size}
+	if format.MediaType == entity.MediaTypeAudio {
+		meta, err := u.prober.Probe(file.Content, file.Size)
+		if err != nil {
+			return nil, domain.NewInvalidInputError("invalid or unsupported audio file", err)
+		}
+		inspected.Audio = meta
+	}
+
+	return inspected, nil
+}
+
+// store writes the file under prefix with a random name
+func (u *uploadUsecase) store(ctx context.Context, prefix string, file *inspectedFile) (*storedFile, error) {
+	name, err := randomName()
+	if err != nil {
+		u.log.Error("failed to generate upload name", "error", err)
+		return nil, domain.NewInternalError("failed to store file", err)
+	}
+	key := prefix + "/" + name + file.Format.Extension
+
+	content := io.NewSectionReader(file.Content, 0, file.Size)
+	info, err := u.storage.Put(ctx, key, content, file.Size, file.Format.ContentType)
+	if err != nil {
+		u.log.Error("failed to store uploaded file", "error", err, "key", key)
+		return nil, domain.NewInternalError("failed to store file", err)
+	}
+
+	return &storedFile{
+		Key:    key,
+		URL:    u.storage.URL(key),
+		Size:   info.Size,
+		Format: file.Format,
+		Audio:  file.Audio,
+	}, nil
+}
+
+// generateWaveform precomputes the player peaks of an uploaded audio file.
+// Failures are logged only: the waveform endpoint retries generation on demand.
+func (u *uploadUsecase) generateWaveform(ctx context.Context, key string, file *inspectedFile) {
+	if file.Format.MediaType != entity.MediaTypeAudio {
+		return
+	}
+	if err := u.waveform.Generate(ctx, key, file.Content, file.Size); err != nil {
+		u.log.Info("waveform not generated for upload", "error", err, "key", key)
+	}
+}
+
+// generateVariants stores resized copies of an uploaded image next to it as {name}_{variant}.{ext}.
+// Failures are logged only: responses then fall back to the original image URL.
+func (u *uploadUsecase) generateVariants(ctx context.Context, stored *storedFile, file *inspectedFile) *entity.ImageVariants {
+	resized, err := u.resizer.Resize(file.Content, file.Size, imageVariantWidths)
+	if err == nil && len(resized) != len(imageVariantWidths) {
+		err = fmt.Errorf("expected %d variants, got %d", len(imageVariantWidths), len(resized))
+	}
+	if err != nil {
+		u.log.Info("image variants not generated for upload", "error", err, "key", stored.Key)
+		return nil
+	}
+
+	base := strings.TrimSuffix(stored.Key, stored.Format.Extension)
+	keys := make([]string, 0, len(resized))
+	for i, img := range resized {
+		key := base + "_" + imageVariantNames[i] + img.Extension
+		if _, err := u.storage.Put(ctx, key, bytes.NewReader(img.Data), int64(len(img.Data)), img.ContentType); err != nil {
+			u.log.Error("failed to store image variant", "error", err, "key", key)
+			for _, k := range keys {
+				u.discard(ctx, k)
+			}
+			return nil
+		}
+		keys = append(keys, key)
+	}
+
+	return &entity.ImageVariants{
+		Thumb:  u.storage.URL(keys[0]),
+		Medium: u.storage.URL(keys[1]),
+		Large:  u.storage.URL(keys[2]),
+	}
+}
+
+// discardVariants removes the stored copies of a replaced image
+func (u *uploadUsecase) discardVariants(ctx context.Context, variants *entity.ImageVariants) {
+	if variants == nil {
+		return
+	}
+	u.discardURL(ctx, variants.Thumb)
+	u.discardURL(ctx, variants.Medium)
+	u.discardURL(ctx, variants.Large)
+}
+
+// discard removes an object that is no longer referenced; failures are only logged
+func (u *uploadUsecase) discard(ctx context.Context, key string) {
+	if err := u.storage.Delete(ctx, key); err != nil {
+		u.log.Error("failed to delete stored file", "error", err, "key", key)
+	}
+}
+
+// discardURL removes the object behind url when it belongs to this storage
+func (u *uploadUsecase) discardURL(ctx context.Context, url string) {
+	if url == "" {
+		return
+	}
+	if key, ok := u.storage.KeyFromURL(url); ok {
+		u.discard(ctx, key)
+	}
+}
+
+// audioFields converts probed metadata to the optional verse media columns
+func audioFields(meta *AudioMetadata) (duration *int, codec *string, bitrate *int) {
+	if meta == nil {
+		return nil, nil, nil
+	}
+	seconds := int(math.Round(meta.Duration.Seconds()))
+	duration = &seconds
+	if meta.Codec != "" {
+		codec = &meta.Codec
+	}
+	if meta.Bitrate > 0 {
+		kbps := int(math.Round(float64(meta.Bitrate) / 1000))
+		bitrate = &kbps
+	}
+	return duration, codec, bitrate
+}
+
+func randomName() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
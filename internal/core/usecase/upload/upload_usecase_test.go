@@ -0,0 +1,630 @@
+package upload_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"ishari-backend/internal/core/domain"
+	"ishari-backend/internal/core/entity"
+	"ishari-backend/internal/core/port/repository"
+	"ishari-backend/internal/core/port/storage"
+	portuc "ishari-backend/internal/core/port/usecase"
+	"ishari-backend/internal/core/usecase/upload"
+)
+
+// MockStorage is an in-memory storage for testing
+type MockStorage struct {
+	Objects map[string][]byte
+	PutErr  error
+	Deleted []string
+	// PutInTransaction is set when a file is written while a transaction is open
+	PutInTransaction bool
+}
+
+func newMockStorage() *MockStorage {
+	return &MockStorage{Objects: map[string][]byte{}}
+}
+
+func (m *MockStorage) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) (*storage.ObjectInfo, error) {
+	if m.PutErr != nil {
+		return nil, m.PutErr
+	}
+	m.PutInTransaction = m.PutInTransaction || inTransaction(ctx)
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	m.Objects[key] = data
+	return &storage.ObjectInfo{Key: key, Size: int64(len(data)), ContentType: contentType}, nil
+}
+
+func (m *MockStorage) Get(ctx context.Context, key string) (io.ReadCloser, *storage.ObjectInfo, error) {
+	data, ok := m.Objects[key]
+	if !ok {
+		return nil, nil, storage.ErrObjectNotFound
+	}
+	return io.NopCloser(bytes.NewReader(data)), &storage.ObjectInfo{Key: key, Size: int64(len(data))}, nil
+}
+
+func (m *MockStorage) Stat(ctx context.Context, key string) (*storage.ObjectInfo, error) {
+	data, ok := m.Objects[key]
+	if !ok {
+		return nil, storage.ErrObjectNotFound
+	}
+	return &storage.ObjectInfo{Key: key, Size: int64(len(data))}, nil
+}
+
+func (m *MockStorage) GetRange(ctx context.Context, key string, offset, length int64) (io.ReadCloser, error) {
+	data, ok := m.Objects[key]
+	if !ok {
+		return nil, storage.ErrObjectNotFound
+	}
+	return io.NopCloser(bytes.NewReader(data[offset : offset+length])), nil
+}
+
+func (m *MockStorage) Delete(ctx context.Context, key string) error {
+	delete(m.Objects, key)
+	m.Deleted = append(m.Deleted, key)
+	return nil
+}
+
+func (m *MockStorage) Presign(ctx context.Context, key string, ttl time.Duration) (string, error) {
+	return m.URL(key), nil
+}
+
+func (m *MockStorage) URL(key string) string {
+	return "http://files.test/" + key
+}
+
+func (m *MockStorage) KeyFromURL(url string) (string, bool) {
+	key, ok := strings.CutPrefix(url, "http://files.test/")
+	return key, ok
+}
+
+// MockVerseMediaUseCase is a manual mock for testing
+type MockVerseMediaUseCase struct {
+	CreateFunc  func(ctx context.Context, input portuc.CreateVerseMediaInput) (*entity.VerseMedia, error)
+	GetByIdFunc func(ctx context.Context, id uint) (*entity.VerseMedia, error)
+	UpdateFunc  func(ctx context.Context, id uint, input portuc.UpdateVerseMediaInput) (*entity.VerseMedia, error)
+}
+
+func (m *MockVerseMediaUseCase) Create(ctx context.Context, input portuc.CreateVerseMediaInput) (*entity.VerseMedia, error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, input)
+	}
+	return &entity.VerseMedia{ID: 1, VerseID: input.VerseID, MediaType: input.MediaType, MediaURL: input.MediaURL, FileSize: input.FileSize}, nil
+}
+
+func (m *MockVerseMediaUseCase) List(ctx context.Context, params portuc.ListVerseMediaParams) (*portuc.PaginatedResult[entity.VerseMedia], error) {
+	return nil, nil
+}
+
+func (m *MockVerseMediaUseCase) GetById(ctx context.Context, id uint) (*entity.VerseMedia, error) {
+	if m.GetByIdFunc != nil {
+		return m.GetByIdFunc(ctx, id)
+	}
+	return nil, errors.New("not found")
+}
+
+func (m *MockVerseMediaUseCase) Update(ctx context.Context, id uint, input portuc.UpdateVerseMediaInput) (*entity.VerseMedia, error) {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, id, input)
+	}
+	return nil, nil
+}
+
+func (m *MockVerseMediaUseCase) Delete(ctx context.Context, id uint) error { return nil }
+
+// MockBookRepository is a manual mock for testing
+type MockBookRepository struct {
+	GetByIdFunc func(ctx context.Context, id int64) (*entity.Book, error)
+	EditFunc    func(ctx context.Context, book *entity.Book) error
+}
+
+func (m *MockBookRepository) List(ctx context.Context, offset, limit int, search string) ([]entity.Book, int64, error) {
+	return nil, 0, nil
+}
+func (m *MockBookRepository) Create(ctx context.Context, book *entity.Book) error { return nil }
+func (m *MockBookRepository) Edit(ctx context.Context, book *entity.Book) error {
+	if m.EditFunc != nil {
+		return m.EditFunc(ctx, book)
+	}
+	return nil
+}
+func (m *MockBookRepository) Delete(ctx context.Context, id int64) error { return nil }
+func (m *MockBookRepository) GetById(ctx context.Context, id int64) (*entity.Book, error) {
+	if m.GetByIdFunc != nil {
+		return m.GetByIdFunc(ctx, id)
+	}
+	return &entity.Book{ID: int(id), Title: "Test Book"}, nil
+}
+
+// MockHadiRepository is a manual mock for testing
+type MockHadiRepository struct {
+	GetByIDFunc func(ctx context.Context, id int) (*entity.Hadi, error)
+	UpdateFunc  func(ctx context.Context, hadi *entity.Hadi) error
+}
+
+func (m *MockHadiRepository) Create(ctx context.Context, hadi *entity.Hadi) error { return nil }
+func (m *MockHadiRepository) GetByID(ctx context.Context, id int) (*entity.Hadi, error) {
+	if m.GetByIDFunc != nil {
+		return m.GetByIDFunc(ctx, id)
+	}
+	return &entity.Hadi{ID: id, Name: "Test Hadi"}, nil
+}
+func (m *MockHadiRepository) List(ctx context.Context, limit, offset int) ([]entity.Hadi, int64, error) {
+	return nil, 0, nil
+}
+func (m *MockHadiRepository) Update(ctx context.Context, hadi *entity.Hadi) error {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, hadi)
+	}
+	return nil
+}
+func (m *MockHadiRepository) Delete(ctx context.Context, id int) error { return nil }
+func (m *MockHadiRepository) ListRepertoire(ctx context.Context, hadiID int) ([]repository.HadiRepertoireRow, error) {
+	return nil, nil
+}
+func (m *MockHadiRepository) ListByVerse(ctx context.Context, verseID uint) ([]repository.VerseHadiRow, error) {
+	return nil, nil
+}
+
+// MockAudioProber is a manual mock for testing
+type MockAudioProber struct {
+	ProbeFunc func(r io.ReaderAt, size int64) (*upload.AudioMetadata, error)
+}
+
+func (m *MockAudioProber) Probe(r io.ReaderAt, size int64) (*upload.AudioMetadata, error) {
+	if m.ProbeFunc != nil {
+		return m.ProbeFunc(r, size)
+	}
+	return &upload.AudioMetadata{Codec: "mp3", Duration: 61600 * time.Millisecond, Bitrate: 128000, SampleRate: 44100, Channels: 2}, nil
+}
+
+// MockImageResizer is a manual mock for testing
+type MockImageResizer struct {
+	ResizeFunc func(r io.ReaderAt, size int64, widths []int) ([]upload.ResizedImage, error)
+}
+
+func (m *MockImageResizer) Resize(r io.ReaderAt, size int64, widths []int) ([]upload.ResizedImage, error) {
+	if m.ResizeFunc != nil {
+		return m.ResizeFunc(r, size, widths)
+	}
+	out := make([]upload.ResizedImage, 0, len(widths))
+	for _, w := range widths {
+		out = append(out, upload.ResizedImage{Width: w, Height: w, ContentType: "image/jpeg", Extension: ".jpg", Data: []byte("jpeg")})
+	}
+	return out, nil
+}
+
+// MockWaveformUseCase is a manual mock for testing
+type MockWaveformUseCase struct {
+	Generated []string
+	Removed   []string
+}
+
+func (m *MockWaveformUseCase) Get(ctx context.Context, mediaID uint, points int) (*portuc.Waveform, error) {
+	return nil, nil
+}
+
+func (m *MockWaveformUseCase) Generate(ctx context.Context, key string, content io.ReaderAt, size int64) error {
+	m.Generated = append(m.Generated, key)
+	return nil
+}
+
+func (m *MockWaveformUseCase) Remove(ctx context.Context, key string) {
+	m.Removed = append(m.Removed, key)
+}
+
+// MockTransactor is a manual mock for testing. It marks the context passed to fn so
+// tests can tell which calls ran inside the transaction.
+type MockTransactor struct {
+	Calls     int
+	CommitErr error
+}
+
+type inTransactionKey struct{}
+
+func (m *MockTransactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	m.Calls++
+	if err := fn(context.WithValue(ctx, inTransactionKey{}, true)); err != nil {
+		return err
+	}
+	return m.CommitErr
+}
+
+func inTransaction(ctx context.Context) bool {
+	in, _ := ctx.Value(inTransactionKey{}).(bool)
+	return in
+}
+
+// MockLogger is a manual mock for testing
+type MockLogger struct{}
+
+func (m *MockLogger) Info(msg string, fields ...any)  {}
+func (m *MockLogger) Error(msg string, fields ...any) {}
+
+var (
+	mp3Bytes = append([]byte("ID3\x04\x00\x00\x00\x00\x00\x00"), bytes.Repeat([]byte{0}, 64)...)
+	pngBytes = append([]byte("\x89PNG\r\n\x1a\n"), bytes.Repeat([]byte{0}, 64)...)
+)
+
+func fileOf(data []byte) portuc.UploadFile {
+	return portuc.UploadFile{Filename: "upload", Size: int64(len(data)), Content: bytes.NewReader(data)}
+}
+
+func newUsecase(store *MockStorage, mediaUC *MockVerseMediaUseCase, bookRepo *MockBookRepository, hadiRepo *MockHadiRepository) portuc.UploadUseCase {
+	return upload.NewUploadUsecase(store, &MockAudioProber{}, &MockImageResizer{}, mediaUC, &MockWaveformUseCase{}, bookRepo, hadiRepo, &MockTransactor{}, upload.Limits{MaxAudioSize: 1 << 20, MaxImageSize: 128}, &MockLogger{})
+}
+
+// =============================================================================
+// TEST: Upload Verse Media
+// =============================================================================
+
+func TestUploadUseCase_UploadVerseMedia_Success(t *testing.T) {
+	store := newMockStorage()
+	uc := newUsecase(store, &MockVerseMediaUseCase{}, &MockBookRepository{}, &MockHadiRepository{})
+
+	media, err := uc.UploadVerseMedia(context.Background(), portuc.UploadVerseMediaInput{
+		VerseID: 7,
+		File:    fileOf(mp3Bytes),
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if media.MediaType != entity.MediaTypeAudio {
+		t.Errorf("expected inferred media type audio, got %s", media.MediaType)
+	}
+	if !strings.HasPrefix(media.MediaURL, "http://files.test/verse-media/7/") || !strings.HasSuffix(media.MediaURL, ".mp3") {
+		t.Errorf("unexpected media url %s", media.MediaURL)
+	}
+	if media.FileSize == nil || *media.FileSize != len(mp3Bytes) {
+		t.Errorf("expected file size %d, got %v", len(mp3Bytes), media.FileSize)
+	}
+
+	key, _ := store.KeyFromURL(media.MediaURL)
+	if !bytes.Equal(store.Objects[key], mp3Bytes) {
+		t.Error("expected stored content to equal the uploaded file")
+	}
+}
+
+func TestUploadUseCase_UploadVerseMedia_WritesRecordInTransaction(t *testing.T) {
+	store := newMockStorage()
+	transactor := &MockTransactor{}
+	var createdInTransaction bool
+	mediaUC := &MockVerseMediaUseCase{
+		CreateFunc: func(ctx context.Context, input portuc.CreateVerseMediaInput) (*entity.VerseMedia, error) {
+			createdInTransaction = inTransaction(ctx)
+			return &entity.VerseMedia{ID: 1}, nil
+		},
+	}
+	uc := upload.NewUploadUsecase(store, &MockAudioProber{}, &MockImageResizer{}, mediaUC, &MockWaveformUseCase{}, &MockBookRepository{}, &MockHadiRepository{}, transactor, upload.Limits{}, &MockLogger{})
+
+	if _, err := uc.UploadVerseMedia(context.Background(), portuc.UploadVerseMediaInput{VerseID: 1, File: fileOf(mp3Bytes)}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if transactor.Calls != 1 || !createdInTransaction {
+		t.Errorf("expected the media record to be created in one transaction, got %d transactions", transactor.Calls)
+	}
+	if store.PutInTransaction {
+		t.Error("expected the file to be stored before the transaction opens")
+	}
+}
+
+func TestUploadUseCase_UploadVerseMedia_StoresAudioMetadata(t *testing.T) {
+	var created portuc.CreateVerseMediaInput
+	mediaUC := &MockVerseMediaUseCase{
+		CreateFunc: func(ctx context.Context, input portuc.CreateVerseMediaInput) (*entity.VerseMedia, error) {
+			created = input
+			return &entity.VerseMedia{ID: 1}, nil
+		},
+	}
+	uc := newUsecase(newMockStorage(), mediaUC, &MockBookRepository{}, &MockHadiRepository{})
+
+	if _, err := uc.UploadVerseMedia(context.Background(), portuc.UploadVerseMediaInput{VerseID: 1, File: fileOf(mp3Bytes)}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if created.Duration == nil || *created.Duration != 62 {
+		t.Errorf("expected duration 62 seconds, got %v", created.Duration)
+	}
+	if created.Codec == nil || *created.Codec != "mp3" {
+		t.Errorf("expected codec mp3, got %v", created.Codec)
+	}
+	if created.Bitrate == nil || *created.Bitrate != 128 {
+		t.Errorf("expected bitrate 128 kbps, got %v", created.Bitrate)
+	}
+}
+
+func TestUploadUseCase_UploadVerseMedia_GeneratesWaveform(t *testing.T) {
+	waveform := &MockWaveformUseCase{}
+	uc := upload.NewUploadUsecase(newMockStorage(), &MockAudioProber{}, &MockImageResizer{}, &MockVerseMediaUseCase{}, waveform, &MockBookRepository{}, &MockHadiRepository{}, &MockTransactor{}, upload.Limits{}, &MockLogger{})
+
+	media, err := uc.UploadVerseMedia(context.Background(), portuc.UploadVerseMediaInput{VerseID: 1, File: fileOf(mp3Bytes)})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(waveform.Generated) != 1 || !strings.HasSuffix(media.MediaURL, waveform.Generated[0]) {
+		t.Errorf("expected waveform generated for the stored file, got %v", waveform.Generated)
+	}
+
+	if _, err := uc.UploadVerseMedia(context.Background(), portuc.UploadVerseMediaInput{VerseID: 1, File: fileOf(pngBytes)}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(waveform.Generated) != 1 {
+		t.Errorf("expected no waveform for images, got %v", waveform.Generated)
+	}
+}
+
+func TestUploadUseCase_UploadVerseMedia_CorruptAudio(t *testing.T) {
+	store := newMockStorage()
+	prober := &MockAudioProber{
+		ProbeFunc: func(r io.ReaderAt, size int64) (*upload.AudioMetadata, error) {
+			return nil, errors.New("corrupt audio file: no mpeg audio frame found")
+		},
+	}
+	uc := upload.NewUploadUsecase(store, prober, &MockImageResizer{}, &MockVerseMediaUseCase{}, &MockWaveformUseCase{}, &MockBookRepository{}, &MockHadiRepository{}, &MockTransactor{}, upload.Limits{}, &MockLogger{})
+
+	_, err := uc.UploadVerseMedia(context.Background(), portuc.UploadVerseMediaInput{VerseID: 1, File: fileOf(mp3Bytes)})
+
+	var domainErr *domain.DomainError
+	if !errors.As(err, &domainErr) || domainErr.Type != domain.ErrTypeInvalidInput {
+		t.Fatalf("expected INVALID_INPUT error, got %v", err)
+	}
+	if len(store.Objects) != 0 {
+		t.Error("expected corrupt file not to be stored")
+	}
+}
+
+func TestUploadUseCase_UploadVerseMedia_UnsupportedType(t *testing.T) {
+	uc := newUsecase(newMockStorage(), &MockVerseMediaUseCase{}, &MockBookRepository{}, &MockHadiRepository{})
+
+	_, err := uc.UploadVerseMedia(context.Background(), portuc.UploadVerseMediaInput{
+		VerseID: 1,
+		File:    fileOf([]byte("%PDF-1.7 not media")),
+	})
+
+	if !errors.Is(err, upload.ErrUnsupportedFileType) {
+		t.Errorf("expected ErrUnsupportedFileType, got %v", err)
+	}
+}
+
+func TestUploadUseCase_UploadVerseMedia_MediaTypeMismatch(t *testing.T) {
+	uc := newUsecase(newMockStorage(), &MockVerseMediaUseCase{}, &MockBookRepository{}, &MockHadiRepository{})
+
+	_, err := uc.UploadVerseMedia(context.Background(), portuc.UploadVerseMediaInput{
+		VerseID:   1,
+		MediaType: entity.MediaTypeImage,
+		File:      fileOf(mp3Bytes),
+	})
+
+	if !errors.Is(err, upload.ErrMediaTypeMismatch) {
+		t.Errorf("expected ErrMediaTypeMismatch, got %v", err)
+	}
+}
+
+func TestUploadUseCase_UploadVerseMedia_TooLarge(t *testing.T) {
+	uc := newUsecase(newMockStorage(), &MockVerseMediaUseCase{}, &MockBookRepository{}, &MockHadiRepository{})
+
+	data := append(append([]byte{}, pngBytes...), bytes.Repeat([]byte{1}, 256)...)
+	_, err := uc.UploadVerseMedia(context.Background(), portuc.UploadVerseMediaInput{
+		VerseID: 1,
+		File:    fileOf(data),
+	})
+
+	if !errors.Is(err, upload.ErrFileTooLarge) {
+		t.Errorf("expected ErrFileTooLarge, got %v", err)
+	}
+}
+
+func TestUploadUseCase_UploadVerseMedia_EmptyFile(t *testing.T) {
+	uc := newUsecase(newMockStorage(), &MockVerseMediaUseCase{}, &MockBookRepository{}, &MockHadiRepository{})
+
+	_, err := uc.UploadVerseMedia(context.Background(), portuc.UploadVerseMediaInput{
+		VerseID: 1,
+		File:    fileOf(nil),
+	})
+
+	if !errors.Is(err, upload.ErrEmptyFile) {
+		t.Errorf("expected ErrEmptyFile, got %v", err)
+	}
+}
+
+func TestUploadUseCase_UploadVerseMedia_CreateFailsRemovesFile(t *testing.T) {
+	store := newMockStorage()
+	createErr := errors.New("verse not found")
+	mediaUC := &MockVerseMediaUseCase{
+		CreateFunc: func(ctx context.Context, input portuc.CreateVerseMediaInput) (*entity.VerseMedia, error) {
+			return nil, createErr
+		},
+	}
+	uc := newUsecase(store, mediaUC, &MockBookRepository{}, &MockHadiRepository{})
+
+	_, err := uc.UploadVerseMedia(context.Background(), portuc.UploadVerseMediaInput{
+		VerseID: 1,
+		File:    fileOf(mp3Bytes),
+	})
+
+	if !errors.Is(err, createErr) {
+		t.Errorf("expected create error, got %v", err)
+	}
+	if len(store.Objects) != 0 {
+		t.Errorf("expected stored file and variants to be removed, %d objects remain", len(store.Objects))
+	}
+}
+
+func TestUploadUseCase_ReplaceVerseMediaFile_RemovesOldFile(t *testing.T) {
+	store := newMockStorage()
+	store.Objects["verse-media/1/old.mp3"] = mp3Bytes
+
+	var updated portuc.UpdateVerseMediaInput
+	mediaUC := &MockVerseMediaUseCase{
+		GetByIdFunc: func(ctx context.Context, id uint) (*entity.VerseMedia, error) {
+			return &entity.VerseMedia{ID: id, VerseID: 1, MediaType: entity.MediaTypeAudio, MediaURL: "http://files.test/verse-media/1/old.mp3"}, nil
+		},
+		UpdateFunc: func(ctx context.Context, id uint, input portuc.UpdateVerseMediaInput) (*entity.VerseMedia, error) {
+			updated = input
+			return &entity.VerseMedia{ID: id, VerseID: 1, MediaType: *input.MediaType, MediaURL: *input.MediaURL}, nil
+		},
+	}
+	uc := newUsecase(store, mediaUC, &MockBookRepository{}, &MockHadiRepository{})
+
+	media, err := uc.ReplaceVerseMediaFile(context.Background(), 5, fileOf(pngBytes))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if *updated.MediaType != entity.MediaTypeImage {
+		t.Errorf("expected media type image, got %s", *updated.MediaType)
+	}
+	if _, ok := store.Objects["verse-media/1/old.mp3"]; ok {
+		t.Error("expected old file to be removed")
+	}
+	if key, _ := store.KeyFromURL(media.MediaURL); store.Objects[key] == nil {
+		t.Error("expected new file to be stored")
+	}
+}
+
+// =============================================================================
+// TEST: Upload Book Cover / Hadi Image
+// =============================================================================
+
+func TestUploadUseCase_UploadBookCover_Success(t *testing.T) {
+	store := newMockStorage()
+	store.Objects["books/3/old.png"] = pngBytes
+	store.Objects["books/3/old_thumb.jpg"] = []byte("jpeg")
+	oldURL := "http://files.test/books/3/old.png"
+
+	var saved *entity.Book
+	bookRepo := &MockBookRepository{
+		GetByIdFunc: func(ctx context.Context, id int64) (*entity.Book, error) {
+			return &entity.Book{ID: int(id), Title: "Diwan", CoverImageURL: &oldURL, CoverImages: &entity.ImageVariants{
+				Thumb:  "http://files.test/books/3/old_thumb.jpg",
+				Medium: "http://files.test/books/3/old_medium.jpg",
+				Large:  "http://files.test/books/3/old_large.jpg",
+			}}, nil
+		},
+		EditFunc: func(ctx context.Context, book *entity.Book) error {
+			saved = book
+			return nil
+		},
+	}
+	uc := newUsecase(store, &MockVerseMediaUseCase{}, bookRepo, &MockHadiRepository{})
+
+	book, err := uc.UploadBookCover(context.Background(), 3, fileOf(pngBytes))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if saved == nil || book.CoverImageURL == nil || !strings.HasPrefix(*book.CoverImageURL, "http://files.test/books/3/") {
+		t.Fatalf("expected cover url to be saved, got %v", book.CoverImageURL)
+	}
+	if _, ok := store.Objects["books/3/old.png"]; ok {
+		t.Error("expected previous cover to be removed")
+	}
+	if _, ok := store.Objects["books/3/old_thumb.jpg"]; ok {
+		t.Error("expected previous cover variants to be removed")
+	}
+
+	variants := book.CoverImages
+	if variants == nil {
+		t.Fatal("expected cover variants to be saved")
+	}
+	base := strings.TrimSuffix(*book.CoverImageURL, ".png")
+	if variants.Thumb != base+"_thumb.jpg" || variants.Medium != base+"_medium.jpg" || variants.Large != base+"_large.jpg" {
+		t.Errorf("unexpected variant urls %+v", variants)
+	}
+	if _, ok := store.Objects[strings.TrimPrefix(variants.Thumb, "http://files.test/")]; !ok {
+		t.Error("expected thumb variant to be stored")
+	}
+}
+
+func TestUploadUseCase_UploadBookCover_ResizeFailsKeepsOriginal(t *testing.T) {
+	store := newMockStorage()
+	bookRepo := &MockBookRepository{
+		GetByIdFunc: func(ctx context.Context, id int64) (*entity.Book, error) {
+			return &entity.Book{ID: int(id), Title: "Diwan"}, nil
+		},
+	}
+	resizer := &MockImageResizer{
+		ResizeFunc: func(r io.ReaderAt, size int64, widths []int) ([]upload.ResizedImage, error) {
+			return nil, errors.New("unsupported image format")
+		},
+	}
+	uc := upload.NewUploadUsecase(store, &MockAudioProber{}, resizer, &MockVerseMediaUseCase{}, &MockWaveformUseCase{}, bookRepo, &MockHadiRepository{}, &MockTransactor{}, upload.Limits{}, &MockLogger{})
+
+	book, err := uc.UploadBookCover(context.Background(), 3, fileOf(pngBytes))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if book.CoverImageURL == nil || book.CoverImages != nil {
+		t.Errorf("expected original cover without variants, got %v %+v", book.CoverImageURL, book.CoverImages)
+	}
+	if len(store.Objects) != 1 {
+		t.Errorf("expected only the original to be stored, got %d objects", len(store.Objects))
+	}
+}
+
+func TestUploadUseCase_UploadBookCover_RequiresImage(t *testing.T) {
+	uc := newUsecase(newMockStorage(), &MockVerseMediaUseCase{}, &MockBookRepository{}, &MockHadiRepository{})
+
+	_, err := uc.UploadBookCover(context.Background(), 1, fileOf(mp3Bytes))
+
+	if !errors.Is(err, upload.ErrImageRequired) {
+		t.Errorf("expected ErrImageRequired, got %v", err)
+	}
+}
+
+func TestUploadUseCase_UploadBookCover_NotFound(t *testing.T) {
+	bookRepo := &MockBookRepository{
+		GetByIdFunc: func(ctx context.Context, id int64) (*entity.Book, error) {
+			return nil, errors.New("record not found")
+		},
+	}
+	uc := newUsecase(newMockStorage(), &MockVerseMediaUseCase{}, bookRepo, &MockHadiRepository{})
+
+	_, err := uc.UploadBookCover(context.Background(), 99, fileOf(pngBytes))
+
+	if !errors.Is(err, upload.ErrBookNotFound) {
+		t.Errorf("expected ErrBookNotFound, got %v", err)
+	}
+}
+
+func TestUploadUseCase_UploadHadiImage_UpdateFailsRemovesFile(t *testing.T) {
+	store := newMockStorage()
+	hadiRepo := &MockHadiRepository{
+		UpdateFunc: func(ctx context.Context, hadi *entity.Hadi) error {
+			return errors.New("db down")
+		},
+	}
+	uc := newUsecase(store, &MockVerseMediaUseCase{}, &MockBookRepository{}, hadiRepo)
+
+	_, err := uc.UploadHadiImage(context.Background(), 2, fileOf(pngBytes))
+
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if len(store.Objects) != 0 {
+		t.Errorf("expected stored file and variants to be removed, %d objects remain", len(store.Objects))
+	}
+}
+
+func TestUploadUseCase_UploadHadiImage_CommitFailsRemovesFile(t *testing.T) {
+	store := newMockStorage()
+	transactor := &MockTransactor{CommitErr: errors.New("commit failed")}
+	uc := upload.NewUploadUsecase(store, &MockAudioProber{}, &MockImageResizer{}, &MockVerseMediaUseCase{}, &MockWaveformUseCase{}, &MockBookRepository{}, &MockHadiRepository{}, transactor, upload.Limits{}, &MockLogger{})
+
+	_, err := uc.UploadHadiImage(context.Background(), 2, fileOf(pngBytes))
+
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if store.PutInTransaction {
+		t.Error("expected files to be stored before the transaction opens")
+	}
+	if len(store.Objects) != 0 {
+		t.Errorf("expected stored file and variants to be removed, %d objects remain", len(store.Objects))
+	}
+}
@@ -7,4 +7,15 @@ var (
 	ErrChapterNotFound    = domain.NewNotFoundError("chapter not found", nil)
 	ErrInvalidVerseNumber = domain.NewInvalidInputError("verse number must be greater than 0", nil)
 	ErrInvalidVerseText   = domain.NewInvalidInputError("verse text is required", nil)
+	ErrEmptyOrder         = domain.NewInvalidInputError("verse ids are required", nil)
+	ErrOrderMismatch      = domain.NewConflictError("verse ids must list every verse of the chapter exactly once", nil)
+	ErrInvalidPosition    = domain.NewInvalidInputError("position must be greater than 0", nil)
+
+	ErrEmptyImport            = domain.NewInvalidInputError("at least one verse is required", nil)
+	ErrInvalidConflictPolicy  = domain.NewInvalidInputError("on_conflict must be fail or update", nil)
+	ErrDuplicateImportNumber  = domain.NewInvalidInputError("verse number appears more than once in the import", nil)
+	ErrVerseNumberTaken       = domain.NewConflictError("verse number already exists in the chapter", nil)
+	ErrImportConflict         = domain.NewConflictError("chapter changed during import, nothing was written", nil)
+	ErrInvalidLanguageCode    = domain.NewInvalidInputError("translation language must be a language code such as ar, id or en", nil)
+	ErrInvalidTranslationText = domain.NewInvalidInputError("translation text is required", nil)
 )
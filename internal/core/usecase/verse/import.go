@@ -0,0 +1,141 @@
+package verse
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+
+	"ishari-backend/internal/core/domain"
+	"ishari-backend/internal/core/domain/arabic"
+	"ishari-backend/internal/core/entity"
+	"ishari-backend/internal/core/port/repository"
+	portuc "ishari-backend/internal/core/port/usecase"
+)
+
+// Import actions reported per row
+const (
+	importActionCreate = "create"
+	importActionUpdate = "update"
+)
+
+// Import validates every row like Create does and, unless it is a dry run, writes all of
+// them in one transaction. Nothing is written when any row is invalid; the result then
+// reports the errors of each row.
+func (u *verseUsecase) Import(ctx context.Context, input portuc.ImportVersesInput) (*portuc.ImportVersesResult, error) {
+	if len(input.Rows) == 0 {
+		return nil, ErrEmptyImport
+	}
+	switch input.OnConflict {
+	case "":
+		input.OnConflict = portuc.ImportOnConflictFail
+	case portuc.ImportOnConflictFail, portuc.ImportOnConflictUpdate:
+	default:
+		return nil, ErrInvalidConflictPolicy
+	}
+	if err := u.validateChapter(ctx, input.ChapterID); err != nil {
+		return nil, err
+	}
+
+	existing, err := u.verseRepo.VerseNumbers(ctx, input.ChapterID)
+	if err != nil {
+		u.log.Error("failed to get verse numbers for import", "error", err, "chapter_id", input.ChapterID)
+		return nil, domain.NewInternalError("failed to import verses", err)
+	}
+
+	result := &portuc.ImportVersesResult{DryRun: input.DryRun, Valid: true}
+	imports := make([]entity.VerseImport, 0, len(input.Rows))
+	seen := make(map[uint]bool, len(input.Rows))
+	for i, row := range input.Rows {
+		report := portuc.ImportRowReport{Row: i + 1, VerseNumber: row.VerseNumber, Action: importActionCreate}
+		errs := u.validateImportRow(row)
+
+		existingID, exists := existing[row.VerseNumber]
+		if row.VerseNumber != 0 {
+			if seen[row.VerseNumber] {
+				errs = append(errs, ErrDuplicateImportNumber)
+			}
+			seen[row.VerseNumber] = true
+		}
+		if exists {
+			report.Action = importActionUpdate
+			if input.OnConflict == portuc.ImportOnConflictFail {
+				errs = append(errs, ErrVerseNumberTaken)
+			}
+		}
+
+		for _, err := range errs {
+			report.Errors = append(report.Errors, err.Error())
+		}
+		result.Rows = append(result.Rows, report)
+		if len(errs) > 0 {
+			result.Valid = false
+			continue
+		}
+
+		if exists {
+			result.Updated++
+		} else {
+			result.Created++
+		}
+		imports = append(imports, toVerseImport(existingID, row))
+	}
+
+	if input.DryRun || !result.Valid {
+		return result, nil
+	}
+
+	if err := u.verseRepo.Import(ctx, input.ChapterID, imports); err != nil {
+		if errors.Is(err, repository.ErrConflict) {
+			return nil, ErrImportConflict
+		}
+		u.log.Error("failed to import verses", "error", err, "chapter_id", input.ChapterID)
+		return nil, domain.NewInternalError("failed to import verses", err)
+	}
+	return result, nil
+}
+
+// validateImportRow checks a verse like Create does, the chapter having been checked once
+// for the whole import, and checks each translation
+func (u *verseUsecase) validateImportRow(row portuc.ImportVerseRow) []error {
+	errs := u.validateVerseContent(row.VerseNumber, row.ArabicText)
+	for _, lang := range sortedLanguages(row.Translations) {
+		if !domain.IsLanguageCode(lang) {
+			errs = append(errs, ErrInvalidLanguageCode)
+		}
+		if strings.TrimSpace(row.Translations[lang]) == "" {
+			errs = append(errs, ErrInvalidTranslationText)
+		}
+	}
+	return errs
+}
+
+// toVerseImport builds the verse to write for a valid row; existingID is 0 for a new verse
+func toVerseImport(existingID uint, row portuc.ImportVerseRow) entity.VerseImport {
+	imp := entity.VerseImport{
+		Verse: entity.Verse{
+			ID:               existingID,
+			VerseNumber:      row.VerseNumber,
+			ArabicText:       row.ArabicText,
+			ArabicNormalized: arabic.Normalize(row.ArabicText),
+			Transliteration:  row.Transliteration,
+		},
+	}
+	for _, lang := range sortedLanguages(row.Translations) {
+		imp.Translations = append(imp.Translations, entity.Translation{
+			LanguageCode:    lang,
+			TranslationText: row.Translations[lang],
+		})
+	}
+	return imp
+}
+
+// sortedLanguages keeps row reports and writes in a stable order
+func sortedLanguages(translations map[string]string) []string {
+	langs := make([]string, 0, len(translations))
+	for lang := range translations {
+		langs = append(langs, lang)
+	}
+	sort.Strings(langs)
+	return langs
+}
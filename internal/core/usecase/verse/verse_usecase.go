@@ -2,7 +2,9 @@ package verse
 
 import (
 	"context"
+	"errors"
 	"ishari-backend/internal/core/domain"
+	"ishari-backend/internal/core/domain/arabic"
 	"ishari-backend/internal/core/entity"
 	"ishari-backend/internal/core/port/logger"
 	"ishari-backend/internal/core/port/repository"
@@ -33,10 +35,11 @@ func (u *verseUsecase) Create(ctx context.Context, input portuc.CreateVerseInput
 
 	// create verse entity
 	verse := &entity.Verse{
-		ChapterID:       input.ChapterID,
-		VerseNumber:     input.VerseNumber,
-		ArabicText:      input.ArabicText,
-		Transliteration: input.Transliteration,
+		ChapterID:        input.ChapterID,
+		VerseNumber:      input.VerseNumber,
+		ArabicText:       input.ArabicText,
+		ArabicNormalized: arabic.Normalize(input.ArabicText),
+		Transliteration:  input.Transliteration,
 	}
 
 	// Persist verse
@@ -48,25 +51,34 @@ func (u *verseUsecase) Create(ctx context.Context, input portuc.CreateVerseInput
 }
 
 func (u *verseUsecase) validateCreateVerse(ctx context.Context, input portuc.CreateVerseInput) error {
-	if input.ChapterID == 0 {
-		return ErrChapterNotFound
-	}
-	if err := u.validateChapterId(ctx, input.ChapterID); err != nil {
+	if err := u.validateChapter(ctx, input.ChapterID); err != nil {
 		return err
 	}
-	if input.VerseNumber == 0 {
-		return ErrInvalidVerseNumber
+	if errs := u.validateVerseContent(input.VerseNumber, input.ArabicText); len(errs) > 0 {
+		return errs[0]
 	}
-	if err := u.validateVerseNumber(input.VerseNumber); err != nil {
-		return err
+	return nil
+}
+
+// validateChapter checks that the verses of a create or an import go to an existing chapter
+func (u *verseUsecase) validateChapter(ctx context.Context, chapterId uint) error {
+	if chapterId == 0 {
+		return ErrChapterNotFound
 	}
-	if input.ArabicText == "" {
-		return ErrInvalidVerseText
+	return u.validateChapterId(ctx, chapterId)
+}
+
+// validateVerseContent checks the fields of a single verse, returning every failure so
+// imports can report them all
+func (u *verseUsecase) validateVerseContent(verseNumber uint, verseText string) []error {
+	var errs []error
+	if err := u.validateVerseNumber(verseNumber); err != nil {
+		errs = append(errs, err)
 	}
-	if err := u.validateVerseText(input.ArabicText); err != nil {
-		return err
+	if err := u.validateVerseText(verseText); err != nil {
+		errs = append(errs, err)
 	}
-	return nil
+	return errs
 }
 
 func (u *verseUsecase) validateChapterId(ctx context.Context, chapterId uint) error {
@@ -172,6 +184,7 @@ func (u *verseUsecase) Update(ctx context.Context, id uint, input portuc.UpdateV
 			return nil, err
 		}
 		verse.ArabicText = *input.ArabicText
+		verse.ArabicNormalized = arabic.Normalize(verse.ArabicText)
 	}
 	if input.Transliteration != nil {
 		verse.Transliteration = input.Transliteration
@@ -213,3 +226,54 @@ func (u *verseUsecase) BulkDelete(ctx context.Context, ids []uint) error {
 
 	return nil
 }
+
+// Reorder renumbers the verses of a chapter 1..n in the given order
+func (u *verseUsecase) Reorder(ctx context.Context, chapterID uint, verseIDs []uint) error {
+	if len(verseIDs) == 0 {
+		return ErrEmptyOrder
+	}
+	if err := u.validateChapterId(ctx, chapterID); err != nil {
+		return err
+	}
+
+	if err := u.verseRepo.Reorder(ctx, chapterID, verseIDs); err != nil {
+		if errors.Is(err, repository.ErrOrderMismatch) {
+			return ErrOrderMismatch
+		}
+		u.log.Error("failed to reorder verses", "error", err, "chapter_id", chapterID)
+		return domain.NewInternalError("failed to reorder verses", err)
+	}
+	return nil
+}
+
+// Move moves a verse to a new position in its chapter or in another chapter, shifting the
+// verses around it
+func (u *verseUsecase) Move(ctx context.Context, id uint, input portuc.MoveVerseInput) (*entity.Verse, error) {
+	verse, err := u.verseRepo.GetById(ctx, id)
+	if err != nil {
+		u.log.Error("failed to get verse for move", "error", err, "verse_id", id)
+		return nil, ErrVerseNotFound
+	}
+
+	chapterID := verse.ChapterID
+	if input.ChapterID != nil {
+		if err := u.validateChapterId(ctx, *input.ChapterID); err != nil {
+			return nil, err
+		}
+		chapterID = *input.ChapterID
+	}
+	var position uint
+	if input.Position != nil {
+		if *input.Position == 0 {
+			return nil, ErrInvalidPosition
+		}
+		position = *input.Position
+	}
+
+	if err := u.verseRepo.Move(ctx, id, chapterID, position); err != nil {
+		u.log.Error("failed to move verse", "error", err, "verse_id", id, "chapter_id", chapterID)
+		return nil, domain.NewInternalError("failed to move verse", err)
+	}
+
+	return u.GetById(ctx, id)
+}
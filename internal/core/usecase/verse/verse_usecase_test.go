@@ -14,12 +14,16 @@ import (
 
 // MockVerseRepository is a manual mock for testing
 type MockVerseRepository struct {
-	CreateFunc     func(ctx context.Context, verse *entity.Verse) error
-	ListFunc       func(ctx context.Context, filter repository.VerseFilter) ([]entity.Verse, uint, error)
-	UpdateFunc     func(ctx context.Context, verse *entity.Verse) error
-	DeleteFunc     func(ctx context.Context, id uint) error
-	BulkDeleteFunc func(ctx context.Context, ids []uint) error
-	GetByIdFunc    func(ctx context.Context, id uint) (*entity.Verse, error)
+	CreateFunc       func(ctx context.Context, verse *entity.Verse) error
+	ListFunc         func(ctx context.Context, filter repository.VerseFilter) ([]entity.Verse, uint, error)
+	UpdateFunc       func(ctx context.Context, verse *entity.Verse) error
+	DeleteFunc       func(ctx context.Context, id uint) error
+	BulkDeleteFunc   func(ctx context.Context, ids []uint) error
+	GetByIdFunc      func(ctx context.Context, id uint) (*entity.Verse, error)
+	ReorderFunc      func(ctx context.Context, chapterID uint, verseIDs []uint) error
+	MoveFunc         func(ctx context.Context, id, chapterID, position uint) error
+	VerseNumbersFunc func(ctx context.Context, chapterID uint) (map[uint]uint, error)
+	ImportFunc       func(ctx context.Context, chapterID uint, verses []entity.VerseImport) error
 }
 
 func (m *MockVerseRepository) Create(ctx context.Context, v *entity.Verse) error {
@@ -64,15 +68,46 @@ func (m *MockVerseRepository) GetById(ctx context.Context, id uint) (*entity.Ver
 	return nil, nil
 }
 
+func (m *MockVerseRepository) Reorder(ctx context.Context, chapterID uint, verseIDs []uint) error {
+	if m.ReorderFunc != nil {
+		return m.ReorderFunc(ctx, chapterID, verseIDs)
+	}
+	return nil
+}
+
+func (m *MockVerseRepository) Move(ctx context.Context, id, chapterID, position uint) error {
+	if m.MoveFunc != nil {
+		return m.MoveFunc(ctx, id, chapterID, position)
+	}
+	return nil
+}
+
+func (m *MockVerseRepository) VerseNumbers(ctx context.Context, chapterID uint) (map[uint]uint, error) {
+	if m.VerseNumbersFunc != nil {
+		return m.VerseNumbersFunc(ctx, chapterID)
+	}
+	return map[uint]uint{}, nil
+}
+
+func (m *MockVerseRepository) Import(ctx context.Context, chapterID uint, verses []entity.VerseImport) error {
+	if m.ImportFunc != nil {
+		return m.ImportFunc(ctx, chapterID, verses)
+	}
+	return nil
+}
+
 // MockChapterRepository is a manual mock for testing
 type MockChapterRepository struct {
-	CreateChapterFunc       func(ctx context.Context, chapter *entity.Chapter) error
-	ListChaptersFunc        func(ctx context.Context, offset, limit int, search string, bookID *uint, title string, category string) ([]entity.Chapter, int64, error)
-	GetChaptersByBookIDFunc func(ctx context.Context, bookID uint) ([]entity.Chapter, int64, error)
-	GetChapterByIDFunc      func(ctx context.Context, id uint) (*entity.Chapter, error)
-	UpdateChapterFunc       func(ctx context.Context, chapter *entity.Chapter) error
-	DeleteChapterFunc       func(ctx context.Context, id uint) error
-	DeleteChaptersFunc      func(ctx context.Context, ids []uint) error
+	CreateChapterFunc        func(ctx context.Context, chapter *entity.Chapter) error
+	ListChaptersFunc         func(ctx context.Context, offset, limit int, search string, bookID *uint, title string, category string) ([]entity.Chapter, int64, error)
+	GetChaptersByBookIDFunc  func(ctx context.Context, bookID uint) ([]entity.Chapter, int64, error)
+	GetChapterByIDFunc       func(ctx context.Context, id uint) (*entity.Chapter, error)
+	UpdateChapterFunc        func(ctx context.Context, chapter *entity.Chapter) error
+	DeleteChapterFunc        func(ctx context.Context, id uint) error
+	DeleteChaptersFunc       func(ctx context.Context, ids []uint) error
+	ReconcileVerseCountsFunc func(ctx context.Context) ([]entity.VerseCountCorrection, error)
+	ReorderChaptersFunc      func(ctx context.Context, bookID uint, chapterIDs []uint) error
+	MoveChapterFunc          func(ctx context.Context, id, bookID, position uint) error
 }
 
 func (m *MockChapterRepository) CreateChapter(ctx context.Context, chapter *entity.Chapter) error {
@@ -96,6 +131,10 @@ func (m *MockChapterRepository) GetChaptersByBookID(ctx context.Context, bookID
 	return nil, 0, nil
 }
 
+func (m *MockChapterRepository) ListChaptersByCategory(ctx context.Context, categoryID uint, offset, limit int) ([]entity.Chapter, int64, error) {
+	return nil, 0, nil
+}
+
 func (m *MockChapterRepository) GetChapterByID(ctx context.Context, id uint) (*entity.Chapter, error) {
 	if m.GetChapterByIDFunc != nil {
 		return m.GetChapterByIDFunc(ctx, id)
@@ -124,6 +163,27 @@ func (m *MockChapterRepository) DeleteChapters(ctx context.Context, ids []uint)
 	return nil
 }
 
+func (m *MockChapterRepository) ReconcileVerseCounts(ctx context.Context) ([]entity.VerseCountCorrection, error) {
+	if m.ReconcileVerseCountsFunc != nil {
+		return m.ReconcileVerseCountsFunc(ctx)
+	}
+	return nil, nil
+}
+
+func (m *MockChapterRepository) ReorderChapters(ctx context.Context, bookID uint, chapterIDs []uint) error {
+	if m.ReorderChaptersFunc != nil {
+		return m.ReorderChaptersFunc(ctx, bookID, chapterIDs)
+	}
+	return nil
+}
+
+func (m *MockChapterRepository) MoveChapter(ctx context.Context, id, bookID, position uint) error {
+	if m.MoveChapterFunc != nil {
+		return m.MoveChapterFunc(ctx, id, bookID, position)
+	}
+	return nil
+}
+
 // MockLogger is a manual mock for testing
 type MockLogger struct{}
 
@@ -190,6 +250,36 @@ func TestVerseUseCase_Create_Success(t *testing.T) {
 	}
 }
 
+func TestVerseUseCase_Create_SetsArabicNormalized(t *testing.T) {
+	var stored *entity.Verse
+	mockVerseRepo := &MockVerseRepository{
+		CreateFunc: func(ctx context.Context, v *entity.Verse) error {
+			stored = v
+			return nil
+		},
+	}
+	mockChapterRepo := &MockChapterRepository{
+		GetChapterByIDFunc: func(ctx context.Context, id uint) (*entity.Chapter, error) {
+			return &entity.Chapter{ID: 1}, nil
+		},
+	}
+
+	uc := verse.NewVerseUsecase(mockVerseRepo, mockChapterRepo, &MockLogger{})
+
+	_, err := uc.Create(context.Background(), portuc.CreateVerseInput{
+		ChapterID:   1,
+		VerseNumber: 1,
+		ArabicText:  "أَشْرَقَ الْبَدْرُ عَلَيْنَا",
+	})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if stored == nil || stored.ArabicNormalized != "اشرق البدر علينا" {
+		t.Errorf("expected normalized arabic text to be stored, got %+v", stored)
+	}
+}
+
 func TestVerseUseCase_Create_ChapterNotFound(t *testing.T) {
 	mockVerseRepo := &MockVerseRepository{}
 
@@ -814,6 +904,40 @@ func TestVerseUseCase_Update_PartialUpdate(t *testing.T) {
 	}
 }
 
+func TestVerseUseCase_Update_SyncsArabicNormalized(t *testing.T) {
+	existingVerse := &entity.Verse{
+		ID:               1,
+		ChapterID:        1,
+		VerseNumber:      1,
+		ArabicText:       "طَلَعَ الْبَدْرُ",
+		ArabicNormalized: "طلع البدر",
+	}
+
+	var updated *entity.Verse
+	mockVerseRepo := &MockVerseRepository{
+		GetByIdFunc: func(ctx context.Context, id uint) (*entity.Verse, error) {
+			return existingVerse, nil
+		},
+		UpdateFunc: func(ctx context.Context, v *entity.Verse) error {
+			updated = v
+			return nil
+		},
+	}
+
+	uc := verse.NewVerseUsecase(mockVerseRepo, &MockChapterRepository{}, &MockLogger{})
+
+	_, err := uc.Update(context.Background(), 1, portuc.UpdateVerseInput{
+		ArabicText: strPtr("طَلَعَ الْبَدْرُ عَلَيْنَا"),
+	})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if updated == nil || updated.ArabicNormalized != "طلع البدر علينا" {
+		t.Errorf("expected normalized arabic text to follow the update, got %+v", updated)
+	}
+}
+
 // =============================================================================
 // TEST: Delete Verse
 // =============================================================================
@@ -945,3 +1069,326 @@ func TestVerseUseCase_BulkDelete_RepositoryError(t *testing.T) {
 		t.Error("expected error, got nil")
 	}
 }
+
+// ==================== Reorder / Move Tests ====================
+
+func TestVerseUseCase_Reorder_Success(t *testing.T) {
+	var gotChapter uint
+	var gotIDs []uint
+	mockVerseRepo := &MockVerseRepository{
+		ReorderFunc: func(ctx context.Context, chapterID uint, verseIDs []uint) error {
+			gotChapter, gotIDs = chapterID, verseIDs
+			return nil
+		},
+	}
+	mockChapterRepo := &MockChapterRepository{
+		GetChapterByIDFunc: func(ctx context.Context, id uint) (*entity.Chapter, error) {
+			return &entity.Chapter{ID: id}, nil
+		},
+	}
+
+	uc := verse.NewVerseUsecase(mockVerseRepo, mockChapterRepo, &MockLogger{})
+
+	if err := uc.Reorder(context.Background(), 3, []uint{12, 10, 11}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if gotChapter != 3 || len(gotIDs) != 3 || gotIDs[0] != 12 {
+		t.Errorf("expected chapter 3 reordered as [12 10 11], got %d %v", gotChapter, gotIDs)
+	}
+}
+
+func TestVerseUseCase_Reorder_EmptyIDs(t *testing.T) {
+	mockVerseRepo := &MockVerseRepository{
+		ReorderFunc: func(ctx context.Context, chapterID uint, verseIDs []uint) error {
+			t.Error("Reorder should not be called")
+			return nil
+		},
+	}
+
+	uc := verse.NewVerseUsecase(mockVerseRepo, &MockChapterRepository{}, &MockLogger{})
+
+	if err := uc.Reorder(context.Background(), 3, nil); !errors.Is(err, verse.ErrEmptyOrder) {
+		t.Errorf("expected ErrEmptyOrder, got %v", err)
+	}
+}
+
+func TestVerseUseCase_Reorder_Mismatch(t *testing.T) {
+	mockVerseRepo := &MockVerseRepository{
+		ReorderFunc: func(ctx context.Context, chapterID uint, verseIDs []uint) error {
+			return repository.ErrOrderMismatch
+		},
+	}
+	mockChapterRepo := &MockChapterRepository{
+		GetChapterByIDFunc: func(ctx context.Context, id uint) (*entity.Chapter, error) {
+			return &entity.Chapter{ID: id}, nil
+		},
+	}
+
+	uc := verse.NewVerseUsecase(mockVerseRepo, mockChapterRepo, &MockLogger{})
+
+	if err := uc.Reorder(context.Background(), 3, []uint{10, 10}); !errors.Is(err, verse.ErrOrderMismatch) {
+		t.Errorf("expected ErrOrderMismatch, got %v", err)
+	}
+}
+
+func TestVerseUseCase_Move_ToOtherChapter(t *testing.T) {
+	var gotChapter, gotPosition uint
+	mockVerseRepo := &MockVerseRepository{
+		GetByIdFunc: func(ctx context.Context, id uint) (*entity.Verse, error) {
+			return &entity.Verse{ID: id, ChapterID: 1, VerseNumber: 4}, nil
+		},
+		MoveFunc: func(ctx context.Context, id, chapterID, position uint) error {
+			gotChapter, gotPosition = chapterID, position
+			return nil
+		},
+	}
+	mockChapterRepo := &MockChapterRepository{
+		GetChapterByIDFunc: func(ctx context.Context, id uint) (*entity.Chapter, error) {
+			return &entity.Chapter{ID: id}, nil
+		},
+	}
+
+	uc := verse.NewVerseUsecase(mockVerseRepo, mockChapterRepo, &MockLogger{})
+
+	_, err := uc.Move(context.Background(), 7, portuc.MoveVerseInput{ChapterID: uintPtr(2), Position: uintPtr(1)})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if gotChapter != 2 || gotPosition != 1 {
+		t.Errorf("expected move to chapter 2 position 1, got chapter %d position %d", gotChapter, gotPosition)
+	}
+}
+
+func TestVerseUseCase_Move_DefaultsToEndOfCurrentChapter(t *testing.T) {
+	var gotChapter, gotPosition uint
+	mockVerseRepo := &MockVerseRepository{
+		GetByIdFunc: func(ctx context.Context, id uint) (*entity.Verse, error) {
+			return &entity.Verse{ID: id, ChapterID: 5}, nil
+		},
+		MoveFunc: func(ctx context.Context, id, chapterID, position uint) error {
+			gotChapter, gotPosition = chapterID, position
+			return nil
+		},
+	}
+
+	uc := verse.NewVerseUsecase(mockVerseRepo, &MockChapterRepository{}, &MockLogger{})
+
+	if _, err := uc.Move(context.Background(), 7, portuc.MoveVerseInput{}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if gotChapter != 5 || gotPosition != 0 {
+		t.Errorf("expected move to end of chapter 5, got chapter %d position %d", gotChapter, gotPosition)
+	}
+}
+
+func TestVerseUseCase_Move_InvalidPosition(t *testing.T) {
+	mockVerseRepo := &MockVerseRepository{
+		GetByIdFunc: func(ctx context.Context, id uint) (*entity.Verse, error) {
+			return &entity.Verse{ID: id, ChapterID: 1}, nil
+		},
+		MoveFunc: func(ctx context.Context, id, chapterID, position uint) error {
+			t.Error("Move should not be called")
+			return nil
+		},
+	}
+
+	uc := verse.NewVerseUsecase(mockVerseRepo, &MockChapterRepository{}, &MockLogger{})
+
+	if _, err := uc.Move(context.Background(), 7, portuc.MoveVerseInput{Position: uintPtr(0)}); !errors.Is(err, verse.ErrInvalidPosition) {
+		t.Errorf("expected ErrInvalidPosition, got %v", err)
+	}
+}
+
+func importChapterRepo() *MockChapterRepository {
+	return &MockChapterRepository{
+		GetChapterByIDFunc: func(ctx context.Context, id uint) (*entity.Chapter, error) {
+			return &entity.Chapter{ID: id}, nil
+		},
+	}
+}
+
+func TestVerseUseCase_Import_CreatesAndUpdates(t *testing.T) {
+	var got []entity.VerseImport
+	mockVerseRepo := &MockVerseRepository{
+		VerseNumbersFunc: func(ctx context.Context, chapterID uint) (map[uint]uint, error) {
+			return map[uint]uint{1: 41}, nil
+		},
+		ImportFunc: func(ctx context.Context, chapterID uint, verses []entity.VerseImport) error {
+			got = verses
+			return nil
+		},
+	}
+
+	uc := verse.NewVerseUsecase(mockVerseRepo, importChapterRepo(), &MockLogger{})
+
+	result, err := uc.Import(context.Background(), portuc.ImportVersesInput{
+		ChapterID:  3,
+		OnConflict: portuc.ImportOnConflictUpdate,
+		Rows: []portuc.ImportVerseRow{
+			{VerseNumber: 1, ArabicText: "بِسْمِ اللهِ"},
+			{VerseNumber: 2, ArabicText: "اَللّٰهُمَّ", Translations: map[string]string{"id": "Ya Allah", "en": "O Allah"}},
+		},
+	})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !result.Valid || result.Created != 1 || result.Updated != 1 {
+		t.Errorf("expected 1 created and 1 updated, got %+v", result)
+	}
+	if result.Rows[0].Action != "update" || result.Rows[1].Action != "create" {
+		t.Errorf("unexpected actions %q, %q", result.Rows[0].Action, result.Rows[1].Action)
+	}
+	if len(got) != 2 || got[0].Verse.ID != 41 || got[1].Verse.ID != 0 {
+		t.Fatalf("expected verse 41 updated and one created, got %+v", got)
+	}
+	if got[1].Verse.ArabicNormalized == "" {
+		t.Error("expected ArabicNormalized to be set")
+	}
+	if len(got[1].Translations) != 2 || got[1].Translations[0].LanguageCode != "en" {
+		t.Errorf("expected translations sorted by language, got %+v", got[1].Translations)
+	}
+}
+
+func TestVerseUseCase_Import_LooksUpChapterOnce(t *testing.T) {
+	lookups := 0
+	chapterRepo := &MockChapterRepository{
+		GetChapterByIDFunc: func(ctx context.Context, id uint) (*entity.Chapter, error) {
+			lookups++
+			return &entity.Chapter{ID: id}, nil
+		},
+	}
+	uc := verse.NewVerseUsecase(&MockVerseRepository{}, chapterRepo, &MockLogger{})
+
+	_, err := uc.Import(context.Background(), portuc.ImportVersesInput{
+		ChapterID: 3,
+		Rows: []portuc.ImportVerseRow{
+			{VerseNumber: 1, ArabicText: "بِسْمِ اللهِ"},
+			{VerseNumber: 2, ArabicText: "اَللّٰهُمَّ"},
+			{VerseNumber: 3, ArabicText: "صَلِّ"},
+		},
+	})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if lookups != 1 {
+		t.Errorf("expected the chapter to be looked up once, got %d", lookups)
+	}
+}
+
+func TestVerseUseCase_Import_DryRunReportsRowErrors(t *testing.T) {
+	mockVerseRepo := &MockVerseRepository{
+		VerseNumbersFunc: func(ctx context.Context, chapterID uint) (map[uint]uint, error) {
+			return map[uint]uint{1: 41}, nil
+		},
+		ImportFunc: func(ctx context.Context, chapterID uint, verses []entity.VerseImport) error {
+			t.Error("Import should not be called on a dry run")
+			return nil
+		},
+	}
+
+	uc := verse.NewVerseUsecase(mockVerseRepo, importChapterRepo(), &MockLogger{})
+
+	result, err := uc.Import(context.Background(), portuc.ImportVersesInput{
+		ChapterID: 3,
+		DryRun:    true,
+		Rows: []portuc.ImportVerseRow{
+			{VerseNumber: 1, ArabicText: "a"},
+			{VerseNumber: 2, ArabicText: "b"},
+			{VerseNumber: 2, ArabicText: ""},
+			{VerseNumber: 0, ArabicText: "c", Translations: map[string]string{"id": " "}},
+			{VerseNumber: 5, ArabicText: "d", Translations: map[string]string{"en_US": "x"}},
+		},
+	})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result.Valid || !result.DryRun || result.Created != 1 {
+		t.Errorf("expected an invalid dry run with 1 creatable row, got %+v", result)
+	}
+	want := [][]string{
+		{verse.ErrVerseNumberTaken.Error()},
+		nil,
+		{verse.ErrInvalidVerseText.Error(), verse.ErrDuplicateImportNumber.Error()},
+		{verse.ErrInvalidVerseNumber.Error(), verse.ErrInvalidTranslationText.Error()},
+		{verse.ErrInvalidLanguageCode.Error()},
+	}
+	for i, report := range result.Rows {
+		if len(report.Errors) != len(want[i]) {
+			t.Errorf("row %d: expected errors %v, got %v", report.Row, want[i], report.Errors)
+			continue
+		}
+		for j := range want[i] {
+			if report.Errors[j] != want[i][j] {
+				t.Errorf("row %d: expected errors %v, got %v", report.Row, want[i], report.Errors)
+			}
+		}
+	}
+}
+
+func TestVerseUseCase_Import_InvalidRowsWriteNothing(t *testing.T) {
+	mockVerseRepo := &MockVerseRepository{
+		ImportFunc: func(ctx context.Context, chapterID uint, verses []entity.VerseImport) error {
+			t.Error("Import should not be called when a row is invalid")
+			return nil
+		},
+	}
+
+	uc := verse.NewVerseUsecase(mockVerseRepo, importChapterRepo(), &MockLogger{})
+
+	result, err := uc.Import(context.Background(), portuc.ImportVersesInput{
+		ChapterID: 3,
+		Rows:      []portuc.ImportVerseRow{{VerseNumber: 1, ArabicText: "a"}, {VerseNumber: 2}},
+	})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result.Valid {
+		t.Error("expected the import to be invalid")
+	}
+}
+
+func TestVerseUseCase_Import_Validation(t *testing.T) {
+	uc := verse.NewVerseUsecase(&MockVerseRepository{}, &MockChapterRepository{}, &MockLogger{})
+	rows := []portuc.ImportVerseRow{{VerseNumber: 1, ArabicText: "a"}}
+
+	tests := []struct {
+		name  string
+		input portuc.ImportVersesInput
+		want  error
+	}{
+		{"no rows", portuc.ImportVersesInput{ChapterID: 3}, verse.ErrEmptyImport},
+		{"unknown policy", portuc.ImportVersesInput{ChapterID: 3, Rows: rows, OnConflict: "skip"}, verse.ErrInvalidConflictPolicy},
+		{"missing chapter", portuc.ImportVersesInput{ChapterID: 3, Rows: rows}, verse.ErrChapterNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := uc.Import(context.Background(), tt.input); !errors.Is(err, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestVerseUseCase_Import_Conflict(t *testing.T) {
+	mockVerseRepo := &MockVerseRepository{
+		ImportFunc: func(ctx context.Context, chapterID uint, verses []entity.VerseImport) error {
+			return repository.ErrConflict
+		},
+	}
+
+	uc := verse.NewVerseUsecase(mockVerseRepo, importChapterRepo(), &MockLogger{})
+
+	_, err := uc.Import(context.Background(), portuc.ImportVersesInput{
+		ChapterID: 3,
+		Rows:      []portuc.ImportVerseRow{{VerseNumber: 1, ArabicText: "a"}},
+	})
+
+	if !errors.Is(err, verse.ErrImportConflict) {
+		t.Errorf("expected ErrImportConflict, got %v", err)
+	}
+}
@@ -0,0 +1,30 @@
+package versemedia
+
+import "ishari-backend/internal/core/domain"
+
+// Verse media domain errors
+var (
+	// ErrMediaNotFound indicates the verse media does not exist
+	ErrMediaNotFound = domain.NewNotFoundError("verse media not found", nil)
+
+	// ErrVerseNotFound indicates the referenced verse does not exist
+	ErrVerseNotFound = domain.NewNotFoundError("verse not found", nil)
+
+	// ErrHadiNotFound indicates the referenced hadi does not exist
+	ErrHadiNotFound = domain.NewNotFoundError("hadi not found", nil)
+
+	// ErrInvalidMediaType indicates media type is not audio or image
+	ErrInvalidMediaType = domain.NewInvalidInputError("media type must be either audio or image", nil)
+
+	// ErrInvalidMediaURL indicates media URL is missing
+	ErrInvalidMediaURL = domain.NewInvalidInputError("media url is required", nil)
+
+	// ErrInvalidFileSize indicates a negative file size
+	ErrInvalidFileSize = domain.NewInvalidInputError("file size must not be negative", nil)
+
+	// ErrInvalidDuration indicates a negative duration
+	ErrInvalidDuration = domain.NewInvalidInputError("duration must not be negative", nil)
+
+	// ErrInvalidBitrate indicates a negative bitrate
+	ErrInvalidBitrate = domain.NewInvalidInputError("bitrate must not be negative", nil)
+)
@@ -0,0 +1,288 @@
+package versemedia
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"ishari-backend/internal/core/domain"
+	"ishari-backend/internal/core/entity"
+	"ishari-backend/internal/core/port/logger"
+	"ishari-backend/internal/core/port/repository"
+	portuc "ishari-backend/internal/core/port/usecase"
+
+	"gorm.io/gorm"
+)
+
+type verseMediaUsecase struct {
+	mediaRepo repository.VerseMediaRepository
+	verseRepo repository.VerseRepository
+	hadiRepo  repository.HadiRepository
+	log       logger.Logger
+}
+
+func NewVerseMediaUsecase(mediaRepo repository.VerseMediaRepository, verseRepo repository.VerseRepository, hadiRepo repository.HadiRepository, log logger.Logger) portuc.VerseMediaUseCase {
+	return &verseMediaUsecase{
+		mediaRepo: mediaRepo,
+		verseRepo: verseRepo,
+		hadiRepo:  hadiRepo,
+		log:       log,
+	}
+}
+
+// Create attaches a new media record to a verse
+func (u *verseMediaUsecase) Create(ctx context.Context, input portuc.CreateVerseMediaInput) (*entity.VerseMedia, error) {
+	if err := u.validateCreateMedia(ctx, input); err != nil {
+		return nil, err
+	}
+
+	media := &entity.VerseMedia{
+		VerseID:     input.VerseID,
+		MediaType:   input.MediaType,
+		MediaURL:    strings.TrimSpace(input.MediaURL),
+		FileSize:    input.FileSize,
+		Duration:    input.Duration,
+		Codec:       input.Codec,
+		Bitrate:     input.Bitrate,
+		Description: input.Description,
+		HadiID:      input.HadiID,
+	}
+
+	if err := u.mediaRepo.Create(ctx, media); err != nil {
+		u.log.Error("failed to create verse media", "error", err, "verse_id", input.VerseID)
+		return nil, domain.NewInternalError("failed to create verse media", err)
+	}
+
+	// Reload with preload to get Verse and Hadi data for the response
+	reloaded, err := u.mediaRepo.GetById(ctx, media.ID)
+	if err != nil || reloaded == nil {
+		u.log.Error("failed to reload verse media after creation", "error", err, "id", media.ID)
+		return media, nil
+	}
+
+	return reloaded, nil
+}
+
+func (u *verseMediaUsecase) validateCreateMedia(ctx context.Context, input portuc.CreateVerseMediaInput) error {
+	if input.VerseID == 0 {
+		return ErrVerseNotFound
+	}
+	if err := u.validateVerseId(ctx, input.VerseID); err != nil {
+		return err
+	}
+	if err := u.validateMediaType(input.MediaType); err != nil {
+		return err
+	}
+	if err := u.validateMediaURL(input.MediaURL); err != nil {
+		return err
+	}
+	if err := u.validateMetadata(input.FileSize, input.Duration, input.Bitrate); err != nil {
+		return err
+	}
+	if input.HadiID != nil {
+		if err := u.validateHadiId(ctx, *input.HadiID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (u *verseMediaUsecase) validateVerseId(ctx context.Context, verseId uint) error {
+	verse, err := u.verseRepo.GetById(ctx, verseId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return ErrVerseNotFound
+	}
+	if err != nil {
+		u.log.Error("failed to get verse for media", "error", err, "verse_id", verseId)
+		return domain.NewInternalError("failed to validate verse", err)
+	}
+	if verse == nil {
+		return ErrVerseNotFound
+	}
+	return nil
+}
+
+func (u *verseMediaUsecase) validateHadiId(ctx context.Context, hadiId int) error {
+	hadi, err := u.hadiRepo.GetByID(ctx, hadiId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return ErrHadiNotFound
+	}
+	if err != nil {
+		u.log.Error("failed to get hadi for media", "error", err, "hadi_id", hadiId)
+		return domain.NewInternalError("failed to validate hadi", err)
+	}
+	if hadi == nil {
+		return ErrHadiNotFound
+	}
+	return nil
+}
+
+func (u *verseMediaUsecase) validateMediaType(mediaType string) error {
+	switch mediaType {
+	case entity.MediaTypeAudio, entity.MediaTypeImage:
+		return nil
+	}
+	return ErrInvalidMediaType
+}
+
+func (u *verseMediaUsecase) validateMediaURL(mediaURL string) error {
+	if strings.TrimSpace(mediaURL) == "" {
+		return ErrInvalidMediaURL
+	}
+	return nil
+}
+
+func (u *verseMediaUsecase) validateMetadata(fileSize, duration, bitrate *int) error {
+	if fileSize != nil && *fileSize < 0 {
+		return ErrInvalidFileSize
+	}
+	if duration != nil && *duration < 0 {
+		return ErrInvalidDuration
+	}
+	if bitrate != nil && *bitrate < 0 {
+		return ErrInvalidBitrate
+	}
+	return nil
+}
+
+// List returns paginated verse media filtered by verse, hadi or media type
+func (u *verseMediaUsecase) List(ctx context.Context, params portuc.ListVerseMediaParams) (*portuc.PaginatedResult[entity.VerseMedia], error) {
+	// apply param default
+	if params.Page <= 0 {
+		params.Page = 1
+	}
+	if params.Limit == 0 {
+		params.Limit = 20
+	}
+
+	if params.MediaType != "" {
+		if err := u.validateMediaType(params.MediaType); err != nil {
+			return nil, err
+		}
+	}
+
+	offset := (params.Page - 1) * params.Limit
+
+	filter := repository.VerseMediaFilter{
+		Offset:    offset,
+		Limit:     params.Limit,
+		VerseID:   params.VerseID,
+		HadiID:    params.HadiID,
+		MediaType: params.MediaType,
+	}
+
+	media, total, err := u.mediaRepo.List(ctx, filter)
+	if err != nil {
+		u.log.Error("failed to list verse media", "error", err)
+		return nil, domain.NewInternalError("failed to list verse media", err)
+	}
+
+	totalPages := int(total / params.Limit)
+	if total%params.Limit > 0 {
+		totalPages++
+	}
+	return &portuc.PaginatedResult[entity.VerseMedia]{
+		Data:       media,
+		Total:      int64(total),
+		Page:       int(params.Page),
+		Limit:      int(params.Limit),
+		TotalPages: totalPages,
+	}, nil
+}
+
+// GetById returns a single verse media record
+func (u *verseMediaUsecase) GetById(ctx context.Context, id uint) (*entity.VerseMedia, error) {
+	media, err := u.mediaRepo.GetById(ctx, id)
+	if err != nil {
+		u.log.Error("failed to get verse media", "error", err, "media_id", id)
+		return nil, domain.NewInternalError("failed to get verse media", err)
+	}
+	if media == nil {
+		return nil, ErrMediaNotFound
+	}
+	return media, nil
+}
+
+// Update modifies an existing verse media record
+func (u *verseMediaUsecase) Update(ctx context.Context, id uint, input portuc.UpdateVerseMediaInput) (*entity.VerseMedia, error) {
+	media, err := u.GetById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// update fields if provided
+	if input.VerseID != nil {
+		if err := u.validateVerseId(ctx, *input.VerseID); err != nil {
+			return nil, err
+		}
+		media.VerseID = *input.VerseID
+		media.Verse = nil
+	}
+	if input.MediaType != nil {
+		if err := u.validateMediaType(*input.MediaType); err != nil {
+			return nil, err
+		}
+		media.MediaType = *input.MediaType
+	}
+	if input.MediaURL != nil {
+		if err := u.validateMediaURL(*input.MediaURL); err != nil {
+			return nil, err
+		}
+		media.MediaURL = strings.TrimSpace(*input.MediaURL)
+	}
+	if err := u.validateMetadata(input.FileSize, input.Duration, input.Bitrate); err != nil {
+		return nil, err
+	}
+	if input.FileSize != nil {
+		media.FileSize = input.FileSize
+	}
+	if input.Duration != nil {
+		media.Duration = input.Duration
+	}
+	if input.Codec != nil {
+		media.Codec = input.Codec
+	}
+	if input.Bitrate != nil {
+		media.Bitrate = input.Bitrate
+	}
+	// images carry no audio metadata
+	if media.MediaType == entity.MediaTypeImage {
+		media.Duration, media.Codec, media.Bitrate = nil, nil, nil
+	}
+	if input.Description != nil {
+		media.Description = input.Description
+	}
+	if input.HadiID != nil {
+		if err := u.validateHadiId(ctx, *input.HadiID); err != nil {
+			return nil, err
+		}
+		media.HadiID = input.HadiID
+		media.Hadi = nil
+	}
+
+	if err := u.mediaRepo.Update(ctx, media); err != nil {
+		u.log.Error("failed to update verse media", "error", err, "media_id", id)
+		return nil, domain.NewInternalError("failed to update verse media", err)
+	}
+
+	reloaded, err := u.mediaRepo.GetById(ctx, id)
+	if err != nil || reloaded == nil {
+		u.log.Error("failed to reload verse media after update", "error", err, "id", id)
+		return media, nil
+	}
+
+	return reloaded, nil
+}
+
+// Delete removes a verse media record (soft delete)
+func (u *verseMediaUsecase) Delete(ctx context.Context, id uint) error {
+	if _, err := u.GetById(ctx, id); err != nil {
+		return err
+	}
+
+	if err := u.mediaRepo.Delete(ctx, id); err != nil {
+		u.log.Error("failed to delete verse media", "error", err, "media_id", id)
+		return domain.NewInternalError("failed to delete verse media", err)
+	}
+	return nil
+}
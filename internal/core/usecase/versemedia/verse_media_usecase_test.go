@@ -0,0 +1,482 @@
+package versemedia_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"ishari-backend/internal/core/domain"
+	"ishari-backend/internal/core/entity"
+	"ishari-backend/internal/core/port/repository"
+	portuc "ishari-backend/internal/core/port/usecase"
+	"ishari-backend/internal/core/usecase/versemedia"
+
+	"gorm.io/gorm"
+)
+
+// MockVerseMediaRepository is a manual mock for testing
+type MockVerseMediaRepository struct {
+	CreateFunc  func(ctx context.Context, media *entity.VerseMedia) error
+	ListFunc    func(ctx context.Context, filter repository.VerseMediaFilter) ([]entity.VerseMedia, uint, error)
+	GetByIdFunc func(ctx context.Context, id uint) (*entity.VerseMedia, error)
+	UpdateFunc  func(ctx context.Context, media *entity.VerseMedia) error
+	DeleteFunc  func(ctx context.Context, id uint) error
+}
+
+func (m *MockVerseMediaRepository) Create(ctx context.Context, media *entity.VerseMedia) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, media)
+	}
+	return nil
+}
+
+func (m *MockVerseMediaRepository) List(ctx context.Context, filter repository.VerseMediaFilter) ([]entity.VerseMedia, uint, error) {
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, filter)
+	}
+	return nil, 0, nil
+}
+
+func (m *MockVerseMediaRepository) GetById(ctx context.Context, id uint) (*entity.VerseMedia, error) {
+	if m.GetByIdFunc != nil {
+		return m.GetByIdFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *MockVerseMediaRepository) Update(ctx context.Context, media *entity.VerseMedia) error {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, media)
+	}
+	return nil
+}
+
+func (m *MockVerseMediaRepository) Delete(ctx context.Context, id uint) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return nil
+}
+
+// MockVerseRepository is a manual mock for testing
+type MockVerseRepository struct {
+	GetByIdFunc func(ctx context.Context, id uint) (*entity.Verse, error)
+}
+
+func (m *MockVerseRepository) Create(ctx context.Context, v *entity.Verse) error { return nil }
+func (m *MockVerseRepository) List(ctx context.Context, filter repository.VerseFilter) ([]entity.Verse, uint, error) {
+	return nil, 0, nil
+}
+func (m *MockVerseRepository) Update(ctx context.Context, v *entity.Verse) error { return nil }
+func (m *MockVerseRepository) Delete(ctx context.Context, id uint) error         { return nil }
+func (m *MockVerseRepository) BulkDelete(ctx context.Context, ids []uint) error  { return nil }
+func (m *MockVerseRepository) GetById(ctx context.Context, id uint) (*entity.Verse, error) {
+	if m.GetByIdFunc != nil {
+		return m.GetByIdFunc(ctx, id)
+	}
+	return &entity.Verse{ID: id, ChapterID: 1, VerseNumber: 1, ArabicText: "Test"}, nil
+}
+func (m *MockVerseRepository) Reorder(ctx context.Context, chapterID uint, verseIDs []uint) error {
+	return nil
+}
+func (m *MockVerseRepository) Move(ctx context.Context, id, chapterID, position uint) error {
+	return nil
+}
+
+func (m *MockVerseRepository) VerseNumbers(ctx context.Context, chapterID uint) (map[uint]uint, error) {
+	return nil, nil
+}
+
+func (m *MockVerseRepository) Import(ctx context.Context, chapterID uint, verses []entity.VerseImport) error {
+	return nil
+}
+
+// MockHadiRepository is a manual mock for testing
+type MockHadiRepository struct {
+	GetByIDFunc func(ctx context.Context, id int) (*entity.Hadi, error)
+}
+
+func (m *MockHadiRepository) Create(ctx context.Context, hadi *entity.Hadi) error { return nil }
+func (m *MockHadiRepository) GetByID(ctx context.Context, id int) (*entity.Hadi, error) {
+	if m.GetByIDFunc != nil {
+		return m.GetByIDFunc(ctx, id)
+	}
+	return &entity.Hadi{ID: id, Name: "Test Hadi"}, nil
+}
+func (m *MockHadiRepository) List(ctx context.Context, limit, offset int) ([]entity.Hadi, int64, error) {
+	return nil, 0, nil
+}
+func (m *MockHadiRepository) Update(ctx context.Context, hadi *entity.Hadi) error { return nil }
+func (m *MockHadiRepository) Delete(ctx context.Context, id int) error            { return nil }
+func (m *MockHadiRepository) ListRepertoire(ctx context.Context, hadiID int) ([]repository.HadiRepertoireRow, error) {
+	return nil, nil
+}
+func (m *MockHadiRepository) ListByVerse(ctx context.Context, verseID uint) ([]repository.VerseHadiRow, error) {
+	return nil, nil
+}
+
+// MockLogger is a manual mock for testing
+type MockLogger struct{}
+
+func (m *MockLogger) Info(msg string, fields ...any)  {}
+func (m *MockLogger) Error(msg string, fields ...any) {}
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func strPtr(s string) *string {
+	return &s
+}
+
+func newUsecase(mediaRepo *MockVerseMediaRepository, verseRepo *MockVerseRepository, hadiRepo *MockHadiRepository) portuc.VerseMediaUseCase {
+	return versemedia.NewVerseMediaUsecase(mediaRepo, verseRepo, hadiRepo, &MockLogger{})
+}
+
+// =============================================================================
+// TEST: Create Verse Media
+// =============================================================================
+
+func TestVerseMediaUseCase_Create_Success(t *testing.T) {
+	var stored *entity.VerseMedia
+	mediaRepo := &MockVerseMediaRepository{
+		CreateFunc: func(ctx context.Context, media *entity.VerseMedia) error {
+			media.ID = 1
+			media.CreatedAt = time.Now()
+			stored = media
+			return nil
+		},
+		GetByIdFunc: func(ctx context.Context, id uint) (*entity.VerseMedia, error) {
+			return stored, nil
+		},
+	}
+
+	uc := newUsecase(mediaRepo, &MockVerseRepository{}, &MockHadiRepository{})
+
+	result, err := uc.Create(context.Background(), portuc.CreateVerseMediaInput{
+		VerseID:   1,
+		MediaType: entity.MediaTypeAudio,
+		MediaURL:  "  https://cdn.example.com/audio/1.mp3 ",
+		Duration:  intPtr(42),
+		HadiID:    intPtr(3),
+	})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result.ID != 1 {
+		t.Errorf("expected ID 1, got %d", result.ID)
+	}
+	if result.MediaURL != "https://cdn.example.com/audio/1.mp3" {
+		t.Errorf("expected trimmed media url, got %q", result.MediaURL)
+	}
+	if result.HadiID == nil || *result.HadiID != 3 {
+		t.Errorf("expected hadi ID 3, got %v", result.HadiID)
+	}
+}
+
+func TestVerseMediaUseCase_Create_InvalidMediaType(t *testing.T) {
+	uc := newUsecase(&MockVerseMediaRepository{}, &MockVerseRepository{}, &MockHadiRepository{})
+
+	_, err := uc.Create(context.Background(), portuc.CreateVerseMediaInput{
+		VerseID:   1,
+		MediaType: "video",
+		MediaURL:  "https://cdn.example.com/video.mp4",
+	})
+
+	if !errors.Is(err, versemedia.ErrInvalidMediaType) {
+		t.Errorf("expected ErrInvalidMediaType, got %v", err)
+	}
+}
+
+func TestVerseMediaUseCase_Create_EmptyURL(t *testing.T) {
+	uc := newUsecase(&MockVerseMediaRepository{}, &MockVerseRepository{}, &MockHadiRepository{})
+
+	_, err := uc.Create(context.Background(), portuc.CreateVerseMediaInput{
+		VerseID:   1,
+		MediaType: entity.MediaTypeImage,
+		MediaURL:  "   ",
+	})
+
+	if !errors.Is(err, versemedia.ErrInvalidMediaURL) {
+		t.Errorf("expected ErrInvalidMediaURL, got %v", err)
+	}
+}
+
+func TestVerseMediaUseCase_Create_VerseNotFound(t *testing.T) {
+	verseRepo := &MockVerseRepository{
+		GetByIdFunc: func(ctx context.Context, id uint) (*entity.Verse, error) {
+			return nil, gorm.ErrRecordNotFound
+		},
+	}
+
+	uc := newUsecase(&MockVerseMediaRepository{}, verseRepo, &MockHadiRepository{})
+
+	_, err := uc.Create(context.Background(), portuc.CreateVerseMediaInput{
+		VerseID:   99,
+		MediaType: entity.MediaTypeAudio,
+		MediaURL:  "https://cdn.example.com/audio/1.mp3",
+	})
+
+	if !errors.Is(err, versemedia.ErrVerseNotFound) {
+		t.Errorf("expected ErrVerseNotFound, got %v", err)
+	}
+}
+
+func TestVerseMediaUseCase_Create_HadiNotFound(t *testing.T) {
+	hadiRepo := &MockHadiRepository{
+		GetByIDFunc: func(ctx context.Context, id int) (*entity.Hadi, error) {
+			return nil, gorm.ErrRecordNotFound
+		},
+	}
+
+	uc := newUsecase(&MockVerseMediaRepository{}, &MockVerseRepository{}, hadiRepo)
+
+	_, err := uc.Create(context.Background(), portuc.CreateVerseMediaInput{
+		VerseID:   1,
+		MediaType: entity.MediaTypeAudio,
+		MediaURL:  "https://cdn.example.com/audio/1.mp3",
+		HadiID:    intPtr(7),
+	})
+
+	if !errors.Is(err, versemedia.ErrHadiNotFound) {
+		t.Errorf("expected ErrHadiNotFound, got %v", err)
+	}
+}
+
+func TestVerseMediaUseCase_Create_VerseLookupError(t *testing.T) {
+	verseRepo := &MockVerseRepository{
+		GetByIdFunc: func(ctx context.Context, id uint) (*entity.Verse, error) {
+			return nil, errors.New("connection refused")
+		},
+	}
+
+	uc := newUsecase(&MockVerseMediaRepository{}, verseRepo, &MockHadiRepository{})
+
+	_, err := uc.Create(context.Background(), portuc.CreateVerseMediaInput{
+		VerseID:   1,
+		MediaType: entity.MediaTypeAudio,
+		MediaURL:  "https://cdn.example.com/audio/1.mp3",
+	})
+
+	var domainErr *domain.DomainError
+	if !errors.As(err, &domainErr) || domainErr.Type != domain.ErrTypeInternal {
+		t.Errorf("expected an internal error, got %v", err)
+	}
+}
+
+func TestVerseMediaUseCase_Update_HadiLookupError(t *testing.T) {
+	hadiRepo := &MockHadiRepository{
+		GetByIDFunc: func(ctx context.Context, id int) (*entity.Hadi, error) {
+			return nil, errors.New("connection refused")
+		},
+	}
+
+	mediaRepo := &MockVerseMediaRepository{
+		GetByIdFunc: func(ctx context.Context, id uint) (*entity.VerseMedia, error) {
+			return &entity.VerseMedia{ID: id, VerseID: 1, MediaType: entity.MediaTypeAudio, MediaURL: "old.mp3"}, nil
+		},
+	}
+
+	uc := newUsecase(mediaRepo, &MockVerseRepository{}, hadiRepo)
+
+	_, err := uc.Update(context.Background(), 1, portuc.UpdateVerseMediaInput{HadiID: intPtr(7)})
+
+	var domainErr *domain.DomainError
+	if !errors.As(err, &domainErr) || domainErr.Type != domain.ErrTypeInternal {
+		t.Errorf("expected an internal error, got %v", err)
+	}
+}
+
+func TestVerseMediaUseCase_Create_NegativeDuration(t *testing.T) {
+	uc := newUsecase(&MockVerseMediaRepository{}, &MockVerseRepository{}, &MockHadiRepository{})
+
+	_, err := uc.Create(context.Background(), portuc.CreateVerseMediaInput{
+		VerseID:   1,
+		MediaType: entity.MediaTypeAudio,
+		MediaURL:  "https://cdn.example.com/audio/1.mp3",
+		Duration:  intPtr(-1),
+	})
+
+	if !errors.Is(err, versemedia.ErrInvalidDuration) {
+		t.Errorf("expected ErrInvalidDuration, got %v", err)
+	}
+}
+
+func TestVerseMediaUseCase_Create_RepositoryError(t *testing.T) {
+	mediaRepo := &MockVerseMediaRepository{
+		CreateFunc: func(ctx context.Context, media *entity.VerseMedia) error {
+			return errors.New("db error")
+		},
+	}
+
+	uc := newUsecase(mediaRepo, &MockVerseRepository{}, &MockHadiRepository{})
+
+	_, err := uc.Create(context.Background(), portuc.CreateVerseMediaInput{
+		VerseID:   1,
+		MediaType: entity.MediaTypeAudio,
+		MediaURL:  "https://cdn.example.com/audio/1.mp3",
+	})
+
+	if err == nil {
+		t.Error("expected error, got nil")
+	}
+}
+
+// =============================================================================
+// TEST: List Verse Media
+// =============================================================================
+
+func TestVerseMediaUseCase_List_PassesFilters(t *testing.T) {
+	var captured repository.VerseMediaFilter
+	mediaRepo := &MockVerseMediaRepository{
+		ListFunc: func(ctx context.Context, filter repository.VerseMediaFilter) ([]entity.VerseMedia, uint, error) {
+			captured = filter
+			return []entity.VerseMedia{
+				{ID: 1, VerseID: 5, MediaType: entity.MediaTypeAudio},
+			}, 21, nil
+		},
+	}
+
+	uc := newUsecase(mediaRepo, &MockVerseRepository{}, &MockHadiRepository{})
+
+	verseID := uint(5)
+	result, err := uc.List(context.Background(), portuc.ListVerseMediaParams{
+		Page:      2,
+		Limit:     10,
+		VerseID:   &verseID,
+		HadiID:    intPtr(3),
+		MediaType: entity.MediaTypeAudio,
+	})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if captured.Offset != 10 || captured.Limit != 10 {
+		t.Errorf("expected offset 10 limit 10, got offset %d limit %d", captured.Offset, captured.Limit)
+	}
+	if captured.VerseID == nil || *captured.VerseID != 5 {
+		t.Errorf("expected verse filter 5, got %v", captured.VerseID)
+	}
+	if captured.HadiID == nil || *captured.HadiID != 3 {
+		t.Errorf("expected hadi filter 3, got %v", captured.HadiID)
+	}
+	if captured.MediaType != entity.MediaTypeAudio {
+		t.Errorf("expected media type audio, got %s", captured.MediaType)
+	}
+	if result.TotalPages != 3 {
+		t.Errorf("expected 3 total pages, got %d", result.TotalPages)
+	}
+}
+
+func TestVerseMediaUseCase_List_Defaults(t *testing.T) {
+	var captured repository.VerseMediaFilter
+	mediaRepo := &MockVerseMediaRepository{
+		ListFunc: func(ctx context.Context, filter repository.VerseMediaFilter) ([]entity.VerseMedia, uint, error) {
+			captured = filter
+			return nil, 0, nil
+		},
+	}
+
+	uc := newUsecase(mediaRepo, &MockVerseRepository{}, &MockHadiRepository{})
+
+	result, err := uc.List(context.Background(), portuc.ListVerseMediaParams{})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if captured.Limit != 20 || captured.Offset != 0 {
+		t.Errorf("expected default limit 20 offset 0, got limit %d offset %d", captured.Limit, captured.Offset)
+	}
+	if result.Page != 1 {
+		t.Errorf("expected page 1, got %d", result.Page)
+	}
+}
+
+func TestVerseMediaUseCase_List_InvalidMediaType(t *testing.T) {
+	uc := newUsecase(&MockVerseMediaRepository{}, &MockVerseRepository{}, &MockHadiRepository{})
+
+	_, err := uc.List(context.Background(), portuc.ListVerseMediaParams{MediaType: "video"})
+	if !errors.Is(err, versemedia.ErrInvalidMediaType) {
+		t.Errorf("expected ErrInvalidMediaType, got %v", err)
+	}
+}
+
+// =============================================================================
+// TEST: Get / Update / Delete Verse Media
+// =============================================================================
+
+func TestVerseMediaUseCase_GetById_NotFound(t *testing.T) {
+	uc := newUsecase(&MockVerseMediaRepository{}, &MockVerseRepository{}, &MockHadiRepository{})
+
+	_, err := uc.GetById(context.Background(), 1)
+	if !errors.Is(err, versemedia.ErrMediaNotFound) {
+		t.Errorf("expected ErrMediaNotFound, got %v", err)
+	}
+}
+
+func TestVerseMediaUseCase_Update_Success(t *testing.T) {
+	existing := &entity.VerseMedia{ID: 1, VerseID: 1, MediaType: entity.MediaTypeAudio, MediaURL: "old.mp3"}
+	var updated *entity.VerseMedia
+	mediaRepo := &MockVerseMediaRepository{
+		GetByIdFunc: func(ctx context.Context, id uint) (*entity.VerseMedia, error) {
+			return existing, nil
+		},
+		UpdateFunc: func(ctx context.Context, media *entity.VerseMedia) error {
+			updated = media
+			return nil
+		},
+	}
+
+	uc := newUsecase(mediaRepo, &MockVerseRepository{}, &MockHadiRepository{})
+
+	result, err := uc.Update(context.Background(), 1, portuc.UpdateVerseMediaInput{
+		MediaURL:    strPtr("new.mp3"),
+		Description: strPtr("Rekaman baru"),
+		HadiID:      intPtr(2),
+	})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if updated == nil || updated.MediaURL != "new.mp3" {
+		t.Errorf("expected media url to be updated, got %+v", updated)
+	}
+	if result.Description == nil || *result.Description != "Rekaman baru" {
+		t.Errorf("expected description to be updated, got %v", result.Description)
+	}
+	if result.MediaType != entity.MediaTypeAudio {
+		t.Errorf("expected media type to be unchanged, got %s", result.MediaType)
+	}
+}
+
+func TestVerseMediaUseCase_Update_NotFound(t *testing.T) {
+	uc := newUsecase(&MockVerseMediaRepository{}, &MockVerseRepository{}, &MockHadiRepository{})
+
+	_, err := uc.Update(context.Background(), 1, portuc.UpdateVerseMediaInput{MediaURL: strPtr("new.mp3")})
+	if !errors.Is(err, versemedia.ErrMediaNotFound) {
+		t.Errorf("expected ErrMediaNotFound, got %v", err)
+	}
+}
+
+func TestVerseMediaUseCase_Delete_Success(t *testing.T) {
+	deleted := uint(0)
+	mediaRepo := &MockVerseMediaRepository{
+		GetByIdFunc: func(ctx context.Context, id uint) (*entity.VerseMedia, error) {
+			return &entity.VerseMedia{ID: id}, nil
+		},
+		DeleteFunc: func(ctx context.Context, id uint) error {
+			deleted = id
+			return nil
+		},
+	}
+
+	uc := newUsecase(mediaRepo, &MockVerseRepository{}, &MockHadiRepository{})
+
+	if err := uc.Delete(context.Background(), 4); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if deleted != 4 {
+		t.Errorf("expected media 4 to be deleted, got %d", deleted)
+	}
+}
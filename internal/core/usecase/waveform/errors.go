@@ -0,0 +1,21 @@
+package waveform
+
+import "ishari-backend/internal/core/domain"
+
+// Waveform domain errors
+var (
+	// ErrMediaNotFound indicates the verse media does not exist
+	ErrMediaNotFound = domain.NewNotFoundError("verse media not found", nil)
+
+	// ErrNotAudio indicates a waveform was requested for non audio media
+	ErrNotAudio = domain.NewInvalidInputError("waveform is only available for audio media", nil)
+
+	// ErrInvalidPoints indicates the requested number of points is out of range
+	ErrInvalidPoints = domain.NewInvalidInputError("points must be between 1 and 2000", nil)
+
+	// ErrUnsupportedAudio indicates the audio format cannot be decoded for peaks
+	ErrUnsupportedAudio = domain.NewInvalidInputError("waveform is only available for wav and mp3 audio", nil)
+
+	// ErrFileNotStored indicates the media file is hosted outside our storage
+	ErrFileNotStored = domain.NewNotFoundError("media file is not stored by this server", nil)
+)
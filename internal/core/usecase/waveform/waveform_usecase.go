@@ -0,0 +1,218 @@
+package waveform
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"math"
+	"strings"
+
+	"ishari-backend/internal/core/domain"
+	"ishari-backend/internal/core/entity"
+	"ishari-backend/internal/core/port/logger"
+	"ishari-backend/internal/core/port/repository"
+	"ishari-backend/internal/core/port/storage"
+	portuc "ishari-backend/internal/core/port/usecase"
+)
+
+const (
+	// BasePoints is the resolution peaks are generated and cached at
+	BasePoints = 2000
+	// DefaultPoints is used when the client does not ask for a resolution
+	DefaultPoints = 200
+
+	peaksSuffix = ".peaks.json"
+	// cacheVersion is bumped when cached documents must be regenerated.
+	// Version 1 held MP3 loudness estimates rather than decoded peaks.
+	cacheVersion = 2
+)
+
+// PeakExtractor defines the interface for decoding audio into amplitude peaks.
+// This abstraction keeps codec handling out of the usecase.
+type PeakExtractor interface {
+	// Peaks returns points peaks in [0, 1] for the size bytes readable from r
+	Peaks(r io.ReaderAt, size int64, points int) ([]float64, error)
+}
+
+// ErrUnsupportedFormat must be returned (or wrapped) by PeakExtractor for formats it cannot decode
+var ErrUnsupportedFormat = errors.New("unsupported audio format")
+
+// cachedPeaks is the JSON document stored next to the audio file
+type cachedPeaks struct {
+	Version int       `json:"version"`
+	Points  int       `json:"points"`
+	Peaks   []float64 `json:"peaks"`
+}
+
+type waveformUsecase struct {
+	mediaRepo repository.VerseMediaRepository
+	storage   storage.Storage
+	extractor PeakExtractor
+	log       logger.Logger
+}
+
+func NewWaveformUsecase(mediaRepo repository.VerseMediaRepository, store storage.Storage, extractor PeakExtractor, log logger.Logger) portuc.WaveformUseCase {
+	return &waveformUsecase{
+		mediaRepo: mediaRepo,
+		storage:   store,
+		extractor: extractor,
+		log:       log,
+	}
+}
+
+// Get returns cached peaks, generating them first for files uploaded before caching existed
+func (u *waveformUsecase) Get(ctx context.Context, mediaID uint, points int) (*portuc.Waveform, error) {
+	if points == 0 {
+		points = DefaultPoints
+	}
+	if points < 0 || points > BasePoints {
+		return nil, ErrInvalidPoints
+	}
+
+	media, err := u.mediaRepo.GetById(ctx, mediaID)
+	if err != nil {
+		u.log.Error("failed to get verse media for waveform", "error", err, "media_id", mediaID)
+		return nil, domain.NewInternalError("failed to get verse media", err)
+	}
+	if media == nil {
+		return nil, ErrMediaNotFound
+	}
+	if media.MediaType != entity.MediaTypeAudio {
+		return nil, ErrNotAudio
+	}
+
+	key, ok := u.storage.KeyFromURL(media.MediaURL)
+	if !ok {
+		return nil, ErrFileNotStored
+	}
+
+	peaks, err := u.load(ctx, key)
+	if errors.Is(err, storage.ErrObjectNotFound) {
+		peaks, err = u.generateFromStorage(ctx, key)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	peaks = downsample(peaks, points)
+	return &portuc.Waveform{
+		MediaID:  media.ID,
+		Points:   len(peaks),
+		Duration: media.Duration,
+		Peaks:    peaks,
+	}, nil
+}
+
+// Generate decodes the audio and stores its peaks at BasePoints resolution
+func (u *waveformUsecase) Generate(ctx context.Context, key string, content io.ReaderAt, size int64) error {
+	_, err := u.generate(ctx, key, content, size)
+	return err
+}
+
+// Remove deletes cached peaks; failures are only logged
+func (u *waveformUsecase) Remove(ctx context.Context, key string) {
+	if err := u.storage.Delete(ctx, key+peaksSuffix); err != nil {
+		u.log.Error("failed to delete cached peaks", "error", err, "key", key)
+	}
+}
+
+func (u *waveformUsecase) generate(ctx context.Context, key string, content io.ReaderAt, size int64) ([]float64, error) {
+	peaks, err := u.extractor.Peaks(content, size, BasePoints)
+	if err != nil {
+		if errors.Is(err, ErrUnsupportedFormat) {
+			return nil, ErrUnsupportedAudio
+		}
+		return nil, domain.NewInvalidInputError("failed to decode audio for waveform", err)
+	}
+	for i, p := range peaks {
+		peaks[i] = math.Round(p*10000) / 10000
+	}
+
+	doc, err := json.Marshal(cachedPeaks{Version: cacheVersion, Points: len(peaks), Peaks: peaks})
+	if err != nil {
+		return nil, domain.NewInternalError("failed to encode peaks", err)
+	}
+	if _, err := u.storage.Put(ctx, key+peaksSuffix, strings.NewReader(string(doc)), int64(len(doc)), "application/json"); err != nil {
+		u.log.Error("failed to cache peaks", "error", err, "key", key)
+		return nil, domain.NewInternalError("failed to store peaks", err)
+	}
+	return peaks, nil
+}
+
+func (u *waveformUsecase) generateFromStorage(ctx context.Context, key string) ([]float64, error) {
+	info, err := u.storage.Stat(ctx, key)
+	if err != nil {
+		if errors.Is(err, storage.ErrObjectNotFound) {
+			return nil, domain.NewNotFoundError("media file not found", err)
+		}
+		u.log.Error("failed to stat audio for peaks", "error", err, "key", key)
+		return nil, domain.NewInternalError("failed to read media file", err)
+	}
+	return u.generate(ctx, key, &storageReaderAt{ctx: ctx, storage: u.storage, key: key, size: info.Size}, info.Size)
+}
+
+func (u *waveformUsecase) load(ctx context.Context, key string) ([]float64, error) {
+	rc, _, err := u.storage.Get(ctx, key+peaksSuffix)
+	if err != nil {
+		if errors.Is(err, storage.ErrObjectNotFound) {
+			return nil, err
+		}
+		u.log.Error("failed to read cached peaks", "error", err, "key", key)
+		return nil, domain.NewInternalError("failed to read peaks", err)
+	}
+	defer rc.Close()
+
+	var doc cachedPeaks
+	if err := json.NewDecoder(rc).Decode(&doc); err != nil || len(doc.Peaks) == 0 {
+		// regenerate unreadable caches instead of failing forever
+		u.log.Error("invalid cached peaks", "error", err, "key", key)
+		return nil, storage.ErrObjectNotFound
+	}
+	if doc.Version < cacheVersion {
+		return nil, storage.ErrObjectNotFound
+	}
+	return doc.Peaks, nil
+}
+
+// downsample reduces peaks to points values keeping the maximum of each bucket
+func downsample(peaks []float64, points int) []float64 {
+	if points >= len(peaks) {
+		return peaks
+	}
+	out := make([]float64, points)
+	for i := range out {
+		start := i * len(peaks) / points
+		end := (i + 1) * len(peaks) / points
+		for _, p := range peaks[start:end] {
+			out[i] = max(out[i], p)
+		}
+	}
+	return out
+}
+
+// storageReaderAt adapts ranged storage reads to io.ReaderAt
+type storageReaderAt struct {
+	ctx     context.Context
+	storage storage.Storage
+	key     string
+	size    int64
+}
+
+func (r *storageReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	if off >= r.size {
+		return 0, io.EOF
+	}
+	length := min(int64(len(p)), r.size-off)
+	rc, err := r.storage.GetRange(r.ctx, r.key, off, length)
+	if err != nil {
+		return 0, err
+	}
+	defer rc.Close()
+
+	n, err := io.ReadFull(rc, p[:length])
+	if err == nil && int64(n) < int64(len(p)) {
+		err = io.EOF
+	}
+	return n, err
+}
@@ -0,0 +1,247 @@
+package waveform_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"ishari-backend/internal/core/entity"
+	"ishari-backend/internal/core/port/repository"
+	"ishari-backend/internal/core/port/storage"
+	portuc "ishari-backend/internal/core/port/usecase"
+	"ishari-backend/internal/core/usecase/waveform"
+)
+
+// MockVerseMediaRepository is a manual mock for testing
+type MockVerseMediaRepository struct {
+	Media map[uint]*entity.VerseMedia
+}
+
+func (m *MockVerseMediaRepository) Create(ctx context.Context, media *entity.VerseMedia) error {
+	return nil
+}
+func (m *MockVerseMediaRepository) List(ctx context.Context, filter repository.VerseMediaFilter) ([]entity.VerseMedia, uint, error) {
+	return nil, 0, nil
+}
+func (m *MockVerseMediaRepository) GetById(ctx context.Context, id uint) (*entity.VerseMedia, error) {
+	return m.Media[id], nil
+}
+func (m *MockVerseMediaRepository) Update(ctx context.Context, media *entity.VerseMedia) error {
+	return nil
+}
+func (m *MockVerseMediaRepository) Delete(ctx context.Context, id uint) error { return nil }
+
+// MockStorage is an in-memory storage for testing
+type MockStorage struct {
+	Objects map[string][]byte
+}
+
+func (m *MockStorage) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) (*storage.ObjectInfo, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	m.Objects[key] = data
+	return &storage.ObjectInfo{Key: key, Size: int64(len(data))}, nil
+}
+func (m *MockStorage) Get(ctx context.Context, key string) (io.ReadCloser, *storage.ObjectInfo, error) {
+	data, ok := m.Objects[key]
+	if !ok {
+		return nil, nil, storage.ErrObjectNotFound
+	}
+	return io.NopCloser(bytes.NewReader(data)), &storage.ObjectInfo{Key: key, Size: int64(len(data))}, nil
+}
+func (m *MockStorage) Stat(ctx context.Context, key string) (*storage.ObjectInfo, error) {
+	data, ok := m.Objects[key]
+	if !ok {
+		return nil, storage.ErrObjectNotFound
+	}
+	return &storage.ObjectInfo{Key: key, Size: int64(len(data))}, nil
+}
+func (m *MockStorage) GetRange(ctx context.Context, key string, offset, length int64) (io.ReadCloser, error) {
+	data, ok := m.Objects[key]
+	if !ok {
+		return nil, storage.ErrObjectNotFound
+	}
+	return io.NopCloser(bytes.NewReader(data[offset : offset+length])), nil
+}
+func (m *MockStorage) Delete(ctx context.Context, key string) error {
+	delete(m.Objects, key)
+	return nil
+}
+func (m *MockStorage) Presign(ctx context.Context, key string, ttl time.Duration) (string, error) {
+	return m.URL(key), nil
+}
+func (m *MockStorage) URL(key string) string { return "http://files.test/" + key }
+func (m *MockStorage) KeyFromURL(url string) (string, bool) {
+	return strings.CutPrefix(url, "http://files.test/")
+}
+
+// MockPeakExtractor is a manual mock for testing
+type MockPeakExtractor struct {
+	Calls     int
+	PeaksFunc func(r io.ReaderAt, size int64, points int) ([]float64, error)
+}
+
+func (m *MockPeakExtractor) Peaks(r io.ReaderAt, size int64, points int) ([]float64, error) {
+	m.Calls++
+	if m.PeaksFunc != nil {
+		return m.PeaksFunc(r, size, points)
+	}
+	// a ramp makes downsampling easy to verify
+	peaks := make([]float64, points)
+	for i := range peaks {
+		peaks[i] = float64(i+1) / float64(points)
+	}
+	return peaks, nil
+}
+
+// MockLogger is a manual mock for testing
+type MockLogger struct{}
+
+func (m *MockLogger) Info(msg string, fields ...any)  {}
+func (m *MockLogger) Error(msg string, fields ...any) {}
+
+func newFixture() (*MockStorage, *MockPeakExtractor, portuc.WaveformUseCase) {
+	duration := 30
+	repo := &MockVerseMediaRepository{Media: map[uint]*entity.VerseMedia{
+		1: {ID: 1, MediaType: entity.MediaTypeAudio, MediaURL: "http://files.test/verse-media/1/a.mp3", Duration: &duration},
+		2: {ID: 2, MediaType: entity.MediaTypeImage, MediaURL: "http://files.test/verse-media/1/b.png"},
+		3: {ID: 3, MediaType: entity.MediaTypeAudio, MediaURL: "https://cdn.example.com/c.mp3"},
+	}}
+	store := &MockStorage{Objects: map[string][]byte{"verse-media/1/a.mp3": []byte("audio-bytes")}}
+	extractor := &MockPeakExtractor{}
+	return store, extractor, waveform.NewWaveformUsecase(repo, store, extractor, &MockLogger{})
+}
+
+// =============================================================================
+// TEST: Get Waveform
+// =============================================================================
+
+func TestWaveformUseCase_Get_GeneratesAndCaches(t *testing.T) {
+	store, extractor, uc := newFixture()
+
+	result, err := uc.Get(context.Background(), 1, 4)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result.Points != 4 || len(result.Peaks) != 4 {
+		t.Fatalf("expected 4 peaks, got %d", len(result.Peaks))
+	}
+	if result.Peaks[3] != 1 || result.Peaks[0] != 0.25 {
+		t.Errorf("expected bucket maxima of the ramp, got %v", result.Peaks)
+	}
+	if result.Duration == nil || *result.Duration != 30 {
+		t.Errorf("expected duration 30, got %v", result.Duration)
+	}
+	if _, ok := store.Objects["verse-media/1/a.mp3.peaks.json"]; !ok {
+		t.Error("expected peaks to be cached next to the file")
+	}
+
+	// second request is served from the cache
+	if _, err := uc.Get(context.Background(), 1, 100); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if extractor.Calls != 1 {
+		t.Errorf("expected peaks to be extracted once, got %d", extractor.Calls)
+	}
+}
+
+func TestWaveformUseCase_Get_RegeneratesVersion1Cache(t *testing.T) {
+	store, extractor, uc := newFixture()
+	store.Objects["verse-media/1/a.mp3.peaks.json"] = []byte(`{"version":1,"points":2,"peaks":[0.5,1]}`)
+
+	if _, err := uc.Get(context.Background(), 1, 10); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if extractor.Calls != 1 {
+		t.Errorf("expected caches of estimated MP3 peaks to be regenerated, got %d extractions", extractor.Calls)
+	}
+}
+
+func TestWaveformUseCase_Get_PointsOfShortCache(t *testing.T) {
+	store, _, uc := newFixture()
+	store.Objects["verse-media/1/a.mp3.peaks.json"] = []byte(`{"version":2,"points":2,"peaks":[0.5,1]}`)
+
+	result, err := uc.Get(context.Background(), 1, 10)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result.Points != 2 || len(result.Peaks) != 2 {
+		t.Errorf("expected points to match the 2 cached peaks, got %d points and %d peaks", result.Points, len(result.Peaks))
+	}
+}
+
+func TestWaveformUseCase_Get_DefaultPoints(t *testing.T) {
+	_, _, uc := newFixture()
+
+	result, err := uc.Get(context.Background(), 1, 0)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(result.Peaks) != waveform.DefaultPoints {
+		t.Errorf("expected %d peaks, got %d", waveform.DefaultPoints, len(result.Peaks))
+	}
+}
+
+func TestWaveformUseCase_Get_InvalidPoints(t *testing.T) {
+	_, _, uc := newFixture()
+
+	_, err := uc.Get(context.Background(), 1, waveform.BasePoints+1)
+
+	if !errors.Is(err, waveform.ErrInvalidPoints) {
+		t.Errorf("expected ErrInvalidPoints, got %v", err)
+	}
+}
+
+func TestWaveformUseCase_Get_Errors(t *testing.T) {
+	_, _, uc := newFixture()
+
+	tests := []struct {
+		id   uint
+		want error
+	}{
+		{id: 2, want: waveform.ErrNotAudio},
+		{id: 3, want: waveform.ErrFileNotStored},
+		{id: 99, want: waveform.ErrMediaNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.id), func(t *testing.T) {
+			if _, err := uc.Get(context.Background(), tt.id, 10); !errors.Is(err, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestWaveformUseCase_Generate_Unsupported(t *testing.T) {
+	store, extractor, uc := newFixture()
+	extractor.PeaksFunc = func(r io.ReaderAt, size int64, points int) ([]float64, error) {
+		return nil, fmt.Errorf("%w: opus", waveform.ErrUnsupportedFormat)
+	}
+
+	err := uc.Generate(context.Background(), "verse-media/1/a.ogg", bytes.NewReader([]byte("OggS")), 4)
+
+	if !errors.Is(err, waveform.ErrUnsupportedAudio) {
+		t.Errorf("expected ErrUnsupportedAudio, got %v", err)
+	}
+	if len(store.Objects) != 1 {
+		t.Error("expected no peaks to be cached")
+	}
+}
+
+func TestWaveformUseCase_Remove(t *testing.T) {
+	store, _, uc := newFixture()
+	store.Objects["verse-media/1/a.mp3.peaks.json"] = []byte("{}")
+
+	uc.Remove(context.Background(), "verse-media/1/a.mp3")
+
+	if _, ok := store.Objects["verse-media/1/a.mp3.peaks.json"]; ok {
+		t.Error("expected cached peaks to be removed")
+	}
+}
@@ -0,0 +1,8 @@
+BEGIN;
+
+ALTER TABLE public.verse_media DROP CONSTRAINT IF EXISTS verse_media_metadata_check;
+ALTER TABLE public.verse_media
+    DROP COLUMN IF EXISTS bitrate,
+    DROP COLUMN IF EXISTS codec;
+
+COMMIT;
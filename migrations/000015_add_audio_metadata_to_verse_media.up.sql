@@ -0,0 +1,14 @@
+BEGIN;
+
+ALTER TABLE public.verse_media
+    ADD COLUMN IF NOT EXISTS codec varchar(20),
+    ADD COLUMN IF NOT EXISTS bitrate integer;
+
+ALTER TABLE public.verse_media
+    ADD CONSTRAINT verse_media_metadata_check
+    CHECK ((file_size IS NULL OR file_size >= 0) AND (duration IS NULL OR duration >= 0) AND (bitrate IS NULL OR bitrate >= 0));
+
+COMMENT ON COLUMN public.verse_media.duration IS 'Duration in seconds';
+COMMENT ON COLUMN public.verse_media.bitrate IS 'Average bitrate in kbit/s';
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE public.hadi DROP COLUMN IF EXISTS images;
+ALTER TABLE public.books DROP COLUMN IF EXISTS cover_images;
+
+COMMIT;
@@ -0,0 +1,9 @@
+BEGIN;
+
+ALTER TABLE public.books ADD COLUMN IF NOT EXISTS cover_images jsonb;
+ALTER TABLE public.hadi ADD COLUMN IF NOT EXISTS images jsonb;
+
+COMMENT ON COLUMN public.books.cover_images IS 'URLs of the resized cover copies: {"thumb","medium","large"}';
+COMMENT ON COLUMN public.hadi.images IS 'URLs of the resized portrait copies: {"thumb","medium","large"}';
+
+COMMIT;
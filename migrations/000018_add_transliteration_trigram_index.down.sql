@@ -0,0 +1,6 @@
+BEGIN;
+
+-- pg_trgm is left installed: it may predate this migration or serve other objects
+DROP INDEX IF EXISTS public.idx_verses_transliteration_trgm;
+
+COMMIT;
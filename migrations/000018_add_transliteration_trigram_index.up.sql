@@ -0,0 +1,9 @@
+BEGIN;
+
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- serves the word_similarity (<%) lookups of the fuzzy search mode
+CREATE INDEX IF NOT EXISTS idx_verses_transliteration_trgm
+    ON public.verses USING gin (transliteration gin_trgm_ops);
+
+COMMIT;
@@ -0,0 +1,53 @@
+BEGIN;
+
+-- Chapter categories (genres) managed through the API instead of chapters_category_check
+CREATE TABLE IF NOT EXISTS public.categories (
+    id SERIAL PRIMARY KEY,
+    slug character varying(100) NOT NULL,
+    name character varying(100) NOT NULL,
+    sort_order integer NOT NULL DEFAULT 0,
+    description text,
+    created_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP,
+    updated_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP,
+    deleted_at timestamp with time zone
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS unique_category_slug ON public.categories (slug) WHERE deleted_at IS NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS unique_category_name ON public.categories (name) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_categories_deleted_at ON public.categories USING btree (deleted_at);
+
+-- The six categories of the old CHECK constraint, in reading order
+INSERT INTO public.categories (slug, name, sort_order) VALUES
+    ('diwan', 'Diwan', 1),
+    ('syaraful-anam', 'Syaraful Anam', 2),
+    ('muhud', 'Muhud', 3),
+    ('rowi', 'Rowi', 4),
+    ('diba', 'Diba', 5),
+    ('muradah', 'Muradah', 6);
+
+-- Move chapters from the category string to a foreign key
+ALTER TABLE public.chapters ADD COLUMN IF NOT EXISTS category_id integer;
+
+UPDATE public.chapters c
+SET category_id = cat.id
+FROM public.categories cat
+WHERE cat.name = c.category;
+
+ALTER TABLE public.chapters ALTER COLUMN category_id SET NOT NULL;
+
+ALTER TABLE public.chapters
+    ADD CONSTRAINT chapters_category_id_fkey
+    FOREIGN KEY (category_id) REFERENCES public.categories (id)
+    ON DELETE RESTRICT;
+
+CREATE INDEX IF NOT EXISTS idx_chapters_category_id ON public.chapters USING btree (category_id);
+
+DROP INDEX IF EXISTS public.idx_chapters_category;
+ALTER TABLE public.chapters DROP CONSTRAINT IF EXISTS chapters_category_check;
+ALTER TABLE public.chapters DROP COLUMN IF EXISTS category;
+
+CREATE TRIGGER audit_categories_changes
+    AFTER INSERT OR UPDATE OR DELETE ON public.categories
+    FOR EACH ROW EXECUTE FUNCTION public.log_table_changes();
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP INDEX IF EXISTS public.idx_audit_logs_table_record;
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+-- serves the history of one record (table_name + record_id), walked newest first by id
+CREATE INDEX IF NOT EXISTS idx_audit_logs_table_record
+    ON public.audit_logs (table_name, record_id, id DESC);
+
+COMMIT;
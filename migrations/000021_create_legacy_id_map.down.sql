@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP TABLE IF EXISTS public.legacy_id_map;
+
+COMMIT;
@@ -0,0 +1,20 @@
+BEGIN;
+
+-- Traces rows imported by cmd/import-legacy back to the legacy MySQL rows they came from.
+-- source names the legacy database, so one legacy row maps to one row per entity table.
+CREATE TABLE IF NOT EXISTS public.legacy_id_map (
+    id BIGSERIAL PRIMARY KEY,
+    source character varying(100) NOT NULL,
+    legacy_table character varying(100) NOT NULL,
+    legacy_id bigint NOT NULL,
+    entity_table character varying(100) NOT NULL,
+    entity_id integer NOT NULL,
+    imported_at timestamp with time zone NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS unique_legacy_id
+    ON public.legacy_id_map (source, legacy_table, legacy_id, entity_table);
+CREATE INDEX IF NOT EXISTS idx_legacy_id_map_entity
+    ON public.legacy_id_map (entity_table, entity_id);
+
+COMMIT;
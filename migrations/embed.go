@@ -0,0 +1,10 @@
+// Package migrations embeds the SQL migrations so the api binary can apply them itself.
+// Files are named NNNNNN_name.up.sql and NNNNNN_name.down.sql, as golang-migrate expects.
+package migrations
+
+import "embed"
+
+// FS holds every migration file
+//
+//go:embed *.sql
+var FS embed.FS
@@ -0,0 +1,87 @@
+// Package audio extracts technical metadata (codec, duration, bitrate) from
+// MP3, Ogg (Vorbis/Opus), WAV and MP4/M4A files without external tools.
+package audio
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"ishari-backend/internal/core/usecase/upload"
+)
+
+var (
+	// ErrUnsupportedFormat is returned when the content is not a known audio container
+	ErrUnsupportedFormat = errors.New("unsupported audio format")
+
+	// ErrCorrupt is returned when the container is recognised but its headers are invalid
+	ErrCorrupt = errors.New("corrupt audio file")
+)
+
+// Prober implements upload.AudioProber
+type Prober struct{}
+
+// NewProber creates a new audio prober
+func NewProber() *Prober {
+	return &Prober{}
+}
+
+// Probe detects the container from its magic bytes and parses its headers
+func (p *Prober) Probe(r io.ReaderAt, size int64) (*upload.AudioMetadata, error) {
+	head := make([]byte, 12)
+	n, err := r.ReadAt(head, 0)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	head = head[:n]
+	if len(head) < 4 {
+		return nil, ErrUnsupportedFormat
+	}
+
+	switch {
+	case string(head[:4]) == "OggS":
+		return probeOgg(r, size)
+	case len(head) >= 12 && string(head[:4]) == "RIFF" && string(head[8:12]) == "WAVE":
+		return probeWAV(r, size)
+	case len(head) >= 8 && string(head[4:8]) == "ftyp":
+		return probeMP4(r, size)
+	case string(head[:3]) == "ID3" || (head[0] == 0xFF && head[1]&0xE0 == 0xE0):
+		return probeMP3(r, size)
+	}
+	return nil, ErrUnsupportedFormat
+}
+
+func corrupt(format string, args ...any) error {
+	return fmt.Errorf("%w: %s", ErrCorrupt, fmt.Sprintf(format, args...))
+}
+
+// durationOf converts a sample count to a duration without overflowing on long files
+func durationOf(samples int64, sampleRate int) time.Duration {
+	if sampleRate <= 0 {
+		return 0
+	}
+	secs := samples / int64(sampleRate)
+	rem := samples % int64(sampleRate)
+	return time.Duration(secs)*time.Second + time.Duration(rem)*time.Second/time.Duration(sampleRate)
+}
+
+// averageBitrate returns bits per second for a payload played over d
+func averageBitrate(bytes int64, d time.Duration) int {
+	if d <= 0 {
+		return 0
+	}
+	return int(float64(bytes*8) / d.Seconds())
+}
+
+// readAt reads exactly len(buf) bytes at off, reporting short reads as corruption
+func readAt(r io.ReaderAt, buf []byte, off int64, what string) error {
+	n, err := r.ReadAt(buf, off)
+	if n == len(buf) {
+		return nil
+	}
+	if err == nil || errors.Is(err, io.EOF) {
+		return corrupt("truncated %s", what)
+	}
+	return err
+}
@@ -0,0 +1,274 @@
+package audio
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"ishari-backend/internal/core/usecase/upload"
+)
+
+func probeBytes(data []byte) (*upload.AudioMetadata, error) {
+	return NewProber().Probe(bytes.NewReader(data), int64(len(data)))
+}
+
+func wavFile(sampleRate, channels, bits int, seconds float64) []byte {
+	blockAlign := channels * bits / 8
+	dataSize := int(float64(sampleRate)*seconds) * blockAlign
+
+	var b bytes.Buffer
+	b.WriteString("RIFF")
+	binary.Write(&b, binary.LittleEndian, uint32(36+dataSize))
+	b.WriteString("WAVE")
+	// a LIST chunk before fmt must be skipped
+	b.WriteString("LIST")
+	binary.Write(&b, binary.LittleEndian, uint32(3))
+	b.Write([]byte{1, 2, 3, 0})
+	b.WriteString("fmt ")
+	binary.Write(&b, binary.LittleEndian, uint32(16))
+	binary.Write(&b, binary.LittleEndian, uint16(1))
+	binary.Write(&b, binary.LittleEndian, uint16(channels))
+	binary.Write(&b, binary.LittleEndian, uint32(sampleRate))
+	binary.Write(&b, binary.LittleEndian, uint32(sampleRate*blockAlign))
+	binary.Write(&b, binary.LittleEndian, uint16(blockAlign))
+	binary.Write(&b, binary.LittleEndian, uint16(bits))
+	b.WriteString("data")
+	binary.Write(&b, binary.LittleEndian, uint32(dataSize))
+	b.Write(make([]byte, dataSize))
+	return b.Bytes()
+}
+
+// mp3Frames builds MPEG-1 layer III frames at 128 kbit/s, 44.1 kHz, joint stereo
+func mp3Frames(n int) []byte {
+	frame := make([]byte, 417)
+	copy(frame, []byte{0xFF, 0xFB, 0x90, 0x40})
+	return bytes.Repeat(frame, n)
+}
+
+func id3Tag(payload int) []byte {
+	tag := []byte{'I', 'D', '3', 4, 0, 0, 0, 0, byte(payload >> 7), byte(payload & 0x7F)}
+	return append(tag, make([]byte, payload)...)
+}
+
+func oggPageBytes(headerType byte, granule int64, serial uint32, seq uint32, payload []byte) []byte {
+	var b bytes.Buffer
+	b.WriteString("OggS")
+	b.WriteByte(0)
+	b.WriteByte(headerType)
+	binary.Write(&b, binary.LittleEndian, granule)
+	binary.Write(&b, binary.LittleEndian, serial)
+	binary.Write(&b, binary.LittleEndian, seq)
+	binary.Write(&b, binary.LittleEndian, uint32(0)) // crc, not verified
+	var lacing []byte
+	rest := len(payload)
+	for rest >= 255 {
+		lacing = append(lacing, 255)
+		rest -= 255
+	}
+	lacing = append(lacing, byte(rest))
+	b.WriteByte(byte(len(lacing)))
+	b.Write(lacing)
+	b.Write(payload)
+	return b.Bytes()
+}
+
+func opusFile(seconds int) []byte {
+	head := []byte("OpusHead")
+	head = append(head, 1, 2)
+	head = binary.LittleEndian.AppendUint16(head, 312)
+	head = binary.LittleEndian.AppendUint32(head, 48000)
+	head = append(head, 0, 0, 0)
+
+	var b bytes.Buffer
+	b.Write(oggPageBytes(0x02, 0, 7, 0, head))
+	b.Write(oggPageBytes(0x00, 0, 7, 1, []byte("OpusTags\x00\x00\x00\x00\x00\x00\x00\x00")))
+	b.Write(oggPageBytes(0x00, 48000, 7, 2, make([]byte, 4000)))
+	b.Write(oggPageBytes(0x04, int64(seconds*48000+312), 7, 3, make([]byte, 4000)))
+	return b.Bytes()
+}
+
+func TestProbeWAV(t *testing.T) {
+	got, err := probeBytes(wavFile(16000, 1, 16, 2.5))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := upload.AudioMetadata{Codec: "pcm", Duration: 2500 * time.Millisecond, Bitrate: 256000, SampleRate: 16000, Channels: 1}
+	if *got != want {
+		t.Errorf("got %+v, want %+v", *got, want)
+	}
+}
+
+func TestProbeWAV_MissingData(t *testing.T) {
+	data := wavFile(16000, 1, 16, 0)
+	data = data[:len(data)-8] // drop the data chunk header
+
+	_, err := probeBytes(data)
+	if !errors.Is(err, ErrCorrupt) {
+		t.Errorf("expected ErrCorrupt, got %v", err)
+	}
+}
+
+func TestProbeMP3_CBR(t *testing.T) {
+	data := append(id3Tag(100), mp3Frames(100)...)
+
+	got, err := probeBytes(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Codec != "mp3" || got.SampleRate != 44100 || got.Channels != 2 || got.Bitrate != 128000 {
+		t.Errorf("unexpected metadata %+v", *got)
+	}
+	// 100 frames * 417 bytes at 128 kbit/s
+	want := time.Duration(41700 * 8 * float64(time.Second) / 128000)
+	if got.Duration != want {
+		t.Errorf("expected duration %v, got %v", want, got.Duration)
+	}
+}
+
+func TestProbeMP3_Xing(t *testing.T) {
+	data := mp3Frames(10)
+	xing := data[4+32:]
+	copy(xing, "Xing")
+	binary.BigEndian.PutUint32(xing[4:], 0x3)
+	binary.BigEndian.PutUint32(xing[8:], 3000)    // frames
+	binary.BigEndian.PutUint32(xing[12:], 960000) // bytes
+
+	got, err := probeBytes(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := durationOf(3000*1152, 44100)
+	if got.Duration != want {
+		t.Errorf("expected duration %v, got %v", want, got.Duration)
+	}
+	if got.Bitrate != averageBitrate(960000, want) {
+		t.Errorf("expected vbr bitrate, got %d", got.Bitrate)
+	}
+}
+
+func TestProbeMP3_NoFrames(t *testing.T) {
+	data := append(id3Tag(20), bytes.Repeat([]byte{0x00, 0x11}, 500)...)
+
+	_, err := probeBytes(data)
+	if !errors.Is(err, ErrCorrupt) {
+		t.Errorf("expected ErrCorrupt, got %v", err)
+	}
+}
+
+func TestProbeOggOpus(t *testing.T) {
+	got, err := probeBytes(opusFile(90))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Codec != "opus" || got.Channels != 2 || got.Duration != 90*time.Second {
+		t.Errorf("unexpected metadata %+v", *got)
+	}
+}
+
+func TestProbeOgg_Truncated(t *testing.T) {
+	data := opusFile(10)[:20]
+
+	_, err := probeBytes(data)
+	if !errors.Is(err, ErrCorrupt) {
+		t.Errorf("expected ErrCorrupt, got %v", err)
+	}
+}
+
+func TestProbeUnsupported(t *testing.T) {
+	_, err := probeBytes([]byte("%PDF-1.7 definitely not audio"))
+	if !errors.Is(err, ErrUnsupportedFormat) {
+		t.Errorf("expected ErrUnsupportedFormat, got %v", err)
+	}
+}
+
+func TestPeaksWAV(t *testing.T) {
+	data := wavFile(8000, 2, 16, 1)
+	samples := data[len(data)-8000*4:]
+	// left channel ramps from silence to full scale over the second
+	for i := 0; i < 8000; i++ {
+		v := int16(float64(i) / 8000 * 32767)
+		binary.LittleEndian.PutUint16(samples[i*4:], uint16(v))
+	}
+
+	peaks, err := NewPeakExtractor().Peaks(bytes.NewReader(data), int64(len(data)), 4)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []float64{0.25, 0.5, 0.75, 1}
+	for i := range want {
+		if diff := peaks[i] - want[i]; diff > 0.001 || diff < -0.001 {
+			t.Errorf("peak %d: expected %.3f, got %.3f", i, want[i], peaks[i])
+		}
+	}
+}
+
+// setBits writes v into n bits of buf starting at bit offset pos
+func setBits(buf []byte, pos, n, v int) {
+	for i := 0; i < n; i++ {
+		if v>>(n-1-i)&1 == 1 {
+			buf[(pos+i)>>3] |= 0x80 >> uint((pos+i)&7)
+		}
+	}
+}
+
+func TestPeaksMP3(t *testing.T) {
+	data := mp3Frames(16)
+	// frames 8..15 carry a low tone in the left channel, louder in the last four:
+	// one big_values pair coded "010" with table 1, (1, 0) and a positive sign
+	for i := 8; i < 16; i++ {
+		frame := data[i*417:]
+		side, main := frame[4:], frame[4+32:]
+		gain := 190
+		if i >= 12 {
+			gain = 202
+		}
+		for gr := 0; gr < 2; gr++ {
+			base := 9 + 3 + 8 + gr*2*59
+			setBits(side, base, 12, 3)      // part2_3_length
+			setBits(side, base+12, 9, 1)    // big_values
+			setBits(side, base+21, 8, gain) // global_gain
+			setBits(side, base+34, 5, 1)    // table_select[0]
+			setBits(main, gr*3, 3, 0b010)
+		}
+	}
+
+	peaks, err := NewPeakExtractor().Peaks(bytes.NewReader(data), int64(len(data)), 4)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if peaks[0] != 0 || peaks[1] != 0 {
+		t.Errorf("expected silent first half, got %v", peaks)
+	}
+	// 12 more global_gain steps scale the decoded samples by 2^(12/4)
+	if ratio := peaks[3] / peaks[2]; peaks[2] <= 0 || ratio < 7.5 || ratio > 8.5 {
+		t.Errorf("expected the last quarter 8 times louder, got %v", peaks)
+	}
+}
+
+func TestBucketPeaks(t *testing.T) {
+	tests := []struct {
+		blocks []float64
+		points int
+		want   []float64
+	}{
+		{blocks: []float64{0.1, 0.4, 0.2, 0.3}, points: 2, want: []float64{0.4, 0.3}},
+		{blocks: []float64{0.1, 0.4, 0.2}, points: 2, want: []float64{0.4, 0.4}},
+		{blocks: []float64{0.1, 0.4}, points: 4, want: []float64{0.1, 0.1, 0.4, 0.4}},
+	}
+	for _, tt := range tests {
+		if got := bucketPeaks(tt.blocks, tt.points); !slices.Equal(got, tt.want) {
+			t.Errorf("bucketPeaks(%v, %d): expected %v, got %v", tt.blocks, tt.points, tt.want, got)
+		}
+	}
+}
+
+func TestPeaksUnsupported(t *testing.T) {
+	data := opusFile(1)
+	_, err := NewPeakExtractor().Peaks(bytes.NewReader(data), int64(len(data)), 10)
+	if !errors.Is(err, ErrUnsupportedFormat) {
+		t.Errorf("expected ErrUnsupportedFormat, got %v", err)
+	}
+}
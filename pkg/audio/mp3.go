@@ -0,0 +1,250 @@
+package audio
+
+import (
+	"encoding/binary"
+	"io"
+	"time"
+
+	"ishari-backend/internal/core/usecase/upload"
+)
+
+// mp3SyncSearch bounds how far past the ID3 tag we look for the first frame
+const mp3SyncSearch = 64 << 10
+
+// bitrates in kbit/s indexed by [version is MPEG1][layer-1][index]
+var mp3Bitrates = [2][3][16]int{
+	// MPEG-2 / MPEG-2.5
+	{
+		{0, 32, 48, 56, 64, 80, 96, 112, 128, 144, 160, 176, 192, 224, 256, 0}, // layer I
+		{0, 8, 16, 24, 32, 40, 48, 56, 64, 80, 96, 112, 128, 144, 160, 0},      // layer II
+		{0, 8, 16, 24, 32, 40, 48, 56, 64, 80, 96, 112, 128, 144, 160, 0},      // layer III
+	},
+	// MPEG-1
+	{
+		{0, 32, 64, 96, 128, 160, 192, 224, 256, 288, 320, 352, 384, 416, 448, 0},
+		{0, 32, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320, 384, 0},
+		{0, 32, 40, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320, 0},
+	},
+}
+
+// sample rates in Hz indexed by [version bits][index]
+var mp3SampleRates = [4][3]int{
+	{11025, 12000, 8000},  // MPEG-2.5
+	{0, 0, 0},             // reserved
+	{22050, 24000, 16000}, // MPEG-2
+	{44100, 48000, 32000}, // MPEG-1
+}
+
+// mp3Frame is a decoded MPEG audio frame header
+type mp3Frame struct {
+	Version    int // 0 = MPEG-2.5, 2 = MPEG-2, 3 = MPEG-1
+	Layer      int // 1, 2 or 3
+	Bitrate    int // bit/s
+	SampleRate int
+	Padding    int
+	Channels   int
+	Length     int
+	Samples    int
+}
+
+func (f mp3Frame) mpeg1() bool { return f.Version == 3 }
+
+// parseMP3Frame decodes a 4 byte frame header, reporting false when it is not valid
+func parseMP3Frame(h []byte) (mp3Frame, bool) {
+	if len(h) < 4 || h[0] != 0xFF || h[1]&0xE0 != 0xE0 {
+		return mp3Frame{}, false
+	}
+	version := int(h[1]>>3) & 0x03
+	layerBits := int(h[1]>>1) & 0x03
+	bitrateIdx := int(h[2] >> 4)
+	rateIdx := int(h[2]>>2) & 0x03
+	if version == 1 || layerBits == 0 || bitrateIdx == 0 || bitrateIdx == 15 || rateIdx == 3 {
+		return mp3Frame{}, false
+	}
+
+	f := mp3Frame{
+		Version:    version,
+		Layer:      4 - layerBits,
+		SampleRate: mp3SampleRates[version][rateIdx],
+		Padding:    int(h[2]>>1) & 0x01,
+		Channels:   2,
+	}
+	if h[3]>>6 == 3 {
+		f.Channels = 1
+	}
+
+	v1 := 0
+	if f.mpeg1() {
+		v1 = 1
+	}
+	f.Bitrate = mp3Bitrates[v1][f.Layer-1][bitrateIdx] * 1000
+
+	switch {
+	case f.Layer == 1:
+		f.Samples = 384
+		f.Length = (12*f.Bitrate/f.SampleRate + f.Padding) * 4
+	case f.Layer == 3 && !f.mpeg1():
+		f.Samples = 576
+		f.Length = 72*f.Bitrate/f.SampleRate + f.Padding
+	default:
+		f.Samples = 1152
+		f.Length = 144*f.Bitrate/f.SampleRate + f.Padding
+	}
+	return f, true
+}
+
+// id3v2Size returns the total size of a leading ID3v2 tag, or 0 when absent
+func id3v2Size(r io.ReaderAt) (int64, error) {
+	h := make([]byte, 10)
+	n, err := r.ReadAt(h, 0)
+	if n < 10 || string(h[:3]) != "ID3" {
+		if err != nil && err != io.EOF && n < 10 {
+			return 0, err
+		}
+		return 0, nil
+	}
+	for _, b := range h[6:10] {
+		if b&0x80 != 0 {
+			return 0, corrupt("invalid id3v2 tag size")
+		}
+	}
+	size := int64(h[6])<<21 | int64(h[7])<<14 | int64(h[8])<<7 | int64(h[9])
+	size += 10
+	if h[5]&0x10 != 0 {
+		size += 10 // footer present
+	}
+	return size, nil
+}
+
+// findMP3Frame locates the first frame at or after start that is followed by another valid frame
+func findMP3Frame(r io.ReaderAt, start, size int64) (int64, mp3Frame, error) {
+	end := min(size, start+mp3SyncSearch)
+	buf := make([]byte, end-start)
+	n, err := r.ReadAt(buf, start)
+	if err != nil && err != io.EOF {
+		return 0, mp3Frame{}, err
+	}
+	buf = buf[:n]
+
+	next := make([]byte, 4)
+	for i := 0; i+4 <= len(buf); i++ {
+		f, ok := parseMP3Frame(buf[i:])
+		if !ok {
+			continue
+		}
+		off := start + int64(i)
+		nextOff := off + int64(f.Length)
+		if nextOff+4 > size {
+			// a single frame file is still valid
+			return off, f, nil
+		}
+		if _, err := r.ReadAt(next, nextOff); err != nil && err != io.EOF {
+			return 0, mp3Frame{}, err
+		}
+		if nf, ok := parseMP3Frame(next); ok && nf.Version == f.Version && nf.Layer == f.Layer && nf.SampleRate == f.SampleRate {
+			return off, f, nil
+		}
+	}
+	return 0, mp3Frame{}, corrupt("no mpeg audio frame found")
+}
+
+// mp3VBRInfo reads frame and byte counts from a Xing/Info or VBRI header in the first frame
+func mp3VBRInfo(r io.ReaderAt, off int64, f mp3Frame) (frames, bytes int64, ok bool) {
+	buf := make([]byte, min(f.Length, 192))
+	if n, _ := r.ReadAt(buf, off); n < len(buf) {
+		return 0, 0, false
+	}
+
+	// Xing/Info follows the side information
+	sideInfo := 32
+	switch {
+	case f.mpeg1() && f.Channels == 1:
+		sideInfo = 17
+	case !f.mpeg1() && f.Channels == 2:
+		sideInfo = 17
+	case !f.mpeg1():
+		sideInfo = 9
+	}
+	if x := 4 + sideInfo; x+16 <= len(buf) {
+		tag := string(buf[x : x+4])
+		if tag == "Xing" || tag == "Info" {
+			flags := binary.BigEndian.Uint32(buf[x+4:])
+			p := x + 8
+			if flags&0x1 != 0 {
+				frames = int64(binary.BigEndian.Uint32(buf[p:]))
+				p += 4
+			}
+			if flags&0x2 != 0 && p+4 <= len(buf) {
+				bytes = int64(binary.BigEndian.Uint32(buf[p:]))
+			}
+			return frames, bytes, frames > 0
+		}
+	}
+
+	// VBRI is always 32 bytes after the frame header
+	if v := 4 + 32; v+18 <= len(buf) && string(buf[v:v+4]) == "VBRI" {
+		bytes = int64(binary.BigEndian.Uint32(buf[v+10:]))
+		frames = int64(binary.BigEndian.Uint32(buf[v+14:]))
+		return frames, bytes, frames > 0
+	}
+	return 0, 0, false
+}
+
+// hasID3v1 reports whether the file ends with a 128 byte ID3v1 tag
+func hasID3v1(r io.ReaderAt, size int64) bool {
+	if size < 128 {
+		return false
+	}
+	tag := make([]byte, 3)
+	if _, err := r.ReadAt(tag, size-128); err != nil {
+		return false
+	}
+	return string(tag) == "TAG"
+}
+
+func probeMP3(r io.ReaderAt, size int64) (*upload.AudioMetadata, error) {
+	start, err := id3v2Size(r)
+	if err != nil {
+		return nil, err
+	}
+	if start >= size {
+		return nil, corrupt("id3 tag exceeds file size")
+	}
+
+	off, f, err := findMP3Frame(r, start, size)
+	if err != nil {
+		return nil, err
+	}
+
+	audioEnd := size
+	if hasID3v1(r, size) {
+		audioEnd -= 128
+	}
+	audioBytes := audioEnd - off
+
+	var duration time.Duration
+	bitrate := f.Bitrate
+	if frames, vbrBytes, ok := mp3VBRInfo(r, off, f); ok {
+		duration = durationOf(frames*int64(f.Samples), f.SampleRate)
+		if vbrBytes > 0 {
+			audioBytes = vbrBytes
+		}
+		bitrate = averageBitrate(audioBytes, duration)
+	} else {
+		// constant bitrate: derive duration from the stream length
+		duration = time.Duration(float64(audioBytes*8) / float64(f.Bitrate) * float64(time.Second))
+	}
+
+	codec := "mp3"
+	if f.Layer != 3 {
+		codec = []string{"", "mp1", "mp2"}[f.Layer]
+	}
+
+	return &upload.AudioMetadata{
+		Codec:      codec,
+		Duration:   duration,
+		Bitrate:    bitrate,
+		SampleRate: f.SampleRate,
+		Channels:   f.Channels,
+	}, nil
+}
@@ -0,0 +1,134 @@
+package audio
+
+import (
+	"encoding/binary"
+	"io"
+
+	"ishari-backend/internal/core/usecase/upload"
+)
+
+// mp4Box is an ISO base media box located in the file
+type mp4Box struct {
+	Type   string
+	Offset int64 // start of the payload
+	Size   int64 // payload size
+}
+
+// mp4Boxes lists the boxes between start and end
+func mp4Boxes(r io.ReaderAt, start, end int64) ([]mp4Box, error) {
+	var boxes []mp4Box
+	h := make([]byte, 16)
+	for off := start; off+8 <= end; {
+		if err := readAt(r, h[:8], off, "mp4 box header"); err != nil {
+			return nil, err
+		}
+		size := int64(binary.BigEndian.Uint32(h[:4]))
+		typ := string(h[4:8])
+		headerLen := int64(8)
+		switch size {
+		case 0:
+			size = end - off
+		case 1:
+			if err := readAt(r, h[8:16], off+8, "mp4 box size"); err != nil {
+				return nil, err
+			}
+			size = int64(binary.BigEndian.Uint64(h[8:16]))
+			headerLen = 16
+		}
+		if size < headerLen || off+size > end {
+			return nil, corrupt("mp4 box %q has invalid size", typ)
+		}
+		boxes = append(boxes, mp4Box{Type: typ, Offset: off + headerLen, Size: size - headerLen})
+		off += size
+	}
+	return boxes, nil
+}
+
+func findMP4Box(boxes []mp4Box, typ string) (mp4Box, bool) {
+	for _, b := range boxes {
+		if b.Type == typ {
+			return b, true
+		}
+	}
+	return mp4Box{}, false
+}
+
+// mp4Path descends through nested container boxes
+func mp4Path(r io.ReaderAt, parent mp4Box, path ...string) (mp4Box, bool, error) {
+	current := parent
+	for _, typ := range path {
+		children, err := mp4Boxes(r, current.Offset, current.Offset+current.Size)
+		if err != nil {
+			return mp4Box{}, false, err
+		}
+		next, ok := findMP4Box(children, typ)
+		if !ok {
+			return mp4Box{}, false, nil
+		}
+		current = next
+	}
+	return current, true, nil
+}
+
+func probeMP4(r io.ReaderAt, size int64) (*upload.AudioMetadata, error) {
+	top, err := mp4Boxes(r, 0, size)
+	if err != nil {
+		return nil, err
+	}
+	moov, ok := findMP4Box(top, "moov")
+	if !ok {
+		return nil, corrupt("mp4 moov box missing")
+	}
+
+	mvhd, ok, err := mp4Path(r, moov, "mvhd")
+	if err != nil {
+		return nil, err
+	}
+	if !ok || mvhd.Size < 20 {
+		return nil, corrupt("mp4 mvhd box missing")
+	}
+
+	buf := make([]byte, min(mvhd.Size, 32))
+	if err := readAt(r, buf, mvhd.Offset, "mp4 mvhd box"); err != nil {
+		return nil, err
+	}
+	var timescale, units int64
+	if buf[0] == 1 {
+		if len(buf) < 32 {
+			return nil, corrupt("mp4 mvhd box too short")
+		}
+		timescale = int64(binary.BigEndian.Uint32(buf[20:24]))
+		units = int64(binary.BigEndian.Uint64(buf[24:32]))
+	} else {
+		timescale = int64(binary.BigEndian.Uint32(buf[12:16]))
+		units = int64(binary.BigEndian.Uint32(buf[16:20]))
+	}
+	if timescale == 0 {
+		return nil, corrupt("mp4 timescale is zero")
+	}
+
+	meta := &upload.AudioMetadata{
+		Codec:    "aac",
+		Duration: durationOf(units, int(timescale)),
+	}
+	meta.Bitrate = averageBitrate(size, meta.Duration)
+
+	// the sample description of the first track names the codec
+	if stsd, ok, err := mp4Path(r, moov, "trak", "mdia", "minf", "stbl", "stsd"); err == nil && ok && stsd.Size >= 16+28 {
+		entry := make([]byte, 8+28)
+		if err := readAt(r, entry, stsd.Offset+8, "mp4 sample description"); err == nil {
+			switch string(entry[4:8]) {
+			case "alac":
+				meta.Codec = "alac"
+			case "mp4a":
+				meta.Codec = "aac"
+			default:
+				return nil, ErrUnsupportedFormat
+			}
+			meta.Channels = int(binary.BigEndian.Uint16(entry[24:26]))
+			meta.SampleRate = int(binary.BigEndian.Uint32(entry[32:36]) >> 16)
+		}
+	}
+
+	return meta, nil
+}
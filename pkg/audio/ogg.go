@@ -0,0 +1,156 @@
+package audio
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+
+	"ishari-backend/internal/core/usecase/upload"
+)
+
+const (
+	oggPageHeaderSize = 27
+	// oggTailSearch bounds the backwards search for the last page
+	oggTailSearch = 128 << 10
+	// opusGranuleRate is the fixed granule clock of Ogg Opus streams
+	opusGranuleRate = 48000
+)
+
+// oggPage is the fixed part of an Ogg page header
+type oggPage struct {
+	HeaderType byte
+	Granule    int64
+	Serial     uint32
+	Segments   int
+}
+
+func parseOggPage(h []byte) (oggPage, bool) {
+	if len(h) < oggPageHeaderSize || string(h[:4]) != "OggS" || h[4] != 0 {
+		return oggPage{}, false
+	}
+	return oggPage{
+		HeaderType: h[5],
+		Granule:    int64(binary.LittleEndian.Uint64(h[6:14])),
+		Serial:     binary.LittleEndian.Uint32(h[14:18]),
+		Segments:   int(h[26]),
+	}, true
+}
+
+// readOggFirstPacket returns the first packet of the page at offset 0
+func readOggFirstPacket(r io.ReaderAt) (oggPage, []byte, error) {
+	h := make([]byte, oggPageHeaderSize)
+	if err := readAt(r, h, 0, "ogg page header"); err != nil {
+		return oggPage{}, nil, err
+	}
+	page, ok := parseOggPage(h)
+	if !ok {
+		return oggPage{}, nil, corrupt("invalid ogg page header")
+	}
+	if page.HeaderType&0x02 == 0 {
+		return oggPage{}, nil, corrupt("first ogg page is not a beginning of stream")
+	}
+
+	lacing := make([]byte, page.Segments)
+	if err := readAt(r, lacing, oggPageHeaderSize, "ogg segment table"); err != nil {
+		return oggPage{}, nil, err
+	}
+
+	// the identification packet ends at the first lacing value below 255
+	packetLen := 0
+	for _, l := range lacing {
+		packetLen += int(l)
+		if l < 255 {
+			break
+		}
+	}
+	packet := make([]byte, packetLen)
+	if err := readAt(r, packet, int64(oggPageHeaderSize+page.Segments), "ogg identification packet"); err != nil {
+		return oggPage{}, nil, err
+	}
+	return page, packet, nil
+}
+
+// lastOggGranule finds the granule position of the last page of the given stream
+func lastOggGranule(r io.ReaderAt, size int64, serial uint32) (int64, error) {
+	start := max(0, size-oggTailSearch)
+	buf := make([]byte, size-start)
+	n, err := r.ReadAt(buf, start)
+	if err != nil && err != io.EOF {
+		return 0, err
+	}
+	buf = buf[:n]
+
+	for end := len(buf); end > 0; {
+		i := bytes.LastIndex(buf[:end], []byte("OggS"))
+		if i < 0 {
+			break
+		}
+		page, ok := parseOggPage(buf[i:])
+		// granule -1 marks pages on which no packet ends
+		if ok && page.Serial == serial && page.Granule >= 0 {
+			return page.Granule, nil
+		}
+		end = i
+	}
+	return 0, corrupt("ogg end of stream not found")
+}
+
+func probeOgg(r io.ReaderAt, size int64) (*upload.AudioMetadata, error) {
+	page, packet, err := readOggFirstPacket(r)
+	if err != nil {
+		return nil, err
+	}
+
+	meta := &upload.AudioMetadata{}
+	var preSkip int64
+	granuleRate := 0
+
+	switch {
+	case bytes.HasPrefix(packet, []byte("OpusHead")):
+		if len(packet) < 19 {
+			return nil, corrupt("opus header too short")
+		}
+		meta.Codec = "opus"
+		meta.Channels = int(packet[9])
+		preSkip = int64(binary.LittleEndian.Uint16(packet[10:12]))
+		meta.SampleRate = int(binary.LittleEndian.Uint32(packet[12:16]))
+		if meta.SampleRate == 0 {
+			meta.SampleRate = opusGranuleRate
+		}
+		granuleRate = opusGranuleRate
+	case bytes.HasPrefix(packet, []byte("\x01vorbis")):
+		if len(packet) < 30 {
+			return nil, corrupt("vorbis header too short")
+		}
+		meta.Codec = "vorbis"
+		meta.Channels = int(packet[11])
+		meta.SampleRate = int(binary.LittleEndian.Uint32(packet[12:16]))
+		granuleRate = meta.SampleRate
+	case bytes.HasPrefix(packet, []byte("\x7fFLAC")):
+		if len(packet) < 30 {
+			return nil, corrupt("flac header too short")
+		}
+		meta.Codec = "flac"
+		// STREAMINFO starts after the 13 byte mapping header and 4 byte block header
+		info := packet[17:]
+		meta.SampleRate = int(info[10])<<12 | int(info[11])<<4 | int(info[12])>>4
+		meta.Channels = int(info[12]>>1&0x07) + 1
+		granuleRate = meta.SampleRate
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+
+	if meta.Channels == 0 || granuleRate == 0 {
+		return nil, corrupt("%s header has zero channels or sample rate", meta.Codec)
+	}
+
+	granule, err := lastOggGranule(r, size, page.Serial)
+	if err != nil {
+		return nil, err
+	}
+	samples := max(granule-preSkip, 0)
+
+	meta.Duration = durationOf(samples, granuleRate)
+	meta.Bitrate = averageBitrate(size, meta.Duration)
+	return meta, nil
+}
@@ -0,0 +1,184 @@
+package audio
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+
+	"ishari-backend/internal/core/usecase/waveform"
+
+	"github.com/hajimehoshi/go-mp3"
+)
+
+// peakReadBuffer is large so range-backed readers issue few requests
+const peakReadBuffer = 1 << 20
+
+// PeakExtractor implements waveform.PeakExtractor
+type PeakExtractor struct{}
+
+// errPeaksUnsupported lets the waveform usecase recognise undecodable formats
+var errPeaksUnsupported = fmt.Errorf("%w: %w", waveform.ErrUnsupportedFormat, ErrUnsupportedFormat)
+
+// NewPeakExtractor creates a new peak extractor
+func NewPeakExtractor() *PeakExtractor {
+	return &PeakExtractor{}
+}
+
+// Peaks returns points amplitude peaks in the range [0, 1], measured on the
+// decoded samples of WAV and MP3 files
+func (e *PeakExtractor) Peaks(r io.ReaderAt, size int64, points int) ([]float64, error) {
+	if points <= 0 {
+		return nil, errors.New("points must be positive")
+	}
+
+	head := make([]byte, 12)
+	n, err := r.ReadAt(head, 0)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	head = head[:n]
+
+	var peaks []float64
+	switch {
+	case len(head) >= 12 && string(head[:4]) == "RIFF" && string(head[8:12]) == "WAVE":
+		peaks, err = wavPeaks(r, size, points)
+	case len(head) >= 3 && (string(head[:3]) == "ID3" || (head[0] == 0xFF && head[1]&0xE0 == 0xE0)):
+		peaks, err = mp3Peaks(r, size, points)
+	default:
+		err = ErrUnsupportedFormat
+	}
+	if errors.Is(err, ErrUnsupportedFormat) {
+		return nil, errPeaksUnsupported
+	}
+	return peaks, err
+}
+
+// wavSampleDecoder converts one little endian sample to [-1, 1]
+type wavSampleDecoder func(b []byte) float64
+
+func wavDecoder(f wavFormat) (wavSampleDecoder, error) {
+	switch tag := f.effectiveTag(); {
+	case tag == wavFormatPCM && f.BitsPerSample == 8:
+		return func(b []byte) float64 { return (float64(b[0]) - 128) / 128 }, nil
+	case tag == wavFormatPCM && f.BitsPerSample == 16:
+		return func(b []byte) float64 { return float64(int16(binary.LittleEndian.Uint16(b))) / (1 << 15) }, nil
+	case tag == wavFormatPCM && f.BitsPerSample == 24:
+		return func(b []byte) float64 {
+			v := int32(b[0])<<8 | int32(b[1])<<16 | int32(b[2])<<24
+			return float64(v>>8) / (1 << 23)
+		}, nil
+	case tag == wavFormatPCM && f.BitsPerSample == 32:
+		return func(b []byte) float64 { return float64(int32(binary.LittleEndian.Uint32(b))) / (1 << 31) }, nil
+	case tag == wavFormatFloat && f.BitsPerSample == 32:
+		return func(b []byte) float64 { return float64(math.Float32frombits(binary.LittleEndian.Uint32(b))) }, nil
+	case tag == wavFormatFloat && f.BitsPerSample == 64:
+		return func(b []byte) float64 { return math.Float64frombits(binary.LittleEndian.Uint64(b)) }, nil
+	}
+	return nil, ErrUnsupportedFormat
+}
+
+func wavPeaks(r io.ReaderAt, size int64, points int) ([]float64, error) {
+	layout, err := parseWAV(r, size)
+	if err != nil {
+		return nil, err
+	}
+	f := layout.Format
+	decode, err := wavDecoder(f)
+	if err != nil {
+		return nil, err
+	}
+
+	sampleBytes := f.BitsPerSample / 8
+	if f.BlockAlign < sampleBytes*f.Channels {
+		return nil, corrupt("wav block align smaller than one frame")
+	}
+	frames := layout.DataSize / int64(f.BlockAlign)
+	peaks := make([]float64, points)
+	if frames == 0 {
+		return peaks, nil
+	}
+
+	br := bufio.NewReaderSize(io.NewSectionReader(r, layout.DataOffset, frames*int64(f.BlockAlign)), peakReadBuffer)
+	block := make([]byte, f.BlockAlign)
+	for i := int64(0); i < frames; i++ {
+		if _, err := io.ReadFull(br, block); err != nil {
+			return nil, corrupt("truncated wav data")
+		}
+		bucket := int(i * int64(points) / frames)
+		for c := 0; c < f.Channels; c++ {
+			v := math.Abs(decode(block[c*sampleBytes:]))
+			if v > peaks[bucket] {
+				peaks[bucket] = min(v, 1)
+			}
+		}
+	}
+	return peaks, nil
+}
+
+// mp3PeakBlock is the number of samples per channel summarised at a time while
+// decoding, the length of an MPEG-1 layer III frame
+const mp3PeakBlock = 1152
+
+// mp3Peaks decodes layer III audio. The total number of samples is only known once
+// the stream ends, so the peak of every block is kept and bucketed afterwards.
+func mp3Peaks(r io.ReaderAt, size int64, points int) ([]float64, error) {
+	start, err := id3v2Size(r)
+	if err != nil {
+		return nil, err
+	}
+	off, first, err := findMP3Frame(r, start, size)
+	if err != nil {
+		return nil, err
+	}
+	if first.Layer != 3 {
+		return nil, ErrUnsupportedFormat
+	}
+
+	// bufio hides the Seeker, which the decoder would use to scan the whole file up front
+	dec, err := mp3.NewDecoder(bufio.NewReaderSize(io.NewSectionReader(r, off, size-off), peakReadBuffer))
+	if err != nil {
+		return nil, corrupt("mp3: %v", err)
+	}
+
+	// the decoder always produces 16-bit little endian stereo, mono being duplicated
+	buf := make([]byte, mp3PeakBlock*4)
+	var blocks []float64
+	for {
+		n, err := io.ReadFull(dec, buf)
+		if n >= 2 {
+			peak := 0.0
+			for i := 0; i+2 <= n; i += 2 {
+				v := math.Abs(float64(int16(binary.LittleEndian.Uint16(buf[i:]))) / (1 << 15))
+				peak = max(peak, v)
+			}
+			blocks = append(blocks, min(peak, 1))
+		}
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			break
+		}
+		if err != nil {
+			return nil, corrupt("mp3: %v", err)
+		}
+	}
+	if len(blocks) == 0 {
+		return nil, corrupt("no mpeg audio frames decoded")
+	}
+	return bucketPeaks(blocks, points), nil
+}
+
+// bucketPeaks reduces block peaks to points buckets. A bucket takes the maximum of
+// every block it overlaps, so short files repeat blocks instead of leaving gaps.
+func bucketPeaks(blocks []float64, points int) []float64 {
+	peaks := make([]float64, points)
+	for i := range peaks {
+		start := i * len(blocks) / points
+		end := ((i+1)*len(blocks) + points - 1) / points
+		for _, v := range blocks[start:end] {
+			peaks[i] = max(peaks[i], v)
+		}
+	}
+	return peaks
+}
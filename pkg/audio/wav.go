@@ -0,0 +1,151 @@
+package audio
+
+import (
+	"encoding/binary"
+	"io"
+
+	"ishari-backend/internal/core/usecase/upload"
+)
+
+const (
+	wavFormatPCM        = 0x0001
+	wavFormatFloat      = 0x0003
+	wavFormatALaw       = 0x0006
+	wavFormatMuLaw      = 0x0007
+	wavFormatExtensible = 0xFFFE
+)
+
+// wavFormat holds the fields of a WAVE "fmt " chunk
+type wavFormat struct {
+	Tag           uint16
+	SubFormat     uint16 // set for WAVE_FORMAT_EXTENSIBLE
+	Channels      int
+	SampleRate    int
+	ByteRate      int
+	BlockAlign    int
+	BitsPerSample int
+}
+
+// wavLayout describes where the sample data of a WAV file lives
+type wavLayout struct {
+	Format     wavFormat
+	DataOffset int64
+	DataSize   int64
+}
+
+func probeWAV(r io.ReaderAt, size int64) (*upload.AudioMetadata, error) {
+	layout, err := parseWAV(r, size)
+	if err != nil {
+		return nil, err
+	}
+
+	f := layout.Format
+	frames := layout.DataSize / int64(f.BlockAlign)
+	duration := durationOf(frames, f.SampleRate)
+
+	return &upload.AudioMetadata{
+		Codec:      wavCodec(f.effectiveTag()),
+		Duration:   duration,
+		Bitrate:    f.ByteRate * 8,
+		SampleRate: f.SampleRate,
+		Channels:   f.Channels,
+	}, nil
+}
+
+// parseWAV walks the RIFF chunks looking for "fmt " and "data"
+func parseWAV(r io.ReaderAt, size int64) (*wavLayout, error) {
+	var (
+		layout  wavLayout
+		haveFmt bool
+		header  = make([]byte, 8)
+	)
+
+	for off := int64(12); off+8 <= size; {
+		if err := readAt(r, header, off, "wav chunk header"); err != nil {
+			return nil, err
+		}
+		id := string(header[:4])
+		chunkSize := int64(binary.LittleEndian.Uint32(header[4:8]))
+		body := off + 8
+
+		switch id {
+		case "fmt ":
+			if chunkSize < 16 {
+				return nil, corrupt("wav fmt chunk too small")
+			}
+			buf := make([]byte, 16)
+			if err := readAt(r, buf, body, "wav fmt chunk"); err != nil {
+				return nil, err
+			}
+			layout.Format = wavFormat{
+				Tag:           binary.LittleEndian.Uint16(buf[0:2]),
+				Channels:      int(binary.LittleEndian.Uint16(buf[2:4])),
+				SampleRate:    int(binary.LittleEndian.Uint32(buf[4:8])),
+				ByteRate:      int(binary.LittleEndian.Uint32(buf[8:12])),
+				BlockAlign:    int(binary.LittleEndian.Uint16(buf[12:14])),
+				BitsPerSample: int(binary.LittleEndian.Uint16(buf[14:16])),
+			}
+			if layout.Format.Tag == wavFormatExtensible && chunkSize >= 40 {
+				// the first two bytes of the sub-format GUID hold the actual format tag
+				sub := make([]byte, 2)
+				if err := readAt(r, sub, body+24, "wav extensible format"); err != nil {
+					return nil, err
+				}
+				layout.Format.SubFormat = binary.LittleEndian.Uint16(sub)
+			}
+			haveFmt = true
+		case "data":
+			if !haveFmt {
+				return nil, corrupt("wav data chunk before fmt chunk")
+			}
+			layout.DataOffset = body
+			// streaming encoders write 0 or 0xFFFFFFFF; trust the file size instead
+			layout.DataSize = min(chunkSize, size-body)
+			if chunkSize == 0 || chunkSize == 0xFFFFFFFF {
+				layout.DataSize = size - body
+			}
+			return validateWAV(&layout)
+		}
+
+		// chunks are word aligned
+		off = body + chunkSize + chunkSize%2
+	}
+
+	if !haveFmt {
+		return nil, corrupt("wav fmt chunk missing")
+	}
+	return nil, corrupt("wav data chunk missing")
+}
+
+// effectiveTag resolves WAVE_FORMAT_EXTENSIBLE to its sub-format
+func (f wavFormat) effectiveTag() uint16 {
+	if f.Tag == wavFormatExtensible && f.SubFormat != 0 {
+		return f.SubFormat
+	}
+	return f.Tag
+}
+
+func validateWAV(layout *wavLayout) (*wavLayout, error) {
+	f := layout.Format
+	if f.Channels == 0 || f.SampleRate == 0 || f.BlockAlign == 0 || f.ByteRate == 0 {
+		return nil, corrupt("wav format has zero channels, sample rate or block size")
+	}
+	if layout.DataSize < 0 {
+		return nil, corrupt("wav data chunk exceeds file size")
+	}
+	return layout, nil
+}
+
+func wavCodec(tag uint16) string {
+	switch tag {
+	case wavFormatPCM, wavFormatExtensible:
+		return "pcm"
+	case wavFormatFloat:
+		return "pcm_float"
+	case wavFormatALaw:
+		return "alaw"
+	case wavFormatMuLaw:
+		return "mulaw"
+	}
+	return "wav"
+}
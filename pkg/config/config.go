@@ -2,6 +2,8 @@ package config
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/spf13/viper"
@@ -15,6 +17,8 @@ type ServerConfig struct {
 	IdleTimeout  time.Duration
 	WriteTimeout time.Duration
 	ReadTimeout  time.Duration
+	// BodyLimit is the maximum request body size in bytes
+	BodyLimit int
 }
 
 type DatabaseConfig struct {
@@ -29,6 +33,8 @@ type DatabaseConfig struct {
 	ConnMaxLifetime time.Duration
 	ConnMaxIdleTime time.Duration
 	LogLevel        logger.LogLevel
+	// MigrateOnStart applies pending migrations before the server starts
+	MigrateOnStart bool
 }
 
 type JWTConfig struct {
@@ -37,15 +43,48 @@ type JWTConfig struct {
 	RefreshTokenTTL time.Duration
 }
 
+const (
+	StorageDriverLocal = "local"
+	StorageDriverS3    = "s3"
+)
+
+type StorageConfig struct {
+	Driver string
+	// LocalDir is the root directory used by the local driver
+	LocalDir string
+	// PublicURL is the base URL stored files are reachable at
+	PublicURL      string
+	S3Endpoint     string
+	S3Region       string
+	S3Bucket       string
+	S3AccessKey    string
+	S3SecretKey    string
+	S3UsePathStyle bool
+	S3PublicURL    string
+	MaxAudioSize   int64
+	MaxImageSize   int64
+}
+
+// Environments of APP_ENV
+const (
+	EnvDevelopment = "development"
+	EnvProduction  = "production"
+)
+
 type Config struct {
+	// Env is the APP_ENV the server runs in; defaults that only suit a local setup are
+	// limited to EnvDevelopment
+	Env      string
 	Server   ServerConfig
 	Database DatabaseConfig
 	JWT      JWTConfig
+	Storage  StorageConfig
 }
 
 func Load() (Config, error) {
 	_ = gotenv.Load()
 
+	viper.SetDefault("APP_ENV", EnvDevelopment)
 	viper.SetDefault("SERVER_HOST", "localhost")
 	viper.SetDefault("SERVER_PORT", "3000")
 	viper.SetDefault("IDLE_TIMEOUT_SEC", 5)
@@ -63,11 +102,20 @@ func Load() (Config, error) {
 	viper.SetDefault("DB_CONN_MAX_LIFETIME_MIN", 60)
 	viper.SetDefault("DB_CONN_MAX_IDLE_TIME_MIN", 10)
 	viper.SetDefault("DB_LOG_LEVEL", "warn")
+	viper.SetDefault("MIGRATE_ON_START", false)
 
 	// JWT defaults
 	viper.SetDefault("JWT_ACCESS_TOKEN_TTL_MIN", 15)
 	viper.SetDefault("JWT_REFRESH_TOKEN_TTL_DAYS", 7)
 
+	// Storage defaults
+	viper.SetDefault("STORAGE_DRIVER", StorageDriverLocal)
+	viper.SetDefault("STORAGE_LOCAL_DIR", "uploads")
+	viper.SetDefault("S3_REGION", "us-east-1")
+	viper.SetDefault("S3_USE_PATH_STYLE", true)
+	viper.SetDefault("UPLOAD_MAX_AUDIO_MB", 100)
+	viper.SetDefault("UPLOAD_MAX_IMAGE_MB", 5)
+
 	viper.AutomaticEnv()
 
 	jwtSecret := viper.GetString("JWT_SECRET")
@@ -90,13 +138,36 @@ func Load() (Config, error) {
 		logLevel = logger.Info
 	}
 
+	storageDriver := viper.GetString("STORAGE_DRIVER")
+	if storageDriver != StorageDriverLocal && storageDriver != StorageDriverS3 {
+		return Config{}, fmt.Errorf("unsupported STORAGE_DRIVER %q", storageDriver)
+	}
+
+	env := viper.GetString("APP_ENV")
+	publicURL := viper.GetString("STORAGE_PUBLIC_URL")
+	if publicURL == "" && storageDriver == StorageDriverLocal {
+		// The URL is saved into cover_image_url and media_url, so a guess from the bind
+		// address is only good enough on a developer machine
+		if env != EnvDevelopment {
+			return Config{}, errors.New("STORAGE_PUBLIC_URL must be set when APP_ENV is not development")
+		}
+		publicURL = fmt.Sprintf("http://%s:%s/uploads", localHost(viper.GetString("SERVER_HOST")), viper.GetString("SERVER_PORT"))
+	}
+
+	maxAudioSize := viper.GetInt64("UPLOAD_MAX_AUDIO_MB") << 20
+	maxImageSize := viper.GetInt64("UPLOAD_MAX_IMAGE_MB") << 20
+	// leave room for multipart boundaries and form fields
+	bodyLimit := max(maxAudioSize, maxImageSize) + 1<<20
+
 	return Config{
+		Env: env,
 		Server: ServerConfig{
 			Host:         viper.GetString("SERVER_HOST"),
 			Port:         viper.GetString("SERVER_PORT"),
 			IdleTimeout:  time.Duration(viper.GetInt("IDLE_TIMEOUT_SEC")) * time.Second,
 			WriteTimeout: time.Duration(viper.GetInt("WRITE_TIMEOUT_SEC")) * time.Second,
 			ReadTimeout:  time.Duration(viper.GetInt("READ_TIMEOUT_SEC")) * time.Second,
+			BodyLimit:    int(bodyLimit),
 		},
 		Database: DatabaseConfig{
 			Host:            viper.GetString("DB_HOST"),
@@ -110,11 +181,36 @@ func Load() (Config, error) {
 			ConnMaxLifetime: time.Duration(viper.GetInt("DB_CONN_MAX_LIFETIME_MIN")) * time.Minute,
 			ConnMaxIdleTime: time.Duration(viper.GetInt("DB_CONN_MAX_IDLE_TIME_MIN")) * time.Minute,
 			LogLevel:        logLevel,
+			MigrateOnStart:  viper.GetBool("MIGRATE_ON_START"),
 		},
 		JWT: JWTConfig{
 			Secret:          jwtSecret,
 			AccessTokenTTL:  time.Duration(viper.GetInt("JWT_ACCESS_TOKEN_TTL_MIN")) * time.Minute,
 			RefreshTokenTTL: time.Duration(viper.GetInt("JWT_REFRESH_TOKEN_TTL_DAYS")) * 24 * time.Hour,
 		},
+		Storage: StorageConfig{
+			Driver:         storageDriver,
+			LocalDir:       viper.GetString("STORAGE_LOCAL_DIR"),
+			PublicURL:      strings.TrimRight(publicURL, "/"),
+			S3Endpoint:     viper.GetString("S3_ENDPOINT"),
+			S3Region:       viper.GetString("S3_REGION"),
+			S3Bucket:       viper.GetString("S3_BUCKET"),
+			S3AccessKey:    viper.GetString("S3_ACCESS_KEY"),
+			S3SecretKey:    viper.GetString("S3_SECRET_KEY"),
+			S3UsePathStyle: viper.GetBool("S3_USE_PATH_STYLE"),
+			S3PublicURL:    viper.GetString("S3_PUBLIC_URL"),
+			MaxAudioSize:   maxAudioSize,
+			MaxImageSize:   maxImageSize,
+		},
 	}, nil
 }
+
+// localHost returns a host clients on the same machine can reach for a bind address,
+// replacing the unspecified addresses with localhost
+func localHost(host string) string {
+	switch host {
+	case "", "0.0.0.0", "::", "[::]":
+		return "localhost"
+	}
+	return host
+}
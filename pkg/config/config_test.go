@@ -37,4 +37,51 @@ func TestLoad(t *testing.T) {
 			t.Errorf("expected JWT secret 'valid-secret-key', got '%s'", cfg.JWT.Secret)
 		}
 	})
+
+	t.Run("should enable migrations on start from MIGRATE_ON_START", func(t *testing.T) {
+		t.Setenv("JWT_SECRET", "valid-secret-key")
+		t.Setenv("MIGRATE_ON_START", "true")
+		cfg, err := Load()
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if !cfg.Database.MigrateOnStart {
+			t.Error("expected MigrateOnStart to be true")
+		}
+	})
+
+	t.Run("should require STORAGE_PUBLIC_URL outside development", func(t *testing.T) {
+		t.Setenv("JWT_SECRET", "valid-secret-key")
+		t.Setenv("APP_ENV", "production")
+		t.Setenv("STORAGE_DRIVER", "local")
+		t.Setenv("STORAGE_PUBLIC_URL", "")
+		if _, err := Load(); err == nil {
+			t.Error("expected error, got nil")
+		}
+
+		t.Setenv("STORAGE_PUBLIC_URL", "https://api.example.com/uploads/")
+		cfg, err := Load()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if cfg.Storage.PublicURL != "https://api.example.com/uploads" {
+			t.Errorf("expected the configured public URL, got '%s'", cfg.Storage.PublicURL)
+		}
+	})
+
+	t.Run("should default the public URL to localhost for an unspecified bind address", func(t *testing.T) {
+		t.Setenv("JWT_SECRET", "valid-secret-key")
+		t.Setenv("APP_ENV", "development")
+		t.Setenv("STORAGE_DRIVER", "local")
+		t.Setenv("STORAGE_PUBLIC_URL", "")
+		t.Setenv("SERVER_HOST", "0.0.0.0")
+		t.Setenv("SERVER_PORT", "8080")
+		cfg, err := Load()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if cfg.Storage.PublicURL != "http://localhost:8080/uploads" {
+			t.Errorf("expected 'http://localhost:8080/uploads', got '%s'", cfg.Storage.PublicURL)
+		}
+	})
 }
@@ -0,0 +1,155 @@
+// Package imaging generates downscaled copies of uploaded images using only the
+// standard library codecs (JPEG, PNG and GIF input).
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	_ "image/gif" // register the GIF decoder
+	"image/jpeg"
+	"image/png"
+	"io"
+	"math"
+
+	"ishari-backend/internal/core/usecase/upload"
+)
+
+var (
+	// ErrUnsupportedFormat is returned for images the standard library cannot decode (e.g. WebP)
+	ErrUnsupportedFormat = errors.New("imaging: unsupported image format")
+
+	// ErrTooManyPixels is returned for images whose decoded size exceeds maxPixels
+	ErrTooManyPixels = errors.New("imaging: image dimensions too large")
+)
+
+const (
+	// maxPixels bounds the decoded bitmap (about 160MB as RGBA) to reject decompression bombs
+	maxPixels = 40_000_000
+
+	jpegQuality = 85
+)
+
+// Resizer implements upload.ImageResizer.
+// Opaque images are encoded as JPEG, images with transparency as PNG.
+type Resizer struct{}
+
+// NewResizer creates a new Resizer
+func NewResizer() *Resizer {
+	return &Resizer{}
+}
+
+// Resize decodes the image once and encodes a copy per width, keeping the aspect ratio.
+// Widths larger than the image are clamped to it, images are never upscaled.
+func (r *Resizer) Resize(src io.ReaderAt, size int64, widths []int) ([]upload.ResizedImage, error) {
+	cfg, _, err := image.DecodeConfig(io.NewSectionReader(src, 0, size))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrUnsupportedFormat, err)
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 || int64(cfg.Width)*int64(cfg.Height) > maxPixels {
+		return nil, ErrTooManyPixels
+	}
+
+	img, _, err := image.Decode(io.NewSectionReader(src, 0, size))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrUnsupportedFormat, err)
+	}
+
+	// resample in premultiplied RGBA so transparent pixels do not bleed their color
+	b := img.Bounds()
+	rgba := image.NewRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(rgba, rgba.Bounds(), img, b.Min, draw.Src)
+	opaque := rgba.Opaque()
+
+	out := make([]upload.ResizedImage, 0, len(widths))
+	for _, w := range widths {
+		w = min(max(w, 1), rgba.Rect.Dx())
+		h := max(1, int(math.Round(float64(rgba.Rect.Dy())*float64(w)/float64(rgba.Rect.Dx()))))
+		scaled := resample(rgba, w, h)
+
+		var buf bytes.Buffer
+		resized := upload.ResizedImage{Width: w, Height: h}
+		if opaque {
+			err = jpeg.Encode(&buf, scaled, &jpeg.Options{Quality: jpegQuality})
+			resized.ContentType, resized.Extension = "image/jpeg", ".jpg"
+		} else {
+			err = png.Encode(&buf, scaled)
+			resized.ContentType, resized.Extension = "image/png", ".png"
+		}
+		if err != nil {
+			return nil, fmt.Errorf("encode %dx%d variant: %w", w, h, err)
+		}
+		resized.Data = buf.Bytes()
+		out = append(out, resized)
+	}
+	return out, nil
+}
+
+// span lists the source pixels covering one destination pixel and their coverage weights
+type span struct {
+	start   int
+	weights []float32
+}
+
+// boxSpans computes area-averaging weights for scaling src pixels down to dst pixels
+func boxSpans(src, dst int) []span {
+	scale := float64(src) / float64(dst)
+	spans := make([]span, dst)
+	for i := range spans {
+		lo, hi := float64(i)*scale, float64(i+1)*scale
+		start, end := int(lo), min(int(math.Ceil(hi)), src)
+		weights := make([]float32, end-start)
+		for j := start; j < end; j++ {
+			covered := math.Min(hi, float64(j+1)) - math.Max(lo, float64(j))
+			weights[j-start] = float32(covered / scale)
+		}
+		spans[i] = span{start: start, weights: weights}
+	}
+	return spans
+}
+
+// resample scales src to w x h with a separable box filter (horizontal then vertical pass)
+func resample(src *image.RGBA, w, h int) *image.RGBA {
+	sw, sh := src.Rect.Dx(), src.Rect.Dy()
+	xs, ys := boxSpans(sw, w), boxSpans(sh, h)
+
+	tmp := make([]float32, w*sh*4)
+	for y := 0; y < sh; y++ {
+		row := src.Pix[y*src.Stride:]
+		for x, s := range xs {
+			var acc [4]float32
+			for k, wt := range s.weights {
+				p := row[(s.start+k)*4:]
+				acc[0] += float32(p[0]) * wt
+				acc[1] += float32(p[1]) * wt
+				acc[2] += float32(p[2]) * wt
+				acc[3] += float32(p[3]) * wt
+			}
+			copy(tmp[(y*w+x)*4:], acc[:])
+		}
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y, s := range ys {
+		row := dst.Pix[y*dst.Stride:]
+		for x := 0; x < w; x++ {
+			var acc [4]float32
+			for k, wt := range s.weights {
+				p := tmp[((s.start+k)*w+x)*4:]
+				acc[0] += p[0] * wt
+				acc[1] += p[1] * wt
+				acc[2] += p[2] * wt
+				acc[3] += p[3] * wt
+			}
+			a := uint8(min(255, max(0, math.Round(float64(acc[3])))))
+			for c := 0; c < 3; c++ {
+				// premultiplied color never exceeds alpha, rounding must not break that
+				row[x*4+c] = min(a, uint8(min(255, max(0, math.Round(float64(acc[c]))))))
+			}
+			row[x*4+3] = a
+		}
+	}
+	return dst
+}
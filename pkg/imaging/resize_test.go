@@ -0,0 +1,121 @@
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+)
+
+func encodePNG(t *testing.T, img image.Image) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestResizeOpaqueToJPEG(t *testing.T) {
+	src := image.NewNRGBA(image.Rect(0, 0, 400, 200))
+	for i := range src.Pix {
+		src.Pix[i] = 0xff
+	}
+	data := encodePNG(t, src)
+
+	out, err := NewResizer().Resize(bytes.NewReader(data), int64(len(data)), []int{100, 800})
+	if err != nil {
+		t.Fatalf("Resize: %v", err)
+	}
+	if len(out) != 2 {
+		t.Fatalf("expected 2 variants, got %d", len(out))
+	}
+	if out[0].Width != 100 || out[0].Height != 50 {
+		t.Errorf("expected 100x50, got %dx%d", out[0].Width, out[0].Height)
+	}
+	// never upscaled
+	if out[1].Width != 400 || out[1].Height != 200 {
+		t.Errorf("expected 400x200, got %dx%d", out[1].Width, out[1].Height)
+	}
+	for _, v := range out {
+		if v.ContentType != "image/jpeg" || v.Extension != ".jpg" {
+			t.Errorf("expected jpeg for opaque image, got %s", v.ContentType)
+		}
+		img, err := jpeg.Decode(bytes.NewReader(v.Data))
+		if err != nil {
+			t.Fatalf("decode variant: %v", err)
+		}
+		if img.Bounds().Dx() != v.Width || img.Bounds().Dy() != v.Height {
+			t.Errorf("encoded size %v does not match %dx%d", img.Bounds(), v.Width, v.Height)
+		}
+	}
+}
+
+func TestResizeTransparentToPNG(t *testing.T) {
+	src := image.NewNRGBA(image.Rect(0, 0, 64, 64))
+	src.Set(0, 0, color.NRGBA{R: 255, A: 128})
+	data := encodePNG(t, src)
+
+	out, err := NewResizer().Resize(bytes.NewReader(data), int64(len(data)), []int{16})
+	if err != nil {
+		t.Fatalf("Resize: %v", err)
+	}
+	if out[0].ContentType != "image/png" || out[0].Extension != ".png" {
+		t.Errorf("expected png for transparent image, got %s", out[0].ContentType)
+	}
+}
+
+func TestResizeAveragesPixels(t *testing.T) {
+	// a black and white checkerboard averages to mid gray
+	src := image.NewGray(image.Rect(0, 0, 8, 8))
+	for y := 0; y < 8; y++ {
+		for x := 0; x < 8; x++ {
+			if (x+y)%2 == 0 {
+				src.SetGray(x, y, color.Gray{Y: 255})
+			}
+		}
+	}
+	rgba := image.NewRGBA(src.Bounds())
+	for y := 0; y < 8; y++ {
+		for x := 0; x < 8; x++ {
+			rgba.Set(x, y, src.At(x, y))
+		}
+	}
+
+	dst := resample(rgba, 2, 2)
+	for i := 0; i < len(dst.Pix); i += 4 {
+		if r := dst.Pix[i]; r < 127 || r > 128 {
+			t.Fatalf("expected gray 127-128, got %d", r)
+		}
+		if dst.Pix[i+3] != 255 {
+			t.Fatalf("expected opaque, got alpha %d", dst.Pix[i+3])
+		}
+	}
+}
+
+func TestResizeNonIntegerScale(t *testing.T) {
+	src := image.NewRGBA(image.Rect(0, 0, 7, 3))
+	for i := range src.Pix {
+		src.Pix[i] = 200
+	}
+
+	dst := resample(src, 3, 1)
+	for i, v := range dst.Pix {
+		if v != 200 {
+			t.Fatalf("expected uniform 200 at %d, got %d", i, v)
+		}
+	}
+}
+
+func TestResizeUnsupported(t *testing.T) {
+	data := []byte("RIFF\x00\x00\x00\x00WEBPVP8 ")
+
+	_, err := NewResizer().Resize(bytes.NewReader(data), int64(len(data)), []int{100})
+
+	if !errors.Is(err, ErrUnsupportedFormat) {
+		t.Errorf("expected ErrUnsupportedFormat, got %v", err)
+	}
+}
@@ -0,0 +1,361 @@
+// Package migrate applies SQL migrations to PostgreSQL. It keeps its state the way
+// golang-migrate does, in a one-row schema_migrations table, so databases migrated with
+// the migrate CLI carry on with this package and the other way round.
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"ishari-backend/pkg/logger"
+)
+
+// NilVersion is the version of a database no migration was applied to
+const NilVersion int64 = -1
+
+// ErrDirty is returned when an earlier migration failed halfway. The schema has to be
+// fixed by hand, then marked with Force before migrating again.
+var ErrDirty = errors.New("database is dirty")
+
+// advisoryLockSalt is the salt golang-migrate mixes into its advisory lock key
+const advisoryLockSalt uint32 = 1486364155
+
+const migrationsTable = "schema_migrations"
+
+// fileName matches NNNNNN_name.up.sql and NNNNNN_name.down.sql
+var fileName = regexp.MustCompile(`^([0-9]+)_(.+)\.(up|down)\.sql$`)
+
+// Migration is one version of the schema with the files that apply and revert it. Down is
+// empty when the migration cannot be reverted.
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
+
+// Status is the version of a database and which migrations are applied to it
+type Status struct {
+	Version    int64
+	Dirty      bool
+	Migrations []MigrationStatus
+}
+
+// MigrationStatus tells whether a migration is applied
+type MigrationStatus struct {
+	Version int64
+	Name    string
+	Applied bool
+}
+
+// Migrator applies the migrations of one directory to a database. Every operation holds
+// a session advisory lock, so concurrent runs, such as several instances migrating on
+// start, wait for each other instead of applying a migration twice.
+type Migrator struct {
+	db         *sql.DB
+	fsys       fs.FS
+	migrations []Migration
+	log        logger.Logger
+}
+
+// New reads the migrations at the root of fsys
+func New(db *sql.DB, fsys fs.FS, log logger.Logger) (*Migrator, error) {
+	migrations, err := parse(fsys)
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{db: db, fsys: fsys, migrations: migrations, log: log}, nil
+}
+
+// Up applies every pending migration in version order
+func (m *Migrator) Up(ctx context.Context) error {
+	return m.locked(ctx, func(conn *sql.Conn, version int64) error {
+		steps, err := pending(m.migrations, version)
+		if err != nil {
+			return err
+		}
+		if len(steps) == 0 {
+			m.log.Info("migrations: no change", "version", version)
+			return nil
+		}
+		for _, migration := range steps {
+			if err := m.apply(ctx, conn, migration.Version, migration.Up); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Down reverts the last n applied migrations
+func (m *Migrator) Down(ctx context.Context, n int) error {
+	if n < 1 {
+		return errors.New("number of migrations to revert must be at least 1")
+	}
+	return m.locked(ctx, func(conn *sql.Conn, version int64) error {
+		steps, err := rollback(m.migrations, version, n)
+		if err != nil {
+			return err
+		}
+		for _, step := range steps {
+			if err := m.apply(ctx, conn, step.target, step.migration.Down); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Force marks the database as migrated to version without running anything, clearing
+// the dirty flag. NilVersion marks it as not migrated at all.
+func (m *Migrator) Force(ctx context.Context, version int64) error {
+	if version != NilVersion {
+		if _, err := find(m.migrations, version); err != nil {
+			return err
+		}
+	}
+	return m.withLock(ctx, func(conn *sql.Conn) error {
+		if err := setVersion(ctx, conn, version, false); err != nil {
+			return err
+		}
+		m.log.Info("migrations: version forced", "version", version)
+		return nil
+	})
+}
+
+// Status returns the version of the database and the state of every migration
+func (m *Migrator) Status(ctx context.Context) (*Status, error) {
+	status := &Status{}
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		var err error
+		status.Version, status.Dirty, err = readVersion(ctx, conn)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, migration := range m.migrations {
+		status.Migrations = append(status.Migrations, MigrationStatus{
+			Version: migration.Version,
+			Name:    migration.Name,
+			Applied: status.Version != NilVersion && migration.Version <= status.Version,
+		})
+	}
+	return status, nil
+}
+
+// locked runs fn under the lock with the current version, refusing a dirty database
+func (m *Migrator) locked(ctx context.Context, fn func(conn *sql.Conn, version int64) error) error {
+	return m.withLock(ctx, func(conn *sql.Conn) error {
+		version, dirty, err := readVersion(ctx, conn)
+		if err != nil {
+			return err
+		}
+		if dirty {
+			return fmt.Errorf("%w at version %d: fix the schema, then force a version", ErrDirty, version)
+		}
+		return fn(conn, version)
+	})
+}
+
+// withLock runs fn on one connection holding the advisory lock of the migrations table,
+// which it creates when missing
+func (m *Migrator) withLock(ctx context.Context, fn func(conn *sql.Conn) error) (err error) {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	var database, schema string
+	if err := conn.QueryRowContext(ctx, "SELECT current_database(), current_schema()").Scan(&database, &schema); err != nil {
+		return err
+	}
+	key := lockKey(database, schema)
+	if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", key); err != nil {
+		return fmt.Errorf("failed to lock migrations: %w", err)
+	}
+	// The lock belongs to the session, which outlives conn in the pool
+	defer func() {
+		if _, unlockErr := conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", key); unlockErr != nil && err == nil {
+			err = fmt.Errorf("failed to unlock migrations: %w", unlockErr)
+		}
+	}()
+
+	if _, err := conn.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS `+migrationsTable+` (version bigint NOT NULL PRIMARY KEY, dirty boolean NOT NULL)`); err != nil {
+		return fmt.Errorf("failed to create %s: %w", migrationsTable, err)
+	}
+	return fn(conn)
+}
+
+// apply runs one migration file, marking the database dirty at target until it succeeds.
+// Files run as they are, so each one manages its own transaction.
+func (m *Migrator) apply(ctx context.Context, conn *sql.Conn, target int64, file string) error {
+	body, err := fs.ReadFile(m.fsys, file)
+	if err != nil {
+		return err
+	}
+	if err := setVersion(ctx, conn, target, true); err != nil {
+		return err
+	}
+	if _, err := conn.ExecContext(ctx, string(body)); err != nil {
+		return fmt.Errorf("migration %s failed: %w", file, err)
+	}
+	if err := setVersion(ctx, conn, target, false); err != nil {
+		return err
+	}
+	m.log.Info("migrations: applied", "file", file, "version", target)
+	return nil
+}
+
+// readVersion returns the recorded version, NilVersion when there is none
+func readVersion(ctx context.Context, conn *sql.Conn) (int64, bool, error) {
+	var (
+		version int64
+		dirty   bool
+	)
+	err := conn.QueryRowContext(ctx, `SELECT version, dirty FROM `+migrationsTable+` LIMIT 1`).Scan(&version, &dirty)
+	if errors.Is(err, sql.ErrNoRows) {
+		return NilVersion, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	return version, dirty, nil
+}
+
+// setVersion replaces the recorded version. Like golang-migrate, a dirty NilVersion is
+// kept as a row so that a failed revert of the first migration is not mistaken for an
+// empty database.
+func setVersion(ctx context.Context, conn *sql.Conn, version int64, dirty bool) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `TRUNCATE `+migrationsTable); err != nil {
+		return err
+	}
+	if version != NilVersion || dirty {
+		if _, err := tx.ExecContext(ctx, `INSERT INTO `+migrationsTable+` (version, dirty) VALUES ($1, $2)`, version, dirty); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// lockKey derives the advisory lock key from the database and schema the same way
+// golang-migrate does, so its CLI and this package exclude each other too
+func lockKey(database, schema string) int64 {
+	name := strings.Join([]string{schema, migrationsTable, database}, "\x00")
+	return int64(crc32.ChecksumIEEE([]byte(name)) * advisoryLockSalt)
+}
+
+// parse lists the migrations at the root of fsys in version order
+func parse(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, err
+	}
+	byVersion := make(map[int64]*Migration)
+	for _, entry := range entries {
+		match := fileName.FindStringSubmatch(entry.Name())
+		if entry.IsDir() || match == nil {
+			continue
+		}
+		version, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid migration version in %s: %w", entry.Name(), err)
+		}
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = migration
+		}
+		if migration.Name != match[2] {
+			return nil, fmt.Errorf("migration %d has two names: %s and %s", version, migration.Name, match[2])
+		}
+		file := &migration.Up
+		if match[3] == "down" {
+			file = &migration.Down
+		}
+		if *file != "" {
+			return nil, fmt.Errorf("duplicate migration file %s", entry.Name())
+		}
+		*file = entry.Name()
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.Up == "" {
+			return nil, fmt.Errorf("migration %d_%s has no up file", migration.Version, migration.Name)
+		}
+		migrations = append(migrations, *migration)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+// find returns the index of the migration with version
+func find(migrations []Migration, version int64) (int, error) {
+	for i, migration := range migrations {
+		if migration.Version == version {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("no migration with version %d", version)
+}
+
+// pending returns the migrations after version
+func pending(migrations []Migration, version int64) ([]Migration, error) {
+	if version == NilVersion {
+		return migrations, nil
+	}
+	i, err := find(migrations, version)
+	if err != nil {
+		return nil, fmt.Errorf("database is at version %d: %w", version, err)
+	}
+	return migrations[i+1:], nil
+}
+
+// rollbackStep reverts migration, leaving the database at target
+type rollbackStep struct {
+	migration Migration
+	target    int64
+}
+
+// rollback returns the steps reverting the last n migrations applied up to version
+func rollback(migrations []Migration, version int64, n int) ([]rollbackStep, error) {
+	if version == NilVersion {
+		return nil, errors.New("no migration is applied")
+	}
+	i, err := find(migrations, version)
+	if err != nil {
+		return nil, fmt.Errorf("database is at version %d: %w", version, err)
+	}
+	if n > i+1 {
+		return nil, fmt.Errorf("cannot revert %d migrations: only %d are applied", n, i+1)
+	}
+
+	steps := make([]rollbackStep, 0, n)
+	for ; n > 0; n, i = n-1, i-1 {
+		if migrations[i].Down == "" {
+			return nil, fmt.Errorf("migration %d_%s has no down file", migrations[i].Version, migrations[i].Name)
+		}
+		target := NilVersion
+		if i > 0 {
+			target = migrations[i-1].Version
+		}
+		steps = append(steps, rollbackStep{migration: migrations[i], target: target})
+	}
+	return steps, nil
+}
@@ -0,0 +1,159 @@
+package migrate
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"ishari-backend/migrations"
+)
+
+func testFS() fstest.MapFS {
+	return fstest.MapFS{
+		"000001_init.up.sql":         {Data: []byte("CREATE TABLE a ();")},
+		"000001_init.down.sql":       {Data: []byte("DROP TABLE a;")},
+		"000002_users.up.sql":        {Data: []byte("CREATE TABLE b ();")},
+		"000002_users.down.sql":      {Data: []byte("DROP TABLE b;")},
+		"000010_no_down.up.sql":      {Data: []byte("CREATE INDEX c ON b ();")},
+		"000011_categories.up.sql":   {Data: []byte("CREATE TABLE d ();")},
+		"000011_categories.down.sql": {Data: []byte("DROP TABLE d;")},
+		"embed.go":                   {Data: []byte("package migrations")},
+		"README.md":                  {Data: []byte("notes")},
+		"000012_directory.up.sql/x":  {Data: []byte("")},
+	}
+}
+
+func TestParse(t *testing.T) {
+	got, err := parse(testFS())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []Migration{
+		{Version: 1, Name: "init", Up: "000001_init.up.sql", Down: "000001_init.down.sql"},
+		{Version: 2, Name: "users", Up: "000002_users.up.sql", Down: "000002_users.down.sql"},
+		{Version: 10, Name: "no_down", Up: "000010_no_down.up.sql"},
+		{Version: 11, Name: "categories", Up: "000011_categories.up.sql", Down: "000011_categories.down.sql"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("expected %d migrations, got %+v", len(want), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("migration %d: expected %+v, got %+v", i, want[i], got[i])
+		}
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	tests := map[string]fstest.MapFS{
+		"no up file": {"000001_init.down.sql": {}},
+		"two names":  {"000001_init.up.sql": {}, "000001_other.down.sql": {}},
+		"duplicate":  {"000001_init.up.sql": {}, "1_init.up.sql": {}},
+	}
+	for name, fsys := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := parse(fsys); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+func TestEmbeddedMigrations(t *testing.T) {
+	list, err := parse(migrations.FS)
+	if err != nil {
+		t.Fatalf("embedded migrations do not parse: %v", err)
+	}
+	if len(list) == 0 {
+		t.Fatal("no migration is embedded")
+	}
+	for i, migration := range list {
+		if migration.Version != int64(i+1) {
+			t.Errorf("expected version %d, got %d_%s", i+1, migration.Version, migration.Name)
+		}
+		if migration.Down == "" {
+			t.Errorf("migration %d_%s has no down file", migration.Version, migration.Name)
+		}
+	}
+}
+
+func TestPending(t *testing.T) {
+	list, _ := parse(testFS())
+
+	tests := []struct {
+		version int64
+		want    []int64
+	}{
+		{NilVersion, []int64{1, 2, 10, 11}},
+		{2, []int64{10, 11}},
+		{11, nil},
+	}
+	for _, tt := range tests {
+		got, err := pending(list, tt.version)
+		if err != nil {
+			t.Fatalf("version %d: unexpected error: %v", tt.version, err)
+		}
+		if versions := versionsOf(got); !slices.Equal(versions, tt.want) {
+			t.Errorf("version %d: expected %v pending, got %v", tt.version, tt.want, versions)
+		}
+	}
+
+	if _, err := pending(list, 5); err == nil || !strings.Contains(err.Error(), "no migration with version 5") {
+		t.Errorf("expected an unknown version error, got %v", err)
+	}
+}
+
+func TestRollback(t *testing.T) {
+	list, _ := parse(testFS())
+
+	steps, err := rollback(list, 2, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(steps) != 2 || steps[0].migration.Version != 2 || steps[0].target != 1 ||
+		steps[1].migration.Version != 1 || steps[1].target != NilVersion {
+		t.Errorf("unexpected steps: %+v", steps)
+	}
+
+	errorCases := []struct {
+		name    string
+		version int64
+		n       int
+		want    string
+	}{
+		{"nothing applied", NilVersion, 1, "no migration is applied"},
+		{"too many", 2, 3, "only 2 are applied"},
+		{"no down file", 11, 2, "10_no_down has no down file"},
+		{"unknown version", 7, 1, "no migration with version 7"},
+	}
+	for _, tt := range errorCases {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := rollback(list, tt.version, tt.n)
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected %q in %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestLockKey(t *testing.T) {
+	key := lockKey("ishari", "public")
+	if key <= 0 || key > 1<<32-1 {
+		t.Errorf("expected a key in the uint32 range, got %d", key)
+	}
+	if key == lockKey("ishari_test", "public") || key == lockKey("ishari", "other") {
+		t.Error("expected databases and schemas to lock independently")
+	}
+}
+
+func versionsOf(list []Migration) []int64 {
+	var versions []int64
+	for _, migration := range list {
+		versions = append(versions, migration.Version)
+	}
+	return versions
+}
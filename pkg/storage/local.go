@@ -0,0 +1,230 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	portstorage "ishari-backend/internal/core/port/storage"
+)
+
+// LocalStorage implements Storage on the local filesystem.
+// Files are expected to be served publicly under publicURL (see bootstrap static route).
+type LocalStorage struct {
+	baseDir   string
+	publicURL string
+}
+
+// NewLocalStorage creates a LocalStorage rooted at baseDir, creating the directory if needed
+func NewLocalStorage(baseDir, publicURL string) (*LocalStorage, error) {
+	if err := os.MkdirAll(baseDir, 0o755); err != nil {
+		return nil, fmt.Errorf("create storage dir: %w", err)
+	}
+	return &LocalStorage{
+		baseDir:   baseDir,
+		publicURL: strings.TrimRight(publicURL, "/"),
+	}, nil
+}
+
+// path resolves key inside baseDir, rejecting keys that escape it
+func (s *LocalStorage) path(key string) (string, error) {
+	clean := path.Clean("/" + key)
+	if clean == "/" || clean[1:] != key {
+		return "", fmt.Errorf("invalid storage key %q", key)
+	}
+	return filepath.Join(s.baseDir, filepath.FromSlash(clean)), nil
+}
+
+// Put writes the content to a temporary file and renames it into place
+func (s *LocalStorage) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) (*portstorage.ObjectInfo, error) {
+	dst, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Dir(dst), 0o755); err != nil {
+		return nil, err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(dst), ".upload-*")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, r); err != nil {
+		tmp.Close()
+		return nil, err
+	}
+	if err := tmp.Close(); err != nil {
+		return nil, err
+	}
+	if err := os.Rename(tmp.Name(), dst); err != nil {
+		return nil, err
+	}
+
+	fi, err := os.Stat(dst)
+	if err != nil {
+		return nil, err
+	}
+	return s.objectInfo(key, fi), nil
+}
+
+// Get opens the file. The returned reader is an *os.File and therefore also an io.ReadSeeker.
+func (s *LocalStorage) Get(ctx context.Context, key string) (io.ReadCloser, *portstorage.ObjectInfo, error) {
+	p, err := s.path(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	f, err := os.Open(p)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil, portstorage.ErrObjectNotFound
+		}
+		return nil, nil, err
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, nil, err
+	}
+	if fi.IsDir() {
+		f.Close()
+		return nil, nil, portstorage.ErrObjectNotFound
+	}
+	return f, s.objectInfo(key, fi), nil
+}
+
+// Stat returns the file metadata
+func (s *LocalStorage) Stat(ctx context.Context, key string) (*portstorage.ObjectInfo, error) {
+	p, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	fi, err := os.Stat(p)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, portstorage.ErrObjectNotFound
+		}
+		return nil, err
+	}
+	if fi.IsDir() {
+		return nil, portstorage.ErrObjectNotFound
+	}
+	return s.objectInfo(key, fi), nil
+}
+
+// GetRange opens the file positioned at offset and limited to length bytes
+func (s *LocalStorage) GetRange(ctx context.Context, key string, offset, length int64) (io.ReadCloser, error) {
+	rc, _, err := s.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	f := rc.(*os.File)
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		f.Close()
+		return nil, err
+	}
+	return &limitedReadCloser{Reader: io.LimitReader(f, length), Closer: f}, nil
+}
+
+// Delete removes the file, ignoring missing files
+func (s *LocalStorage) Delete(ctx context.Context, key string) error {
+	p, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(p); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// Presign returns the public URL; local files are served without authentication
+func (s *LocalStorage) Presign(ctx context.Context, key string, ttl time.Duration) (string, error) {
+	return s.URL(key), nil
+}
+
+// URL returns the public URL for key
+func (s *LocalStorage) URL(key string) string {
+	return s.publicURL + "/" + escapeKey(key)
+}
+
+// KeyFromURL extracts the key from a URL produced by URL
+func (s *LocalStorage) KeyFromURL(rawURL string) (string, bool) {
+	return keyFromURL(s.publicURL, rawURL)
+}
+
+func (s *LocalStorage) objectInfo(key string, fi os.FileInfo) *portstorage.ObjectInfo {
+	return &portstorage.ObjectInfo{
+		Key:          key,
+		Size:         fi.Size(),
+		ContentType:  contentTypeByKey(key),
+		ETag:         fmt.Sprintf(`"%x-%x"`, fi.ModTime().UnixNano(), fi.Size()),
+		LastModified: fi.ModTime(),
+	}
+}
+
+// limitedReadCloser closes the underlying file of a limited reader
+type limitedReadCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// escapeKey percent-encodes every path segment of key
+func escapeKey(key string) string {
+	segments := strings.Split(strings.TrimLeft(key, "/"), "/")
+	for i, seg := range segments {
+		segments[i] = url.PathEscape(seg)
+	}
+	return strings.Join(segments, "/")
+}
+
+// keyFromURL strips base from rawURL and unescapes the remaining key
+func keyFromURL(base, rawURL string) (string, bool) {
+	prefix := base + "/"
+	if base == "" || !strings.HasPrefix(rawURL, prefix) {
+		return "", false
+	}
+	rest := strings.TrimPrefix(rawURL, prefix)
+	if i := strings.IndexAny(rest, "?#"); i >= 0 {
+		rest = rest[:i]
+	}
+	key, err := url.PathUnescape(rest)
+	if err != nil || key == "" {
+		return "", false
+	}
+	return key, true
+}
+
+// knownContentTypes covers upload formats missing from Go's builtin mime table
+var knownContentTypes = map[string]string{
+	".mp3":  "audio/mpeg",
+	".ogg":  "audio/ogg",
+	".opus": "audio/ogg",
+	".wav":  "audio/wav",
+	".m4a":  "audio/mp4",
+	".jpg":  "image/jpeg",
+	".jpeg": "image/jpeg",
+	".png":  "image/png",
+	".webp": "image/webp",
+	".json": "application/json",
+}
+
+func contentTypeByKey(key string) string {
+	ext := strings.ToLower(path.Ext(key))
+	if ct, ok := knownContentTypes[ext]; ok {
+		return ct
+	}
+	if ct := mime.TypeByExtension(ext); ct != "" {
+		return ct
+	}
+	return "application/octet-stream"
+}
@@ -0,0 +1,257 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	portstorage "ishari-backend/internal/core/port/storage"
+)
+
+// S3Config describes an S3-compatible bucket (AWS S3, MinIO, R2, ...)
+type S3Config struct {
+	Endpoint     string
+	Region       string
+	Bucket       string
+	AccessKey    string
+	SecretKey    string
+	UsePathStyle bool
+	// PublicURL overrides the base URL returned by URL, e.g. a CDN in front of the bucket
+	PublicURL string
+}
+
+// S3Storage stores objects in an S3-compatible bucket using signed HTTP requests
+type S3Storage struct {
+	endpoint   *url.URL
+	bucket     string
+	pathStyle  bool
+	publicURL  string
+	signer     *sigV4Signer
+	httpClient *http.Client
+	now        func() time.Time
+}
+
+// NewS3Storage creates an S3-compatible storage
+func NewS3Storage(cfg S3Config) (*S3Storage, error) {
+	if cfg.Bucket == "" {
+		return nil, fmt.Errorf("s3 storage: bucket is required")
+	}
+	if cfg.AccessKey == "" || cfg.SecretKey == "" {
+		return nil, fmt.Errorf("s3 storage: access key and secret key are required")
+	}
+
+	endpoint := cfg.Endpoint
+	if endpoint == "" {
+		endpoint = "https://s3." + cfg.Region + ".amazonaws.com"
+	}
+	u, err := url.Parse(strings.TrimRight(endpoint, "/"))
+	if err != nil || u.Host == "" {
+		return nil, fmt.Errorf("s3 storage: invalid endpoint %q", endpoint)
+	}
+
+	region := cfg.Region
+	if region == "" {
+		region = "us-east-1"
+	}
+
+	s := &S3Storage{
+		endpoint:   u,
+		bucket:     cfg.Bucket,
+		pathStyle:  cfg.UsePathStyle,
+		signer:     &sigV4Signer{accessKey: cfg.AccessKey, secretKey: cfg.SecretKey, region: region},
+		httpClient: &http.Client{Timeout: 5 * time.Minute},
+		now:        time.Now,
+	}
+	s.publicURL = strings.TrimRight(cfg.PublicURL, "/")
+	if s.publicURL == "" {
+		s.publicURL = strings.TrimRight(s.objectURL("").String(), "/")
+	}
+	return s, nil
+}
+
+// objectURL builds the bucket URL for key honoring path-style addressing
+func (s *S3Storage) objectURL(key string) *url.URL {
+	u := *s.endpoint
+	p := strings.TrimRight(u.Path, "/")
+	if s.pathStyle {
+		p += "/" + s.bucket
+	} else {
+		u.Host = s.bucket + "." + u.Host
+	}
+	u.Path = p + "/" + key
+	u.RawPath = p + "/" + escapeKey(key)
+	return &u
+}
+
+func (s *S3Storage) do(ctx context.Context, method, key string, body io.Reader, size int64, header http.Header) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, s.objectURL(key).String(), body)
+	if err != nil {
+		return nil, err
+	}
+	for name, values := range header {
+		for _, v := range values {
+			req.Header.Add(name, v)
+		}
+	}
+	payloadHash := emptyPayloadHash
+	if body != nil {
+		req.ContentLength = size
+		payloadHash = unsignedPayload
+	}
+	s.signer.signRequest(req, payloadHash, s.now())
+	return s.httpClient.Do(req)
+}
+
+// Put uploads the object in a single request
+func (s *S3Storage) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) (*portstorage.ObjectInfo, error) {
+	if size < 0 {
+		return nil, fmt.Errorf("s3 storage: object size is required")
+	}
+	if contentType == "" {
+		contentType = contentTypeByKey(key)
+	}
+	header := http.Header{}
+	header.Set("Content-Type", contentType)
+
+	resp, err := s.do(ctx, http.MethodPut, key, r, size, header)
+	if err != nil {
+		return nil, fmt.Errorf("s3 storage: put %s: %w", key, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, s3Error("put", key, resp)
+	}
+
+	return &portstorage.ObjectInfo{
+		Key:          key,
+		Size:         size,
+		ContentType:  contentType,
+		ETag:         resp.Header.Get("ETag"),
+		LastModified: s.now().UTC(),
+	}, nil
+}
+
+// Get downloads the whole object
+func (s *S3Storage) Get(ctx context.Context, key string) (io.ReadCloser, *portstorage.ObjectInfo, error) {
+	resp, err := s.do(ctx, http.MethodGet, key, nil, 0, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("s3 storage: get %s: %w", key, err)
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		resp.Body.Close()
+		return nil, nil, portstorage.ErrObjectNotFound
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		return nil, nil, s3Error("get", key, resp)
+	}
+	return resp.Body, objectInfoFromHeader(key, resp.Header, resp.ContentLength), nil
+}
+
+// Stat fetches the object metadata with a HEAD request
+func (s *S3Storage) Stat(ctx context.Context, key string) (*portstorage.ObjectInfo, error) {
+	resp, err := s.do(ctx, http.MethodHead, key, nil, 0, nil)
+	if err != nil {
+		return nil, fmt.Errorf("s3 storage: head %s: %w", key, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, portstorage.ErrObjectNotFound
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, s3Error("head", key, resp)
+	}
+	return objectInfoFromHeader(key, resp.Header, resp.ContentLength), nil
+}
+
+// GetRange downloads a byte range of the object
+func (s *S3Storage) GetRange(ctx context.Context, key string, offset, length int64) (io.ReadCloser, error) {
+	if length <= 0 {
+		return io.NopCloser(strings.NewReader("")), nil
+	}
+	header := http.Header{}
+	header.Set("Range", fmt.Sprintf("bytes=%d-%d", offset, offset+length-1))
+
+	resp, err := s.do(ctx, http.MethodGet, key, nil, 0, header)
+	if err != nil {
+		return nil, fmt.Errorf("s3 storage: get %s: %w", key, err)
+	}
+	switch resp.StatusCode {
+	case http.StatusPartialContent:
+		return resp.Body, nil
+	case http.StatusOK:
+		// the backend ignored the range; skip to offset ourselves
+		if _, err := io.CopyN(io.Discard, resp.Body, offset); err != nil {
+			resp.Body.Close()
+			return nil, fmt.Errorf("s3 storage: get %s: %w", key, err)
+		}
+		return &limitedReadCloser{Reader: io.LimitReader(resp.Body, length), Closer: resp.Body}, nil
+	case http.StatusNotFound:
+		resp.Body.Close()
+		return nil, portstorage.ErrObjectNotFound
+	default:
+		defer resp.Body.Close()
+		return nil, s3Error("get", key, resp)
+	}
+}
+
+// Delete removes the object; missing objects are not an error
+func (s *S3Storage) Delete(ctx context.Context, key string) error {
+	resp, err := s.do(ctx, http.MethodDelete, key, nil, 0, nil)
+	if err != nil {
+		return fmt.Errorf("s3 storage: delete %s: %w", key, err)
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusNoContent, http.StatusNotFound:
+		return nil
+	default:
+		return s3Error("delete", key, resp)
+	}
+}
+
+// Presign returns a time-limited GET URL for the object
+func (s *S3Storage) Presign(ctx context.Context, key string, ttl time.Duration) (string, error) {
+	if ttl <= 0 {
+		ttl = 15 * time.Minute
+	}
+	return s.signer.presignURL(http.MethodGet, s.objectURL(key), ttl, s.now()), nil
+}
+
+// URL returns the public URL of the object
+func (s *S3Storage) URL(key string) string {
+	return s.publicURL + "/" + escapeKey(key)
+}
+
+// KeyFromURL extracts the object key from a URL produced by URL
+func (s *S3Storage) KeyFromURL(rawURL string) (string, bool) {
+	return keyFromURL(s.publicURL, rawURL)
+}
+
+func objectInfoFromHeader(key string, h http.Header, size int64) *portstorage.ObjectInfo {
+	info := &portstorage.ObjectInfo{
+		Key:         key,
+		Size:        size,
+		ContentType: h.Get("Content-Type"),
+		ETag:        h.Get("ETag"),
+	}
+	if size < 0 {
+		if n, err := strconv.ParseInt(h.Get("Content-Length"), 10, 64); err == nil {
+			info.Size = n
+		}
+	}
+	if t, err := http.ParseTime(h.Get("Last-Modified")); err == nil {
+		info.LastModified = t
+	}
+	return info
+}
+
+func s3Error(op, key string, resp *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	return fmt.Errorf("s3 storage: %s %s: unexpected status %d: %s", op, key, resp.StatusCode, strings.TrimSpace(string(body)))
+}
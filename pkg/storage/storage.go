@@ -0,0 +1,28 @@
+package storage
+
+import (
+	"fmt"
+
+	portstorage "ishari-backend/internal/core/port/storage"
+	"ishari-backend/pkg/config"
+)
+
+// New creates the storage backend selected by cfg.Driver
+func New(cfg config.StorageConfig) (portstorage.Storage, error) {
+	switch cfg.Driver {
+	case "", config.StorageDriverLocal:
+		return NewLocalStorage(cfg.LocalDir, cfg.PublicURL)
+	case config.StorageDriverS3:
+		return NewS3Storage(S3Config{
+			Endpoint:     cfg.S3Endpoint,
+			Region:       cfg.S3Region,
+			Bucket:       cfg.S3Bucket,
+			AccessKey:    cfg.S3AccessKey,
+			SecretKey:    cfg.S3SecretKey,
+			UsePathStyle: cfg.S3UsePathStyle,
+			PublicURL:    cfg.S3PublicURL,
+		})
+	default:
+		return nil, fmt.Errorf("unknown storage driver %q", cfg.Driver)
+	}
+}